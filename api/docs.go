@@ -19,9 +19,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/departments": {
+        "/calendars": {
             "get": {
-                "description": "取得全部部門",
+                "description": "取得全部行事曆",
                 "consumes": [
                     "application/json"
                 ],
@@ -29,9 +29,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "department"
+                    "calendar"
                 ],
-                "summary": "取得全部部門",
+                "summary": "取得全部行事曆",
                 "parameters": [
                     {
                         "type": "string",
@@ -67,7 +67,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/departments.List"
+                                            "$ref": "#/definitions/calendars.List"
                                         }
                                     }
                                 }
@@ -113,7 +113,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "新增部門",
+                "description": "新增行事曆",
                 "consumes": [
                     "application/json"
                 ],
@@ -121,9 +121,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "department"
+                    "calendar"
                 ],
-                "summary": "新增部門",
+                "summary": "新增行事曆",
                 "parameters": [
                     {
                         "type": "string",
@@ -133,12 +133,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "新增部門",
+                        "description": "新增行事曆",
                         "name": "*",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/departments.Create"
+                            "$ref": "#/definitions/calendars.Create"
                         }
                     }
                 ],
@@ -200,9 +200,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/departments/no-pagination": {
+        "/calendars/no-pagination": {
             "get": {
-                "description": "取得全部部門",
+                "description": "取得全部行事曆",
                 "consumes": [
                     "application/json"
                 ],
@@ -210,9 +210,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "department"
+                    "calendar"
                 ],
-                "summary": "取得全部部門(不用page\u0026limit)",
+                "summary": "取得全部行事曆(不用page\u0026limit)",
                 "parameters": [
                     {
                         "type": "string",
@@ -234,7 +234,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/departments.List"
+                                            "$ref": "#/definitions/calendars.List"
                                         }
                                     }
                                 }
@@ -280,9 +280,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/departments/{id}": {
+        "/calendars/{id}": {
             "get": {
-                "description": "取得單一部門",
+                "description": "取得單一行事曆",
                 "consumes": [
                     "application/json"
                 ],
@@ -290,9 +290,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "department"
+                    "calendar"
                 ],
-                "summary": "取得單一部門",
+                "summary": "取得單一行事曆",
                 "parameters": [
                     {
                         "type": "string",
@@ -303,7 +303,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "部門UUID",
+                        "description": "行事曆UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -321,7 +321,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/departments.Single"
+                                            "$ref": "#/definitions/calendars.Single"
                                         }
                                     }
                                 }
@@ -367,7 +367,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "刪除單一部門",
+                "description": "刪除單一行事曆",
                 "consumes": [
                     "application/json"
                 ],
@@ -375,9 +375,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "department"
+                    "calendar"
                 ],
-                "summary": "刪除單一部門",
+                "summary": "刪除單一行事曆",
                 "parameters": [
                     {
                         "type": "string",
@@ -388,7 +388,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "部門UUID",
+                        "description": "行事曆UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -452,7 +452,7 @@ const docTemplate = `{
                 }
             },
             "patch": {
-                "description": "更新單一部門",
+                "description": "更新單一行事曆",
                 "consumes": [
                     "application/json"
                 ],
@@ -460,9 +460,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "department"
+                    "calendar"
                 ],
-                "summary": "更新單一部門",
+                "summary": "更新單一行事曆",
                 "parameters": [
                     {
                         "type": "string",
@@ -473,18 +473,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "部門UUID",
+                        "description": "行事曆UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "更新部門",
+                        "description": "更新行事曆",
                         "name": "*",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/departments.Update"
+                            "$ref": "#/definitions/calendars.Update"
                         }
                     }
                 ],
@@ -546,9 +546,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/event-marks": {
-            "post": {
-                "description": "新增事件標記",
+        "/departments": {
+            "get": {
+                "description": "取得全部部門",
                 "consumes": [
                     "application/json"
                 ],
@@ -556,9 +556,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "event_mark"
+                    "department"
                 ],
-                "summary": "新增事件標記",
+                "summary": "取得全部部門",
                 "parameters": [
                     {
                         "type": "string",
@@ -568,13 +568,18 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "新增事件標記",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/event_marks.Create"
-                        }
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -589,7 +594,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/departments.List"
                                         }
                                     }
                                 }
@@ -633,11 +638,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/event-marks/{id}": {
-            "delete": {
-                "description": "刪除單一事件標記",
+            },
+            "post": {
+                "description": "新增部門",
                 "consumes": [
                     "application/json"
                 ],
@@ -645,9 +648,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "event_mark"
+                    "department"
                 ],
-                "summary": "刪除單一事件標記",
+                "summary": "新增部門",
                 "parameters": [
                     {
                         "type": "string",
@@ -657,11 +660,13 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "事件標記UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "新增部門",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/departments.Create"
+                        }
                     }
                 ],
                 "responses": {
@@ -720,9 +725,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "更新單一事件標記",
+            }
+        },
+        "/departments/no-pagination": {
+            "get": {
+                "description": "取得全部部門",
                 "consumes": [
                     "application/json"
                 ],
@@ -730,9 +737,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "event_mark"
+                    "department"
                 ],
-                "summary": "更新單一事件標記",
+                "summary": "取得全部部門(不用page\u0026limit)",
                 "parameters": [
                     {
                         "type": "string",
@@ -740,22 +747,6 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "事件標記UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "更新事件標記",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/event_marks.Update"
-                        }
                     }
                 ],
                 "responses": {
@@ -770,7 +761,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/departments.List"
                                         }
                                     }
                                 }
@@ -816,9 +807,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/files": {
-            "post": {
-                "description": "上傳檔案",
+        "/departments/{id}": {
+            "get": {
+                "description": "取得單一部門",
                 "consumes": [
                     "application/json"
                 ],
@@ -826,9 +817,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "s3_file"
+                    "department"
                 ],
-                "summary": "上傳檔案",
+                "summary": "取得單一部門",
                 "parameters": [
                     {
                         "type": "string",
@@ -838,16 +829,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "上傳檔案",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/s3_files.Create"
-                            }
-                        }
+                        "type": "string",
+                        "description": "部門UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -862,7 +848,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/departments.Single"
                                         }
                                     }
                                 }
@@ -906,11 +892,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/files/{id}": {
+            },
             "delete": {
-                "description": "刪除單一檔案",
+                "description": "刪除單一部門",
                 "consumes": [
                     "application/json"
                 ],
@@ -918,9 +902,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "s3_file"
+                    "department"
                 ],
-                "summary": "刪除單一檔案",
+                "summary": "刪除單一部門",
                 "parameters": [
                     {
                         "type": "string",
@@ -931,7 +915,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "檔案UUID",
+                        "description": "部門UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -993,11 +977,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/forget-password": {
-            "post": {
-                "description": "忘記密碼",
+            },
+            "patch": {
+                "description": "更新單一部門",
                 "consumes": [
                     "application/json"
                 ],
@@ -1005,17 +987,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "login"
+                    "department"
                 ],
-                "summary": "忘記密碼",
+                "summary": "更新單一部門",
                 "parameters": [
                     {
-                        "description": "忘記密碼",
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "部門UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新部門",
                         "name": "*",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/logins.Forget"
+                            "$ref": "#/definitions/departments.Update"
                         }
                     }
                 ],
@@ -1077,9 +1073,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/holidays": {
-            "get": {
-                "description": "取得全部假期",
+        "/event-marks": {
+            "post": {
+                "description": "新增事件標記",
                 "consumes": [
                     "application/json"
                 ],
@@ -1087,9 +1083,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "holiday"
+                    "event_mark"
                 ],
-                "summary": "取得全部假期",
+                "summary": "新增事件標記",
                 "parameters": [
                     {
                         "type": "string",
@@ -1099,18 +1095,13 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "目前頁數,請從1開始帶入",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
+                        "description": "新增事件標記",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event_marks.Create"
+                        }
                     }
                 ],
                 "responses": {
@@ -1125,7 +1116,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/holidays.List"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -1169,9 +1160,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "新增假期",
+            }
+        },
+        "/event-marks/{id}": {
+            "delete": {
+                "description": "刪除單一事件標記",
                 "consumes": [
                     "application/json"
                 ],
@@ -1179,9 +1172,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "holiday"
+                    "event_mark"
                 ],
-                "summary": "新增假期",
+                "summary": "刪除單一事件標記",
                 "parameters": [
                     {
                         "type": "string",
@@ -1191,13 +1184,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "新增假期",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/holidays.Create"
-                        }
+                        "type": "string",
+                        "description": "事件標記UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1256,11 +1247,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/holidays/no-pagination": {
-            "get": {
-                "description": "取得全部假期",
+            },
+            "patch": {
+                "description": "更新單一事件標記",
                 "consumes": [
                     "application/json"
                 ],
@@ -1268,9 +1257,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "holiday"
+                    "event_mark"
                 ],
-                "summary": "取得全部假期(不用page\u0026limit)",
+                "summary": "更新單一事件標記",
                 "parameters": [
                     {
                         "type": "string",
@@ -1278,10 +1267,26 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
+                    },
+                    {
+                        "type": "string",
+                        "description": "事件標記UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新事件標記",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event_marks.Update"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
                         "description": "成功後返回的值",
                         "schema": {
                             "allOf": [
@@ -1292,7 +1297,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/tasks.List"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -1338,9 +1343,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/holidays/{id}": {
-            "get": {
-                "description": "取得單一假期",
+        "/files": {
+            "post": {
+                "description": "上傳檔案",
                 "consumes": [
                     "application/json"
                 ],
@@ -1348,9 +1353,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "holiday"
+                    "s3_file"
                 ],
-                "summary": "取得單一假期",
+                "summary": "上傳檔案",
                 "parameters": [
                     {
                         "type": "string",
@@ -1360,11 +1365,16 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "假期UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "上傳檔案",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/s3_files.Create"
+                            }
+                        }
                     }
                 ],
                 "responses": {
@@ -1379,7 +1389,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/holidays.Single"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -1423,9 +1433,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/files/{id}": {
             "delete": {
-                "description": "刪除單一假期",
+                "description": "刪除單一檔案",
                 "consumes": [
                     "application/json"
                 ],
@@ -1433,9 +1445,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "holiday"
+                    "s3_file"
                 ],
-                "summary": "刪除單一假期",
+                "summary": "刪除單一檔案",
                 "parameters": [
                     {
                         "type": "string",
@@ -1446,7 +1458,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "假期UUID",
+                        "description": "檔案UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1508,9 +1520,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "更新單一假期",
+            }
+        },
+        "/forget-password": {
+            "post": {
+                "description": "忘記密碼",
                 "consumes": [
                     "application/json"
                 ],
@@ -1518,31 +1532,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "holiday"
+                    "login"
                 ],
-                "summary": "更新單一假期",
+                "summary": "忘記密碼",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "JWE Token",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "假期UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "更新假期",
+                        "description": "忘記密碼",
                         "name": "*",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/holidays.Update"
+                            "$ref": "#/definitions/logins.Forget"
                         }
                     }
                 ],
@@ -1604,9 +1604,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/login": {
-            "post": {
-                "description": "登入",
+        "/holidays": {
+            "get": {
+                "description": "取得全部假期",
                 "consumes": [
                     "application/json"
                 ],
@@ -1614,18 +1614,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "login"
+                    "holiday"
                 ],
-                "summary": "登入",
+                "summary": "取得全部假期",
                 "parameters": [
                     {
-                        "description": "登入",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/logins.Login"
-                        }
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1640,7 +1652,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/holidays.List"
                                         }
                                     }
                                 }
@@ -1684,11 +1696,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/policies": {
-            "get": {
-                "description": "取得策略",
+            },
+            "post": {
+                "description": "新增假期",
                 "consumes": [
                     "application/json"
                 ],
@@ -1696,9 +1706,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "policies"
+                    "holiday"
                 ],
-                "summary": "取得策略",
+                "summary": "新增假期",
                 "parameters": [
                     {
                         "type": "string",
@@ -1706,6 +1716,15 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "新增假期",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/holidays.Create"
+                        }
                     }
                 ],
                 "responses": {
@@ -1720,10 +1739,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/policies.Single"
-                                            }
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -1767,9 +1783,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "新增策略",
+            }
+        },
+        "/holidays/no-pagination": {
+            "get": {
+                "description": "取得全部假期",
                 "consumes": [
                     "application/json"
                 ],
@@ -1777,9 +1795,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "policies"
+                    "holiday"
                 ],
-                "summary": "新增策略",
+                "summary": "取得全部假期(不用page\u0026limit)",
                 "parameters": [
                     {
                         "type": "string",
@@ -1787,18 +1805,6 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "description": "新增策略",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/policies.PolicyRule"
-                            }
-                        }
                     }
                 ],
                 "responses": {
@@ -1813,7 +1819,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/tasks.List"
                                         }
                                     }
                                 }
@@ -1857,9 +1863,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "刪除策略",
+            }
+        },
+        "/holidays/{id}": {
+            "get": {
+                "description": "取得單一假期",
                 "consumes": [
                     "application/json"
                 ],
@@ -1867,9 +1875,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "policies"
+                    "holiday"
                 ],
-                "summary": "刪除策略",
+                "summary": "取得單一假期",
                 "parameters": [
                     {
                         "type": "string",
@@ -1879,16 +1887,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "刪除策略",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/policies.PolicyRule"
-                            }
-                        }
+                        "type": "string",
+                        "description": "假期UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1903,7 +1906,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/holidays.Single"
                                         }
                                     }
                                 }
@@ -1947,11 +1950,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/project-resources": {
-            "post": {
-                "description": "取得全部專案資源",
+            },
+            "delete": {
+                "description": "刪除單一假期",
                 "consumes": [
                     "application/json"
                 ],
@@ -1959,9 +1960,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project_resource"
+                    "holiday"
                 ],
-                "summary": "取得全部專案資源",
+                "summary": "刪除單一假期",
                 "parameters": [
                     {
                         "type": "string",
@@ -1971,26 +1972,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "目前頁數,請從1開始帶入",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
-                        "name": "limit",
-                        "in": "query",
+                        "type": "string",
+                        "description": "假期UUID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "搜尋",
-                        "name": "*",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/project_resources.Filter"
-                        }
                     }
                 ],
                 "responses": {
@@ -2005,7 +1991,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/project_resources.List"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -2049,11 +2035,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/project-resources/get-by-project": {
-            "post": {
-                "description": "透過過專案ID取得專案資源",
+            },
+            "patch": {
+                "description": "更新單一假期",
                 "consumes": [
                     "application/json"
                 ],
@@ -2061,9 +2045,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project_resource"
+                    "holiday"
                 ],
-                "summary": "透過過專案ID取得專案資源(不用page\u0026limit)",
+                "summary": "更新單一假期",
                 "parameters": [
                     {
                         "type": "string",
@@ -2074,10 +2058,19 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "專案UUID",
-                        "name": "project-uuid",
+                        "description": "假期UUID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "更新假期",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/holidays.Update"
+                        }
                     }
                 ],
                 "responses": {
@@ -2092,7 +2085,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/tasks.List"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -2138,9 +2131,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/project-resources/{id}": {
-            "get": {
-                "description": "取得單一專案資源",
+        "/login": {
+            "post": {
+                "description": "登入",
                 "consumes": [
                     "application/json"
                 ],
@@ -2148,23 +2141,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project_resource"
+                    "login"
                 ],
-                "summary": "取得單一專案資源",
+                "summary": "登入",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "JWE Token",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "專案資源UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "登入",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/logins.Login"
+                        }
                     }
                 ],
                 "responses": {
@@ -2179,7 +2167,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/project_resources.Single"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -2225,9 +2213,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/project-types": {
+        "/policies": {
             "get": {
-                "description": "取得全部專案類別",
+                "description": "取得策略",
                 "consumes": [
                     "application/json"
                 ],
@@ -2235,9 +2223,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project_type"
+                    "policies"
                 ],
-                "summary": "取得全部專案類別",
+                "summary": "取得策略",
                 "parameters": [
                     {
                         "type": "string",
@@ -2245,20 +2233,6 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "目前頁數,請從1開始帶入",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -2273,7 +2247,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/project_types.List"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/policies.Single"
+                                            }
                                         }
                                     }
                                 }
@@ -2319,7 +2296,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "新增專案類別",
+                "description": "新增策略",
                 "consumes": [
                     "application/json"
                 ],
@@ -2327,9 +2304,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project_type"
+                    "policies"
                 ],
-                "summary": "新增專案類別",
+                "summary": "新增策略",
                 "parameters": [
                     {
                         "type": "string",
@@ -2339,12 +2316,15 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "新增專案類別",
+                        "description": "新增策略",
                         "name": "*",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/project_types.Create"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/policies.PolicyRule"
+                            }
                         }
                     }
                 ],
@@ -2404,11 +2384,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/project-types/no-pagination": {
-            "get": {
-                "description": "取得全部專案類別",
+            },
+            "delete": {
+                "description": "刪除策略",
                 "consumes": [
                     "application/json"
                 ],
@@ -2416,9 +2394,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project_type"
+                    "policies"
                 ],
-                "summary": "取得全部專案類別(不用page\u0026limit)",
+                "summary": "刪除策略",
                 "parameters": [
                     {
                         "type": "string",
@@ -2426,6 +2404,18 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "刪除策略",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/policies.PolicyRule"
+                            }
+                        }
                     }
                 ],
                 "responses": {
@@ -2440,7 +2430,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/tasks.List"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -2486,9 +2476,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/project-types/{id}": {
+        "/project-baselines": {
             "get": {
-                "description": "取得單一專案類別",
+                "description": "取得全部基準線",
                 "consumes": [
                     "application/json"
                 ],
@@ -2496,9 +2486,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project_type"
+                    "project-baseline"
                 ],
-                "summary": "取得單一專案類別",
+                "summary": "取得全部基準線",
                 "parameters": [
                     {
                         "type": "string",
@@ -2509,9 +2499,22 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "專案類別UUID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "專案UUID",
+                        "name": "project_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -2527,7 +2530,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/project_types.Single"
+                                            "$ref": "#/definitions/project_baselines.List"
                                         }
                                     }
                                 }
@@ -2572,8 +2575,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "刪除單一專案類別",
+            "post": {
+                "description": "新增基準線，並快照專案所有任務的日期、期間、花費時間及完成百分比",
                 "consumes": [
                     "application/json"
                 ],
@@ -2581,9 +2584,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project_type"
+                    "project-baseline"
                 ],
-                "summary": "刪除單一專案類別",
+                "summary": "新增基準線",
                 "parameters": [
                     {
                         "type": "string",
@@ -2593,11 +2596,13 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "專案類別UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "新增基準線",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/project_baselines.Create"
+                        }
                     }
                 ],
                 "responses": {
@@ -2656,9 +2661,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "更新單一專案類別",
+            }
+        },
+        "/project-baselines/no-pagination": {
+            "get": {
+                "description": "取得全部基準線",
                 "consumes": [
                     "application/json"
                 ],
@@ -2666,9 +2673,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project_type"
+                    "project-baseline"
                 ],
-                "summary": "更新單一專案類別",
+                "summary": "取得全部基準線(不用page\u0026limit)",
                 "parameters": [
                     {
                         "type": "string",
@@ -2679,19 +2686,9 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "專案類別UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "更新專案類別",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/project_types.Update"
-                        }
+                        "description": "專案UUID",
+                        "name": "project_uuid",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2706,7 +2703,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/project_baselines.List"
                                         }
                                     }
                                 }
@@ -2752,9 +2749,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects": {
-            "post": {
-                "description": "新增專案",
+        "/project-baselines/{id}": {
+            "get": {
+                "description": "取得單一基準線",
                 "consumes": [
                     "application/json"
                 ],
@@ -2762,9 +2759,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project"
+                    "project-baseline"
                 ],
-                "summary": "新增專案",
+                "summary": "取得單一基準線",
                 "parameters": [
                     {
                         "type": "string",
@@ -2774,13 +2771,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "新增專案",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/projects.Create"
-                        }
+                        "type": "string",
+                        "description": "基準線UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2795,7 +2790,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/project_baselines.Single"
                                         }
                                     }
                                 }
@@ -2839,11 +2834,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/projects/list": {
-            "post": {
-                "description": "取得全部專案",
+            },
+            "delete": {
+                "description": "刪除單一基準線",
                 "consumes": [
                     "application/json"
                 ],
@@ -2851,9 +2844,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project"
+                    "project-baseline"
                 ],
-                "summary": "取得全部專案",
+                "summary": "刪除單一基準線",
                 "parameters": [
                     {
                         "type": "string",
@@ -2863,26 +2856,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "目前頁數,請從1開始帶入",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
-                        "name": "limit",
-                        "in": "query",
+                        "type": "string",
+                        "description": "基準線UUID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "搜尋",
-                        "name": "*",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/projects.Filter"
-                        }
                     }
                 ],
                 "responses": {
@@ -2897,7 +2875,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/projects.List"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -2941,11 +2919,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/projects/no-pagination": {
-            "get": {
-                "description": "取得全部專案",
+            },
+            "patch": {
+                "description": "更新單一基準線",
                 "consumes": [
                     "application/json"
                 ],
@@ -2953,9 +2929,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project"
+                    "project-baseline"
                 ],
-                "summary": "取得全部專案(不用page\u0026limit)",
+                "summary": "更新單一基準線",
                 "parameters": [
                     {
                         "type": "string",
@@ -2963,6 +2939,22 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "基準線UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新基準線",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/project_baselines.Update"
+                        }
                     }
                 ],
                 "responses": {
@@ -2977,7 +2969,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/projects.List"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -3023,9 +3015,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{project-uuid}": {
+        "/project-baselines/{id}/compare": {
             "get": {
-                "description": "取得單一專案",
+                "description": "比較基準線與另一基準線或目前排程的任務差異",
                 "consumes": [
                     "application/json"
                 ],
@@ -3033,9 +3025,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project"
+                    "project-baseline"
                 ],
-                "summary": "取得單一專案",
+                "summary": "比較基準線",
                 "parameters": [
                     {
                         "type": "string",
@@ -3046,10 +3038,16 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "專案UUID",
-                        "name": "project-uuid",
+                        "description": "基準線UUID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "比較的基準線UUID (空值表示與目前排程比較)",
+                        "name": "target_uuid",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3064,7 +3062,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/projects.Single"
+                                            "$ref": "#/definitions/project_baselines.Comparison"
                                         }
                                     }
                                 }
@@ -3108,9 +3106,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "刪除單一專案",
+            }
+        },
+        "/project-baselines/{id}/select": {
+            "post": {
+                "description": "選用單一基準線，get-by-projects的基準線欄位將以此基準線填入",
                 "consumes": [
                     "application/json"
                 ],
@@ -3118,9 +3118,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project"
+                    "project-baseline"
                 ],
-                "summary": "刪除單一專案",
+                "summary": "選用單一基準線",
                 "parameters": [
                     {
                         "type": "string",
@@ -3131,8 +3131,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "專案UUID",
-                        "name": "project-uuid",
+                        "description": "基準線UUID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -3193,9 +3193,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "更新單一專案",
+            }
+        },
+        "/project-resources": {
+            "post": {
+                "description": "取得全部專案資源",
                 "consumes": [
                     "application/json"
                 ],
@@ -3203,9 +3205,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "project"
+                    "project_resource"
                 ],
-                "summary": "更新單一專案",
+                "summary": "取得全部專案資源",
                 "parameters": [
                     {
                         "type": "string",
@@ -3215,19 +3217,25 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "專案UUID",
-                        "name": "project-uuid",
-                        "in": "path",
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "更新專案",
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "搜尋",
                         "name": "*",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/projects.Update"
+                            "$ref": "#/definitions/project_resources.Filter"
                         }
                     }
                 ],
@@ -3243,7 +3251,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/project_resources.List"
                                         }
                                     }
                                 }
@@ -3289,9 +3297,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/refresh": {
+        "/project-resources/get-by-project": {
             "post": {
-                "description": "換新的令牌",
+                "description": "透過過專案ID取得專案資源",
                 "consumes": [
                     "application/json"
                 ],
@@ -3299,18 +3307,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "login"
+                    "project_resource"
                 ],
-                "summary": "換新的令牌",
+                "summary": "透過過專案ID取得專案資源(不用page\u0026limit)",
                 "parameters": [
                     {
-                        "description": "換新令牌",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/jwx.Refresh"
-                        }
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "專案UUID",
+                        "name": "project-uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3325,7 +3338,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/jwx.Token"
+                                            "$ref": "#/definitions/tasks.List"
                                         }
                                     }
                                 }
@@ -3371,9 +3384,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/register": {
-            "post": {
-                "description": "註冊",
+        "/project-resources/{id}": {
+            "get": {
+                "description": "取得單一專案資源",
                 "consumes": [
                     "application/json"
                 ],
@@ -3381,18 +3394,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "login"
+                    "project_resource"
                 ],
-                "summary": "註冊",
+                "summary": "取得單一專案資源",
                 "parameters": [
                     {
-                        "description": "註冊",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/logins.Register"
-                        }
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "專案資源UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3407,7 +3425,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/project_resources.Single"
                                         }
                                     }
                                 }
@@ -3453,9 +3471,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/resources": {
-            "post": {
-                "description": "新增資源",
+        "/project-scenarios": {
+            "get": {
+                "description": "取得全部情境",
                 "consumes": [
                     "application/json"
                 ],
@@ -3463,9 +3481,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "resource"
+                    "project-scenario"
                 ],
-                "summary": "新增資源",
+                "summary": "取得全部情境",
                 "parameters": [
                     {
                         "type": "string",
@@ -3475,13 +3493,24 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "新增資源",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/resources.Create"
-                        }
+                        "type": "string",
+                        "description": "專案UUID",
+                        "name": "project_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3496,7 +3525,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/project_scenarios.List"
                                         }
                                     }
                                 }
@@ -3540,11 +3569,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/resources/import": {
+            },
             "post": {
-                "description": "匯入資源",
+                "description": "新增情境，將專案的任務及資源指派複製至隱藏的沙盒專案，以情境專案UUID編輯情境的任務不影響目前排程",
                 "consumes": [
                     "application/json"
                 ],
@@ -3552,9 +3579,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "resource"
+                    "project-scenario"
                 ],
-                "summary": "匯入資源",
+                "summary": "新增情境",
                 "parameters": [
                     {
                         "type": "string",
@@ -3564,12 +3591,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "匯入資源",
+                        "description": "新增情境",
                         "name": "*",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/resources.Import"
+                            "$ref": "#/definitions/project_scenarios.Create"
                         }
                     }
                 ],
@@ -3631,9 +3658,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/resources/list": {
-            "post": {
-                "description": "取得全部資源",
+        "/project-scenarios/no-pagination": {
+            "get": {
+                "description": "取得全部情境",
                 "consumes": [
                     "application/json"
                 ],
@@ -3641,9 +3668,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "resource"
+                    "project-scenario"
                 ],
-                "summary": "取得全部資源",
+                "summary": "取得全部情境(不用page\u0026limit)",
                 "parameters": [
                     {
                         "type": "string",
@@ -3653,26 +3680,10 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "目前頁數,請從1開始帶入",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "description": "搜尋",
-                        "name": "*",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/resources.Filter"
-                        }
+                        "type": "string",
+                        "description": "專案UUID",
+                        "name": "project_uuid",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3687,7 +3698,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/resources.List"
+                                            "$ref": "#/definitions/project_scenarios.List"
                                         }
                                     }
                                 }
@@ -3733,9 +3744,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/resources/no-pagination": {
+        "/project-scenarios/{id}": {
             "get": {
-                "description": "取得全部資源",
+                "description": "取得單一情境",
                 "consumes": [
                     "application/json"
                 ],
@@ -3743,9 +3754,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "resource"
+                    "project-scenario"
                 ],
-                "summary": "取得全部資源(不用page\u0026limit)",
+                "summary": "取得單一情境",
                 "parameters": [
                     {
                         "type": "string",
@@ -3753,6 +3764,13 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "情境UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3767,7 +3785,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/resources.List"
+                                            "$ref": "#/definitions/project_scenarios.Single"
                                         }
                                     }
                                 }
@@ -3811,11 +3829,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/resources/{resource-uuid}": {
-            "get": {
-                "description": "取得單一資源",
+            },
+            "delete": {
+                "description": "捨棄單一情境及其沙盒專案的任務",
                 "consumes": [
                     "application/json"
                 ],
@@ -3823,9 +3839,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "resource"
+                    "project-scenario"
                 ],
-                "summary": "取得單一資源",
+                "summary": "捨棄單一情境",
                 "parameters": [
                     {
                         "type": "string",
@@ -3836,8 +3852,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "資源UUID",
-                        "name": "resource-uuid",
+                        "description": "情境UUID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -3854,7 +3870,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/resources.Single"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -3899,8 +3915,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "刪除單一資源",
+            "patch": {
+                "description": "更新單一情境",
                 "consumes": [
                     "application/json"
                 ],
@@ -3908,9 +3924,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "resource"
+                    "project-scenario"
                 ],
-                "summary": "刪除單一資源",
+                "summary": "更新單一情境",
                 "parameters": [
                     {
                         "type": "string",
@@ -3921,10 +3937,19 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "資源UUID",
-                        "name": "resource-uuid",
+                        "description": "情境UUID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "更新情境",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/project_scenarios.Update"
+                        }
                     }
                 ],
                 "responses": {
@@ -3983,9 +4008,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "更新單一資源",
+            }
+        },
+        "/project-scenarios/{id}/compare": {
+            "get": {
+                "description": "比較情境與目前排程的結束日期、花費、資源負載及任務差異",
                 "consumes": [
                     "application/json"
                 ],
@@ -3993,9 +4020,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "resource"
+                    "project-scenario"
                 ],
-                "summary": "更新單一資源",
+                "summary": "比較情境",
                 "parameters": [
                     {
                         "type": "string",
@@ -4006,19 +4033,10 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "資源UUID",
-                        "name": "resource-uuid",
+                        "description": "情境UUID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "更新資源",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/resources.Update"
-                        }
                     }
                 ],
                 "responses": {
@@ -4033,7 +4051,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/project_scenarios.Comparison"
                                         }
                                     }
                                 }
@@ -4079,9 +4097,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/roles": {
-            "get": {
-                "description": "取得全部角色",
+        "/project-scenarios/{id}/promote": {
+            "post": {
+                "description": "以情境的排程取代專案目前的排程(經由任務批次更新的檢查)，並移除情境",
                 "consumes": [
                     "application/json"
                 ],
@@ -4089,9 +4107,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "role"
+                    "project-scenario"
                 ],
-                "summary": "取得全部角色",
+                "summary": "採用情境",
                 "parameters": [
                     {
                         "type": "string",
@@ -4101,17 +4119,10 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "目前頁數,請從1開始帶入",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
-                        "name": "limit",
-                        "in": "query",
+                        "type": "string",
+                        "description": "情境UUID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -4127,7 +4138,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/roles.List"
+                                            "$ref": "#/definitions/tasks.Result"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "使用者無權限或排程不合法",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -4171,9 +4200,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "新增角色",
+            }
+        },
+        "/project-templates": {
+            "get": {
+                "description": "取得全部範本",
                 "consumes": [
                     "application/json"
                 ],
@@ -4181,9 +4212,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "role"
+                    "project-template"
                 ],
-                "summary": "新增角色",
+                "summary": "取得全部範本",
                 "parameters": [
                     {
                         "type": "string",
@@ -4193,13 +4224,30 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "新增角色",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/roles.Create"
-                        }
+                        "type": "string",
+                        "description": "名稱",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "類別ID",
+                        "name": "type_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -4214,7 +4262,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/project_templates.List"
                                         }
                                     }
                                 }
@@ -4258,11 +4306,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/roles/no-pagination": {
-            "get": {
-                "description": "取得全部角色",
+            },
+            "post": {
+                "description": "將專案另存為範本，保存任務(含大綱、前置任務、資源指派)、專案資源及事件標記，日期以專案起始日期為基準",
                 "consumes": [
                     "application/json"
                 ],
@@ -4270,9 +4316,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "role"
+                    "project-template"
                 ],
-                "summary": "取得全部角色(不用page\u0026limit)",
+                "summary": "新增範本",
                 "parameters": [
                     {
                         "type": "string",
@@ -4280,6 +4326,15 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "新增範本",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/project_templates.Create"
+                        }
                     }
                 ],
                 "responses": {
@@ -4294,7 +4349,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/roles.List"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -4340,9 +4395,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/roles/{id}": {
+        "/project-templates/no-pagination": {
             "get": {
-                "description": "取得單一角色",
+                "description": "取得全部範本",
                 "consumes": [
                     "application/json"
                 ],
@@ -4350,9 +4405,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "role"
+                    "project-template"
                 ],
-                "summary": "取得單一角色",
+                "summary": "取得全部範本(不用page\u0026limit)",
                 "parameters": [
                     {
                         "type": "string",
@@ -4363,10 +4418,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "角色ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "名稱",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "類別ID",
+                        "name": "type_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -4381,7 +4441,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/roles.Single"
+                                            "$ref": "#/definitions/project_templates.List"
                                         }
                                     }
                                 }
@@ -4425,9 +4485,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "刪除單一角色",
+            }
+        },
+        "/project-templates/{id}": {
+            "get": {
+                "description": "取得單一範本",
                 "consumes": [
                     "application/json"
                 ],
@@ -4435,9 +4497,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "role"
+                    "project-template"
                 ],
-                "summary": "刪除單一角色",
+                "summary": "取得單一範本",
                 "parameters": [
                     {
                         "type": "string",
@@ -4448,19 +4510,10 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "角色ID",
+                        "description": "範本UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "更新角色",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/roles.Update"
-                        }
                     }
                 ],
                 "responses": {
@@ -4475,7 +4528,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/project_templates.Single"
                                         }
                                     }
                                 }
@@ -4520,8 +4573,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "patch": {
-                "description": "更新單一角色",
+            "delete": {
+                "description": "刪除單一範本",
                 "consumes": [
                     "application/json"
                 ],
@@ -4529,9 +4582,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "role"
+                    "project-template"
                 ],
-                "summary": "更新單一角色",
+                "summary": "刪除單一範本",
                 "parameters": [
                     {
                         "type": "string",
@@ -4542,19 +4595,10 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "角色ID",
+                        "description": "範本UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "更新角色",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/roles.Update"
-                        }
                     }
                 ],
                 "responses": {
@@ -4613,11 +4657,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/tasks": {
-            "post": {
-                "description": "新增單一任務",
+            },
+            "patch": {
+                "description": "更新單一範本",
                 "consumes": [
                     "application/json"
                 ],
@@ -4625,9 +4667,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "project-template"
                 ],
-                "summary": "新增單一任務",
+                "summary": "更新單一範本",
                 "parameters": [
                     {
                         "type": "string",
@@ -4637,12 +4679,19 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "新增任務",
+                        "type": "string",
+                        "description": "範本UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新範本",
                         "name": "*",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tasks.Create"
+                            "$ref": "#/definitions/project_templates.Update"
                         }
                     }
                 ],
@@ -4702,9 +4751,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "刪除單一任務",
+            }
+        },
+        "/project-types": {
+            "get": {
+                "description": "取得全部專案類別",
                 "consumes": [
                     "application/json"
                 ],
@@ -4712,9 +4763,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "project_type"
                 ],
-                "summary": "刪除單一任務",
+                "summary": "取得全部專案類別",
                 "parameters": [
                     {
                         "type": "string",
@@ -4724,10 +4775,17 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "任務UUID",
-                        "name": "task-uuid",
-                        "in": "path",
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -4743,7 +4801,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/project_types.List"
                                         }
                                     }
                                 }
@@ -4787,11 +4845,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/tasks/create-all": {
+            },
             "post": {
-                "description": "新增全任務",
+                "description": "新增專案類別",
                 "consumes": [
                     "application/json"
                 ],
@@ -4799,9 +4855,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "project_type"
                 ],
-                "summary": "新增全任務",
+                "summary": "新增專案類別",
                 "parameters": [
                     {
                         "type": "string",
@@ -4811,15 +4867,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "新增任務",
+                        "description": "新增專案類別",
                         "name": "*",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/tasks.Create"
-                            }
+                            "$ref": "#/definitions/project_types.Create"
                         }
                     }
                 ],
@@ -4881,9 +4934,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks/get-by-projects": {
-            "post": {
-                "description": "取得多個專案含任務(不用page\u0026limit)",
+        "/project-types/no-pagination": {
+            "get": {
+                "description": "取得全部專案類別",
                 "consumes": [
                     "application/json"
                 ],
@@ -4891,9 +4944,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "project_type"
                 ],
-                "summary": "取得多個專案含任務(不用page\u0026limit)",
+                "summary": "取得全部專案類別(不用page\u0026limit)",
                 "parameters": [
                     {
                         "type": "string",
@@ -4901,15 +4954,6 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "description": "專案UUIDs",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/tasks.ProjectIDs"
-                        }
                     }
                 ],
                 "responses": {
@@ -4970,9 +5014,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks/import": {
-            "post": {
-                "description": "匯入專案",
+        "/project-types/{id}": {
+            "get": {
+                "description": "取得單一專案類別",
                 "consumes": [
                     "application/json"
                 ],
@@ -4980,9 +5024,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "project_type"
                 ],
-                "summary": "匯入專案",
+                "summary": "取得單一專案類別",
                 "parameters": [
                     {
                         "type": "string",
@@ -4992,13 +5036,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "匯入專案",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/tasks.Import"
-                        }
+                        "type": "string",
+                        "description": "專案類別UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5013,7 +5055,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/project_types.Single"
                                         }
                                     }
                                 }
@@ -5057,11 +5099,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/tasks/no-pagination/no-sub-filter": {
-            "get": {
-                "description": "取得全部不算階層的任務(不用page\u0026limit)",
+            },
+            "delete": {
+                "description": "刪除單一專案類別",
                 "consumes": [
                     "application/json"
                 ],
@@ -5069,9 +5109,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "project_type"
                 ],
-                "summary": "取得全部不算階層的任務(不用page\u0026limit)",
+                "summary": "刪除單一專案類別",
                 "parameters": [
                     {
                         "type": "string",
@@ -5079,6 +5119,13 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "專案類別UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5093,7 +5140,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/tasks.List"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -5137,11 +5184,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/tasks/update-all": {
+            },
             "patch": {
-                "description": "更新全任務",
+                "description": "更新單一專案類別",
                 "consumes": [
                     "application/json"
                 ],
@@ -5149,9 +5194,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "project_type"
                 ],
-                "summary": "更新全任務",
+                "summary": "更新單一專案類別",
                 "parameters": [
                     {
                         "type": "string",
@@ -5161,15 +5206,19 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "更新任務",
-                        "name": "*",
-                        "in": "body",
+                        "type": "string",
+                        "description": "專案類別UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新專案類別",
+                        "name": "*",
+                        "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/tasks.Update"
-                            }
+                            "$ref": "#/definitions/project_types.Update"
                         }
                     }
                 ],
@@ -5231,9 +5280,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks/{task-uuid}": {
-            "get": {
-                "description": "取得單一任務",
+        "/projects": {
+            "post": {
+                "description": "新增專案，帶入template_uuid時以範本建立任務、專案資源及事件標記，任務日期依專案起始日期平移",
                 "consumes": [
                     "application/json"
                 ],
@@ -5241,9 +5290,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "project"
                 ],
-                "summary": "取得單一任務",
+                "summary": "新增專案",
                 "parameters": [
                     {
                         "type": "string",
@@ -5253,11 +5302,13 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "任務UUID",
-                        "name": "task-uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "新增專案",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/projects.Create"
+                        }
                     }
                 ],
                 "responses": {
@@ -5272,7 +5323,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/tasks.Single"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -5316,9 +5367,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "更新單一任務",
+            }
+        },
+        "/projects/list": {
+            "post": {
+                "description": "取得全部專案",
                 "consumes": [
                     "application/json"
                 ],
@@ -5326,9 +5379,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "project"
                 ],
-                "summary": "更新單一任務",
+                "summary": "取得全部專案",
                 "parameters": [
                     {
                         "type": "string",
@@ -5338,19 +5391,25 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "任務UUID",
-                        "name": "task-uuid",
-                        "in": "path",
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "更新任務",
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "搜尋",
                         "name": "*",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tasks.Update"
+                            "$ref": "#/definitions/projects.Filter"
                         }
                     }
                 ],
@@ -5366,7 +5425,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/projects.List"
                                         }
                                     }
                                 }
@@ -5412,9 +5471,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/projects/milestones": {
             "get": {
-                "description": "取得全部使用者(不用page和limit)",
+                "description": "取得可存取專案的里程碑及其狀態 (未到期、已達成、逾期)，依里程碑日期排序",
                 "consumes": [
                     "application/json"
                 ],
@@ -5422,9 +5481,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "project"
                 ],
-                "summary": "取得全部使用者(不用page和limit)",
+                "summary": "取得里程碑報表",
                 "parameters": [
                     {
                         "type": "string",
@@ -5432,6 +5491,34 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "專案UUIDs (空值表示可存取的全部專案)",
+                        "name": "project_uuids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "起始日期 (YYYY-MM-DD)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束日期 (YYYY-MM-DD)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "狀態 (due、achieved、late)",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -5446,7 +5533,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/users.ListNoPagination"
+                                            "$ref": "#/definitions/tasks.MilestoneList"
                                         }
                                     }
                                 }
@@ -5492,9 +5579,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/authenticator/current-user": {
-            "post": {
-                "description": "啟用驗證器",
+        "/projects/no-pagination": {
+            "get": {
+                "description": "取得全部專案",
                 "consumes": [
                     "application/json"
                 ],
@@ -5502,9 +5589,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "project"
                 ],
-                "summary": "啟用驗證器",
+                "summary": "取得全部專案(不用page\u0026limit)",
                 "parameters": [
                     {
                         "type": "string",
@@ -5512,15 +5599,6 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "description": "啟用驗證器",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/users.EnableAuthenticator"
-                        }
                     }
                 ],
                 "responses": {
@@ -5535,7 +5613,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/projects.List"
                                         }
                                     }
                                 }
@@ -5581,9 +5659,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/change-email/current-user": {
-            "post": {
-                "description": "更換電子郵件",
+        "/projects/{project-uuid}": {
+            "get": {
+                "description": "取得單一專案",
                 "consumes": [
                     "application/json"
                 ],
@@ -5591,9 +5669,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "project"
                 ],
-                "summary": "更換電子郵件",
+                "summary": "取得單一專案",
                 "parameters": [
                     {
                         "type": "string",
@@ -5603,13 +5681,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "更換電子郵件",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/users.ChangeEmail"
-                        }
+                        "type": "string",
+                        "description": "專案UUID",
+                        "name": "project-uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5624,7 +5700,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/projects.Single"
                                         }
                                     }
                                 }
@@ -5668,11 +5744,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/check-duplicate": {
-            "post": {
-                "description": "檢查使用者是否重複",
+            },
+            "delete": {
+                "description": "刪除單一專案",
                 "consumes": [
                     "application/json"
                 ],
@@ -5680,18 +5754,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "project"
                 ],
-                "summary": "檢查使用者是否重複",
+                "summary": "刪除單一專案",
                 "parameters": [
                     {
-                        "description": "檢查使用者是否重複",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/users.Filter"
-                        }
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "專案UUID",
+                        "name": "project-uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5750,11 +5829,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/current-user": {
-            "get": {
-                "description": "取得當前使用者",
+            },
+            "patch": {
+                "description": "更新單一專案",
                 "consumes": [
                     "application/json"
                 ],
@@ -5762,9 +5839,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "project"
                 ],
-                "summary": "取得當前使用者",
+                "summary": "更新單一專案",
                 "parameters": [
                     {
                         "type": "string",
@@ -5772,6 +5849,22 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "專案UUID",
+                        "name": "project-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新專案",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/projects.Update"
+                        }
                     }
                 ],
                 "responses": {
@@ -5786,7 +5879,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/users.Single"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -5830,9 +5923,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "更新當前使用者",
+            }
+        },
+        "/projects/{project-uuid}/clone": {
+            "post": {
+                "description": "複製專案的任務、專案資源及事件標記為新專案，任務日期依新的起始日期以工作日平移，完成百分比歸零",
                 "consumes": [
                     "application/json"
                 ],
@@ -5840,9 +5935,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "project"
                 ],
-                "summary": "更新當前使用者",
+                "summary": "複製專案",
                 "parameters": [
                     {
                         "type": "string",
@@ -5852,12 +5947,19 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "更新使用者",
+                        "type": "string",
+                        "description": "專案UUID",
+                        "name": "project-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "複製專案",
                         "name": "*",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/users.Update"
+                            "$ref": "#/definitions/projects.Clone"
                         }
                     }
                 ],
@@ -5919,9 +6021,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/enable/current-user": {
-            "post": {
-                "description": "啟用當前使用者",
+        "/projects/{project-uuid}/critical-path": {
+            "get": {
+                "description": "取得專案要徑",
                 "consumes": [
                     "application/json"
                 ],
@@ -5929,9 +6031,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "project"
                 ],
-                "summary": "啟用當前使用者",
+                "summary": "取得專案要徑",
                 "parameters": [
                     {
                         "type": "string",
@@ -5941,13 +6043,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "啟用當前使用者",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/users.Enable"
-                        }
+                        "type": "string",
+                        "description": "專案UUID",
+                        "name": "project-uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5962,7 +6062,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/tasks.CriticalPath"
                                         }
                                     }
                                 }
@@ -6008,9 +6108,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/list": {
-            "post": {
-                "description": "取得全部使用者",
+        "/projects/{project-uuid}/earned-value": {
+            "get": {
+                "description": "依狀態日期取得專案、摘要任務及各任務的實獲值指標 (PV、EV、AC、SV、CV、SPI、CPI、EAC、ETC)",
                 "consumes": [
                     "application/json"
                 ],
@@ -6018,9 +6118,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "project"
                 ],
-                "summary": "取得全部使用者",
+                "summary": "取得專案實獲值",
                 "parameters": [
                     {
                         "type": "string",
@@ -6030,18 +6130,17 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "目前頁數,請從1開始帶入",
-                        "name": "page",
-                        "in": "query",
+                        "type": "string",
+                        "description": "專案UUID",
+                        "name": "project-uuid",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
+                        "type": "string",
+                        "description": "狀態日期 (YYYY-MM-DD，空值表示今日)",
+                        "name": "status_date",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -6056,7 +6155,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/users.List"
+                                            "$ref": "#/definitions/tasks.EarnedValue"
                                         }
                                     }
                                 }
@@ -6102,9 +6201,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/reset-password/current-user": {
-            "post": {
-                "description": "重設密碼",
+        "/projects/{project-uuid}/forecast": {
+            "get": {
+                "description": "依任務的三點估計(PERT)以蒙地卡羅模擬相依網路，取得專案及里程碑的P50、P80、P95完成日期及各任務的要徑指數，帶入相同亂數種子可重現相同結果",
                 "consumes": [
                     "application/json"
                 ],
@@ -6112,9 +6211,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "project"
                 ],
-                "summary": "重設密碼",
+                "summary": "取得專案完成日期預測",
                 "parameters": [
                     {
                         "type": "string",
@@ -6124,13 +6223,33 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "重設密碼",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/users.ResetPassword"
-                        }
+                        "type": "string",
+                        "description": "專案UUID",
+                        "name": "project-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "里程碑任務UUIDs (空值表示專案全部里程碑)",
+                        "name": "milestone_uuids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "模擬次數 (預設1000，最高10000)",
+                        "name": "iterations",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "亂數種子 (空值表示隨機產生)",
+                        "name": "seed",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -6145,6 +6264,24 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
+                                            "$ref": "#/definitions/tasks.Forecast"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "里程碑不屬於專案或前任設定錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
                                             "type": "string"
                                         }
                                     }
@@ -6191,9 +6328,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/verify-email/current-user": {
+        "/projects/{project-uuid}/level-resources": {
             "post": {
-                "description": "驗證電子郵件",
+                "description": "在浮時內延後非要徑任務(依優先順序及限制)以排除資源過度配置，preview模式僅回傳建議的日期變更，commit模式套用變更",
                 "consumes": [
                     "application/json"
                 ],
@@ -6201,9 +6338,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "project"
                 ],
-                "summary": "驗證電子郵件",
+                "summary": "調配專案資源",
                 "parameters": [
                     {
                         "type": "string",
@@ -6211,6 +6348,22 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "專案UUID",
+                        "name": "project-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "資源調配",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tasks.Level"
+                        }
                     }
                 ],
                 "responses": {
@@ -6225,7 +6378,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/tasks.LevelResult"
                                         }
                                     }
                                 }
@@ -6271,9 +6424,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
-            "get": {
-                "description": "取得單一使用者",
+        "/projects/{project-uuid}/roll-up": {
+            "post": {
+                "description": "依子任務重新計算專案各摘要任務的起始、結束日期、期間、花費及完成百分比(依期間加權)，用於修復既有專案",
                 "consumes": [
                     "application/json"
                 ],
@@ -6281,9 +6434,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "project"
                 ],
-                "summary": "取得單一使用者",
+                "summary": "重新彙總專案摘要任務",
                 "parameters": [
                     {
                         "type": "string",
@@ -6294,8 +6447,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "使用者ID",
-                        "name": "id",
+                        "description": "專案UUID",
+                        "name": "project-uuid",
                         "in": "path",
                         "required": true
                     }
@@ -6312,7 +6465,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/users.Single"
+                                            "$ref": "#/definitions/tasks.RollUpResult"
                                         }
                                     }
                                 }
@@ -6356,9 +6509,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "刪除單一使用者",
+            }
+        },
+        "/projects/{project-uuid}/variance": {
+            "get": {
+                "description": "取得專案各任務(含摘要任務彙總)與基準線的起始、完成、期間及花費差異",
                 "consumes": [
                     "application/json"
                 ],
@@ -6366,9 +6521,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "project"
                 ],
-                "summary": "刪除單一使用者",
+                "summary": "取得專案基準線差異報表",
                 "parameters": [
                     {
                         "type": "string",
@@ -6379,19 +6534,22 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "使用者ID",
-                        "name": "id",
+                        "description": "專案UUID",
+                        "name": "project-uuid",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "更新使用者",
-                        "name": "*",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/users.Update"
-                        }
+                        "type": "string",
+                        "description": "基準線UUID (空值表示已選取的基準線)",
+                        "name": "baseline",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序 (outline: 大綱順序、slippage: 延誤最嚴重者優先)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -6406,7 +6564,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/project_baselines.VarianceReport"
                                         }
                                     }
                                 }
@@ -6450,9 +6608,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "更新使用者",
+            }
+        },
+        "/refresh": {
+            "post": {
+                "description": "換新的令牌",
                 "consumes": [
                     "application/json"
                 ],
@@ -6460,31 +6620,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "login"
                 ],
-                "summary": "更新使用者",
+                "summary": "換新的令牌",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "JWE Token",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "使用者ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "更新使用者",
+                        "description": "換新令牌",
                         "name": "*",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/users.Update"
+                            "$ref": "#/definitions/jwx.Refresh"
                         }
                     }
                 ],
@@ -6500,7 +6646,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/jwx.Token"
                                         }
                                     }
                                 }
@@ -6546,9 +6692,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/verify": {
+        "/register": {
             "post": {
-                "description": "驗證",
+                "description": "註冊",
                 "consumes": [
                     "application/json"
                 ],
@@ -6558,15 +6704,15 @@ const docTemplate = `{
                 "tags": [
                     "login"
                 ],
-                "summary": "驗證",
+                "summary": "註冊",
                 "parameters": [
                     {
-                        "description": "驗證",
+                        "description": "註冊",
                         "name": "*",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/logins.Verify"
+                            "$ref": "#/definitions/logins.Register"
                         }
                     }
                 ],
@@ -6582,7 +6728,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/jwx.Token"
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -6628,9 +6774,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/work-days": {
+        "/resource-exceptions": {
             "get": {
-                "description": "取得全部工作時間",
+                "description": "取得全部資源例外日",
                 "consumes": [
                     "application/json"
                 ],
@@ -6638,9 +6784,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "work_day"
+                    "resource_exception"
                 ],
-                "summary": "取得全部工作時間",
+                "summary": "取得全部資源例外日",
                 "parameters": [
                     {
                         "type": "string",
@@ -6649,6 +6795,12 @@ const docTemplate = `{
                         "in": "header",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "資源UUID",
+                        "name": "resource_uuid",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "目前頁數,請從1開始帶入",
@@ -6676,7 +6828,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/work_days.List"
+                                            "$ref": "#/definitions/resource_exceptions.List"
                                         }
                                     }
                                 }
@@ -6722,7 +6874,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "新增工作時間",
+                "description": "新增資源例外日",
                 "consumes": [
                     "application/json"
                 ],
@@ -6730,9 +6882,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "work_day"
+                    "resource_exception"
                 ],
-                "summary": "新增工作時間",
+                "summary": "新增資源例外日",
                 "parameters": [
                     {
                         "type": "string",
@@ -6742,12 +6894,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "新增工作時間",
+                        "description": "新增資源例外日",
                         "name": "*",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/work_days.Create"
+                            "$ref": "#/definitions/resource_exceptions.Create"
                         }
                     }
                 ],
@@ -6809,9 +6961,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/work-days/no-pagination": {
+        "/resource-exceptions/no-pagination": {
             "get": {
-                "description": "取得全部工作時間",
+                "description": "取得全部資源例外日",
                 "consumes": [
                     "application/json"
                 ],
@@ -6819,9 +6971,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "work_day"
+                    "resource_exception"
                 ],
-                "summary": "取得全部工作時間(不用page\u0026limit)",
+                "summary": "取得全部資源例外日(不用page\u0026limit)",
                 "parameters": [
                     {
                         "type": "string",
@@ -6829,6 +6981,12 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "資源UUID",
+                        "name": "resource_uuid",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -6843,7 +7001,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/work_days.List"
+                                            "$ref": "#/definitions/tasks.List"
                                         }
                                     }
                                 }
@@ -6889,9 +7047,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/work-days/{id}": {
+        "/resource-exceptions/{id}": {
             "get": {
-                "description": "取得單一工作時間",
+                "description": "取得單一資源例外日",
                 "consumes": [
                     "application/json"
                 ],
@@ -6899,9 +7057,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "work_day"
+                    "resource_exception"
                 ],
-                "summary": "取得單一工作時間",
+                "summary": "取得單一資源例外日",
                 "parameters": [
                     {
                         "type": "string",
@@ -6912,7 +7070,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "工作時間UUID",
+                        "description": "資源例外日UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -6930,7 +7088,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/work_days.Single"
+                                            "$ref": "#/definitions/resource_exceptions.Single"
                                         }
                                     }
                                 }
@@ -6976,7 +7134,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "刪除單一工作時間",
+                "description": "刪除單一資源例外日",
                 "consumes": [
                     "application/json"
                 ],
@@ -6984,9 +7142,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "work_day"
+                    "resource_exception"
                 ],
-                "summary": "刪除單一工作時間",
+                "summary": "刪除單一資源例外日",
                 "parameters": [
                     {
                         "type": "string",
@@ -6997,7 +7155,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "工作時間UUID",
+                        "description": "資源例外日UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -7061,7 +7219,7 @@ const docTemplate = `{
                 }
             },
             "patch": {
-                "description": "更新單一工作時間",
+                "description": "更新單一資源例外日",
                 "consumes": [
                     "application/json"
                 ],
@@ -7069,9 +7227,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "work_day"
+                    "resource_exception"
                 ],
-                "summary": "更新單一工作時間",
+                "summary": "更新單一資源例外日",
                 "parameters": [
                     {
                         "type": "string",
@@ -7082,18 +7240,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "工作時間UUID",
+                        "description": "資源例外日UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "更新工作時間",
+                        "description": "更新資源例外日",
                         "name": "*",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/work_days.Update"
+                            "$ref": "#/definitions/resource_exceptions.Update"
                         }
                     }
                 ],
@@ -7154,212 +7312,8428 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "affiliations.Create": {
-            "type": "object",
-            "required": [
-                "dept_id"
-            ],
-            "properties": {
-                "dept_id": {
-                    "description": "部門ID",
-                    "type": "string"
-                },
-                "job_title": {
-                    "description": "職稱",
-                    "type": "string"
-                }
-            }
-        },
-        "affiliations.CreateForDept": {
-            "type": "object",
-            "required": [
-                "user_id"
-            ],
-            "properties": {
-                "job_title": {
-                    "description": "職稱",
-                    "type": "string"
-                },
-                "user_id": {
-                    "description": "使用者ID",
-                    "type": "string"
-                }
-            }
-        },
-        "affiliations.Single": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "description": "創建時間",
-                    "type": "string"
-                },
-                "deleted_at": {
-                    "description": "刪除時間",
-                    "type": "string"
-                },
-                "dept_id": {
-                    "description": "部門ID",
-                    "type": "string"
-                },
-                "id": {
-                    "description": "表ID",
-                    "type": "string"
-                },
-                "is_supervisor": {
-                    "description": "是否為主管",
-                    "type": "boolean"
-                },
-                "job_title": {
-                    "description": "職稱",
-                    "type": "string"
-                },
-                "name": {
-                    "description": "使用者名稱",
-                    "type": "string"
-                },
-                "updated_at": {
-                    "description": "更新時間",
-                    "type": "string"
-                },
-                "user_id": {
-                    "description": "使用者ID",
-                    "type": "string"
-                }
-            }
+        },
+        "/resources": {
+            "post": {
+                "description": "新增資源",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "新增資源",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "新增資源",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.Create"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/resources/import": {
+            "post": {
+                "description": "匯入資源",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "匯入資源",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "匯入資源",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.Import"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/resources/list": {
+            "post": {
+                "description": "取得全部資源",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "取得全部資源",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "搜尋",
+                        "name": "*",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/resources.Filter"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/resources.List"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/resources/load": {
+            "get": {
+                "description": "依日期區間取得各資源跨專案的每日或每週分配工時",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "取得資源負載",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "起始日期 (YYYY-MM-DD)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束日期 (YYYY-MM-DD)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "區間 (day、week，預設day)",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "資源UUIDs",
+                        "name": "resource_uuids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "群組",
+                        "name": "resource_groups",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/resources.LoadList"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/resources/no-pagination": {
+            "get": {
+                "description": "取得全部資源",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "取得全部資源(不用page\u0026limit)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/resources.List"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/resources/{resource-uuid}": {
+            "get": {
+                "description": "取得單一資源",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "取得單一資源",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "資源UUID",
+                        "name": "resource-uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/resources.Single"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "刪除單一資源",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "刪除單一資源",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "資源UUID",
+                        "name": "resource-uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "更新單一資源",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "更新單一資源",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "資源UUID",
+                        "name": "resource-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新資源",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.Update"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/roles": {
+            "get": {
+                "description": "取得全部角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "role"
+                ],
+                "summary": "取得全部角色",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/roles.List"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "新增角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "role"
+                ],
+                "summary": "新增角色",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "新增角色",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/roles.Create"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/no-pagination": {
+            "get": {
+                "description": "取得全部角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "role"
+                ],
+                "summary": "取得全部角色(不用page\u0026limit)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/roles.List"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/{id}": {
+            "get": {
+                "description": "取得單一角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "role"
+                ],
+                "summary": "取得單一角色",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "角色ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/roles.Single"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "刪除單一角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "role"
+                ],
+                "summary": "刪除單一角色",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "角色ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新角色",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/roles.Update"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "更新單一角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "role"
+                ],
+                "summary": "更新單一角色",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "角色ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新角色",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/roles.Update"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks": {
+            "post": {
+                "description": "新增單一任務，帶入recurrence時此任務為摘要任務，依週期規則及工作行事曆產生週期任務為其子任務；帶入segments時檢查分段(不得超出任務日期、總期間須與期間相符)，合併重疊的分段並依分段重新計算任務日期及期間",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "新增單一任務",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "新增任務",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tasks.Create"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/tasks.Result"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "刪除單一任務",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "刪除單一任務",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "任務UUID",
+                        "name": "task-uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/create-all": {
+            "post": {
+                "description": "新增全任務",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "新增全任務",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "新增任務",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/tasks.Create"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/tasks.Result"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/get-by-projects": {
+            "post": {
+                "description": "取得多個專案含任務(不用page\u0026limit)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "取得多個專案含任務(不用page\u0026limit)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "專案UUIDs",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tasks.ProjectIDs"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/tasks.List"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/import": {
+            "post": {
+                "description": "匯入專案",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "匯入專案",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "匯入專案",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tasks.Import"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/tasks.Result"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/no-pagination/no-sub-filter": {
+            "get": {
+                "description": "取得全部不算階層的任務(不用page\u0026limit)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "取得全部不算階層的任務(不用page\u0026limit)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/tasks.List"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/update-all": {
+            "patch": {
+                "description": "更新全任務",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "更新全任務",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "更新任務",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/tasks.Update"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/tasks.Result"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task-uuid}": {
+            "get": {
+                "description": "取得單一任務",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "取得單一任務",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "任務UUID",
+                        "name": "task-uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/tasks.Single"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "更新單一任務，帶入segments時檢查分段並合併重疊的分段，任務日期及期間依分段重新計算",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "更新單一任務",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "任務UUID",
+                        "name": "task-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新任務",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tasks.Update"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/tasks.Result"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task-uuid}/checklist": {
+            "get": {
+                "description": "依排序取得任務的檢查清單項目及完成比例",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task_checklist"
+                ],
+                "summary": "取得任務的檢查清單",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "任務UUID",
+                        "name": "task-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "負責資源UUID",
+                        "name": "resource_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "是否完成",
+                        "name": "is_done",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/task_checklist_items.List"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "新增項目於任務檢查清單的最後，專案依檢查清單計算進度時同步更新任務進度",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task_checklist"
+                ],
+                "summary": "新增任務的檢查清單項目",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "任務UUID",
+                        "name": "task-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "新增檢查清單項目",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/task_checklist_items.Create"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "使用者無權限或資源不存在",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task-uuid}/checklist/reorder": {
+            "patch": {
+                "description": "依傳入的項目ID順序重新排序任務的檢查清單，須包含全部項目",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task_checklist"
+                ],
+                "summary": "重新排序任務的檢查清單",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "任務UUID",
+                        "name": "task-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "重新排序檢查清單",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/task_checklist_items.Reorder"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "type": "string"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "使用者無權限或項目不符",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task-uuid}/checklist/{id}": {
+            "delete": {
+                "description": "刪除檢查清單項目，專案依檢查清單計算進度時同步更新任務進度",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task_checklist"
+                ],
+                "summary": "刪除任務的檢查清單項目",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "任務UUID",
+                        "name": "task-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "檢查清單項目ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "使用者無權限",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "更新檢查清單項目，專案依檢查清單計算進度時同步更新任務進度",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task_checklist"
+                ],
+                "summary": "更新任務的檢查清單項目",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "任務UUID",
+                        "name": "task-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "檢查清單項目ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新檢查清單項目",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/task_checklist_items.Update"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "使用者無權限或資源不存在",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task-uuid}/duplicate": {
+            "post": {
+                "description": "任務連同其子任務、分段、標示、資源及附件複製至原專案或其他專案",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "複製任務",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "任務UUID",
+                        "name": "task-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "複製任務",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tasks.Duplicate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/tasks.Result"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "無法複製",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task-uuid}/indent": {
+            "patch": {
+                "description": "任務連同其子任務成為前一個同階任務的最後一個子任務",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "任務降階",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "任務UUID",
+                        "name": "task-uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/tasks.Result"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "無法降階",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task-uuid}/move": {
+            "patch": {
+                "description": "任務連同其子任務移至目標任務之前、之後或成為其最後一個子任務",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "移動任務",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "任務UUID",
+                        "name": "task-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "移動任務",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tasks.Move"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/tasks.Result"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "無法移動",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task-uuid}/outdent": {
+            "patch": {
+                "description": "任務連同其子任務移至其父任務之後",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "任務升階",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "任務UUID",
+                        "name": "task-uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/tasks.Result"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "無法升階",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task-uuid}/recurrence": {
+            "patch": {
+                "description": "依新的規則、名稱、期間或資源重新產生週期任務於生效日期起尚未開始的子任務，之前及已開始的子任務保留不變",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "更新週期任務",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "週期任務UUID",
+                        "name": "task-uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新週期任務",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tasks.UpdateRecurrence"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/tasks.Result"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "無法更新",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/timesheets": {
+            "get": {
+                "description": "取得使用者的全部工時表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timesheet"
+                ],
+                "summary": "取得使用者的全部工時表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "週起始日 (YYYY-MM-DD，週內任一日皆可)",
+                        "name": "week_start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "狀態 (draft、submitted、approved、rejected)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/timesheets.List"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/timesheets/approvals": {
+            "get": {
+                "description": "取得使用者主管部門成員的工時表(預設為已送出)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timesheet"
+                ],
+                "summary": "取得待審核的工時表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "週起始日 (YYYY-MM-DD，週內任一日皆可)",
+                        "name": "week_start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "狀態 (draft、submitted、approved、rejected)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/timesheets.List"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/timesheets/entries": {
+            "post": {
+                "description": "於指派給使用者的任務新增單日工時，並自動建立該週的工時表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timesheet"
+                ],
+                "summary": "新增工時",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "新增工時",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/timesheet_entries.Create"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "未指派任務、工時表已送出或單日工時超過上限",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/timesheets/entries/{id}": {
+            "delete": {
+                "description": "刪除單一工時(僅限工時表的擁有者，且工時表未送出或已退回)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timesheet"
+                ],
+                "summary": "刪除單一工時",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "工時UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "使用者無權限或工時表已送出",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "更新單一工時(僅限工時表的擁有者，且工時表未送出或已退回)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timesheet"
+                ],
+                "summary": "更新單一工時",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "工時UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新工時",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/timesheet_entries.Update"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "使用者無權限、工時表已送出或單日工時超過上限",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/timesheets/{id}": {
+            "get": {
+                "description": "取得單一工時表及其工時(僅限擁有者、其主管及管理員)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timesheet"
+                ],
+                "summary": "取得單一工時表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "工時表UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/timesheets.Single"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "使用者無權限",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/timesheets/{id}/approve": {
+            "post": {
+                "description": "由擁有者部門的主管核准已送出的工時表，並將核准的工時加總至任務的實際工時及花費時間",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timesheet"
+                ],
+                "summary": "核准工時表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "工時表UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "審核意見",
+                        "name": "*",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/timesheets.Review"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "使用者不是主管或工時表未送出",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/timesheets/{id}/reject": {
+            "post": {
+                "description": "由擁有者部門的主管退回已送出的工時表(須填寫審核意見)，退回後擁有者可修改後再送出",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timesheet"
+                ],
+                "summary": "退回工時表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "工時表UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "審核意見",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/timesheets.Review"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "使用者不是主管、工時表未送出或未填寫審核意見",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/timesheets/{id}/submit": {
+            "post": {
+                "description": "送出草稿或已退回的工時表給主管審核",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timesheet"
+                ],
+                "summary": "送出工時表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "工時表UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "使用者無權限、工時表已送出或沒有工時",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "取得全部使用者(不用page和limit)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "取得全部使用者(不用page和limit)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/users.ListNoPagination"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/authenticator/current-user": {
+            "post": {
+                "description": "啟用驗證器",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "啟用驗證器",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "啟用驗證器",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.EnableAuthenticator"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/change-email/current-user": {
+            "post": {
+                "description": "更換電子郵件",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "更換電子郵件",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "更換電子郵件",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.ChangeEmail"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/check-duplicate": {
+            "post": {
+                "description": "檢查使用者是否重複",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "檢查使用者是否重複",
+                "parameters": [
+                    {
+                        "description": "檢查使用者是否重複",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.Filter"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/current-user": {
+            "get": {
+                "description": "取得當前使用者",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "取得當前使用者",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/users.Single"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "更新當前使用者",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "更新當前使用者",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "更新使用者",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.Update"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/enable/current-user": {
+            "post": {
+                "description": "啟用當前使用者",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "啟用當前使用者",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "啟用當前使用者",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.Enable"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/list": {
+            "post": {
+                "description": "取得全部使用者",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "取得全部使用者",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/users.List"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/reset-password/current-user": {
+            "post": {
+                "description": "重設密碼",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "重設密碼",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "重設密碼",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.ResetPassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/verify-email/current-user": {
+            "post": {
+                "description": "驗證電子郵件",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "驗證電子郵件",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "description": "取得單一使用者",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "取得單一使用者",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "使用者ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/users.Single"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "刪除單一使用者",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "刪除單一使用者",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "使用者ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新使用者",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.Update"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "更新使用者",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "更新使用者",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "使用者ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新使用者",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.Update"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/verify": {
+            "post": {
+                "description": "驗證",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "login"
+                ],
+                "summary": "驗證",
+                "parameters": [
+                    {
+                        "description": "驗證",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/logins.Verify"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/jwx.Token"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/work-days": {
+            "get": {
+                "description": "取得全部工作時間",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "work_day"
+                ],
+                "summary": "取得全部工作時間",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "目前頁數,請從1開始帶入",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "一次回傳比數,請從1開始帶入,最高上限20",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/work_days.List"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "新增工作時間",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "work_day"
+                ],
+                "summary": "新增工作時間",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "新增工作時間",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/work_days.Create"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/work-days/no-pagination": {
+            "get": {
+                "description": "取得全部工作時間",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "work_day"
+                ],
+                "summary": "取得全部工作時間(不用page\u0026limit)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/work_days.List"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/work-days/{id}": {
+            "get": {
+                "description": "取得單一工作時間",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "work_day"
+                ],
+                "summary": "取得單一工作時間",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "工作時間UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/work_days.Single"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "刪除單一工作時間",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "work_day"
+                ],
+                "summary": "刪除單一工作時間",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "工作時間UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "更新單一工作時間",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "work_day"
+                ],
+                "summary": "更新單一工作時間",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWE Token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "工作時間UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新工作時間",
+                        "name": "*",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/work_days.Update"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功後返回的值",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.SuccessfulMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "必要欄位帶入錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "伺服器非預期錯誤",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/code.ErrorMessage"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "detailed": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "affiliations.Create": {
+            "type": "object",
+            "required": [
+                "dept_id"
+            ],
+            "properties": {
+                "dept_id": {
+                    "description": "部門ID",
+                    "type": "string"
+                },
+                "job_title": {
+                    "description": "職稱",
+                    "type": "string"
+                }
+            }
+        },
+        "affiliations.CreateForDept": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "job_title": {
+                    "description": "職稱",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "使用者ID",
+                    "type": "string"
+                }
+            }
+        },
+        "affiliations.Single": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "創建時間",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "刪除時間",
+                    "type": "string"
+                },
+                "dept_id": {
+                    "description": "部門ID",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "is_supervisor": {
+                    "description": "是否為主管",
+                    "type": "boolean"
+                },
+                "job_title": {
+                    "description": "職稱",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "使用者名稱",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "更新時間",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "使用者ID",
+                    "type": "string"
+                }
+            }
         },
         "affiliations.SingleUser": {
             "type": "object",
             "properties": {
-                "dept_id": {
-                    "description": "部門ID",
+                "dept_id": {
+                    "description": "部門ID",
+                    "type": "string"
+                },
+                "is_supervisor": {
+                    "description": "是否為主管",
+                    "type": "boolean"
+                },
+                "job_title": {
+                    "description": "職稱",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "部門",
+                    "type": "string"
+                }
+            }
+        },
+        "affiliations.Update": {
+            "type": "object",
+            "properties": {
+                "dept_id": {
+                    "description": "部門ID",
+                    "type": "string"
+                },
+                "is_supervisor": {
+                    "description": "是否為主管",
+                    "type": "boolean"
+                },
+                "job_title": {
+                    "description": "職稱",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "使用者ID",
+                    "type": "string"
+                }
+            }
+        },
+        "calendars.Create": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "is_default": {
+                    "description": "是否為預設行事曆",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "workWeek": {
+                    "description": "工作日",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "workingTime": {
+                    "description": "工作時間",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/work_days.WorkingTimes"
+                    }
+                }
+            }
+        },
+        "calendars.Holiday": {
+            "type": "object",
+            "properties": {
+                "cssClass": {
+                    "description": "前端css",
+                    "type": "string"
+                },
+                "from": {
+                    "description": "起始日期",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "結束日期",
+                    "type": "string"
+                }
+            }
+        },
+        "calendars.List": {
+            "type": "object",
+            "required": [
+                "limit",
+                "page"
+            ],
+            "properties": {
+                "calendars": {
+                    "description": "多筆",
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "created_at": {
+                                "description": "創建時間",
+                                "type": "string"
+                            },
+                            "created_by": {
+                                "description": "創建者",
+                                "type": "string"
+                            },
+                            "deleted_at": {
+                                "description": "刪除時間",
+                                "type": "string"
+                            },
+                            "holidays": {
+                                "description": "假日",
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/calendars.Holiday"
+                                }
+                            },
+                            "id": {
+                                "description": "表ID",
+                                "type": "string"
+                            },
+                            "is_default": {
+                                "description": "是否為預設行事曆",
+                                "type": "boolean"
+                            },
+                            "name": {
+                                "description": "名稱",
+                                "type": "string"
+                            },
+                            "updated_at": {
+                                "description": "更新時間",
+                                "type": "string"
+                            },
+                            "updated_by": {
+                                "description": "更新者",
+                                "type": "string"
+                            },
+                            "workWeek": {
+                                "description": "工作日",
+                                "type": "array",
+                                "items": {
+                                    "type": "string"
+                                }
+                            },
+                            "workingTime": {
+                                "description": "工作時間",
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/work_days.WorkingTimes"
+                                }
+                            }
+                        }
+                    }
+                },
+                "limit": {
+                    "description": "筆數(請從1開始帶入,最高上限20)",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "頁數(請從1開始帶入)",
+                    "type": "integer"
+                },
+                "pages": {
+                    "description": "總頁數",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "總筆數",
+                    "type": "integer"
+                }
+            }
+        },
+        "calendars.Single": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "創建時間",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "創建者",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "刪除時間",
+                    "type": "string"
+                },
+                "holidays": {
+                    "description": "假日",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/calendars.Holiday"
+                    }
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "is_default": {
+                    "description": "是否為預設行事曆",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "更新時間",
+                    "type": "string"
+                },
+                "updated_by": {
+                    "description": "更新者",
+                    "type": "string"
+                },
+                "workWeek": {
+                    "description": "工作日",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "workingTime": {
+                    "description": "工作時間",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/work_days.WorkingTimes"
+                    }
+                }
+            }
+        },
+        "calendars.Update": {
+            "type": "object",
+            "properties": {
+                "is_default": {
+                    "description": "是否為預設行事曆",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "workWeek": {
+                    "description": "工作日",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "workingTime": {
+                    "description": "工作時間",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/work_days.WorkingTimes"
+                    }
+                }
+            }
+        },
+        "code.ErrorMessage": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "回傳代碼",
+                    "type": "integer"
+                },
+                "detailed": {
+                    "description": "詳細錯誤內容"
+                },
+                "message": {
+                    "description": "錯誤回傳訊息",
+                    "type": "string"
+                },
+                "timestamp": {
+                    "description": "錯誤時間",
+                    "type": "string",
+                    "example": "2021-07-29T07:23:47Z"
+                }
+            }
+        },
+        "code.SuccessfulMessage": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "description": "正確回傳內容"
+                },
+                "code": {
+                    "description": "回傳代碼",
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "description": "錯誤時間",
+                    "type": "string",
+                    "example": "2021-07-29T07:23:47Z"
+                }
+            }
+        },
+        "departments.Create": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "affiliations": {
+                    "description": "affiliations data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/affiliations.CreateForDept"
+                    }
+                },
+                "fax": {
+                    "description": "傳真",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "supervisor_id": {
+                    "description": "部門主管ID(user_id)",
+                    "type": "string"
+                },
+                "tel": {
+                    "description": "電話",
+                    "type": "string"
+                }
+            }
+        },
+        "departments.List": {
+            "type": "object",
+            "required": [
+                "limit",
+                "page"
+            ],
+            "properties": {
+                "departments": {
+                    "description": "多筆",
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "affiliations": {
+                                "description": "affiliations data",
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/affiliations.Single"
+                                }
+                            },
+                            "created_at": {
+                                "description": "創建時間",
+                                "type": "string"
+                            },
+                            "created_by": {
+                                "description": "創建者",
+                                "type": "string"
+                            },
+                            "deleted_at": {
+                                "description": "刪除時間",
+                                "type": "string"
+                            },
+                            "fax": {
+                                "description": "傳真",
+                                "type": "string"
+                            },
+                            "id": {
+                                "description": "表ID",
+                                "type": "string"
+                            },
+                            "name": {
+                                "description": "名稱",
+                                "type": "string"
+                            },
+                            "supervisor": {
+                                "description": "部門主管",
+                                "type": "string"
+                            },
+                            "tel": {
+                                "description": "電話",
+                                "type": "string"
+                            },
+                            "updated_at": {
+                                "description": "更新時間",
+                                "type": "string"
+                            },
+                            "updated_by": {
+                                "description": "更新者",
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "limit": {
+                    "description": "筆數(請從1開始帶入,最高上限20)",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "頁數(請從1開始帶入)",
+                    "type": "integer"
+                },
+                "pages": {
+                    "description": "總頁數",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "總筆數",
+                    "type": "integer"
+                }
+            }
+        },
+        "departments.Single": {
+            "type": "object",
+            "properties": {
+                "affiliations": {
+                    "description": "affiliations data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/affiliations.Single"
+                    }
+                },
+                "created_at": {
+                    "description": "創建時間",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "創建者",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "刪除時間",
+                    "type": "string"
+                },
+                "fax": {
+                    "description": "傳真",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "supervisor": {
+                    "description": "部門主管",
+                    "type": "string"
+                },
+                "tel": {
+                    "description": "電話",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "更新時間",
+                    "type": "string"
+                },
+                "updated_by": {
+                    "description": "更新者",
+                    "type": "string"
+                }
+            }
+        },
+        "departments.Update": {
+            "type": "object",
+            "properties": {
+                "affiliations": {
+                    "description": "affiliations data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/affiliations.Update"
+                    }
+                },
+                "fax": {
+                    "description": "傳真",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "supervisor_id": {
+                    "description": "部門主管ID(user_id)",
+                    "type": "string"
+                },
+                "tel": {
+                    "description": "電話",
+                    "type": "string"
+                }
+            }
+        },
+        "event_marks.Create": {
+            "type": "object",
+            "required": [
+                "project_uuid"
+            ],
+            "properties": {
+                "day": {
+                    "description": "日期",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
+                }
+            }
+        },
+        "event_marks.Single": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "創建時間",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "創建者",
+                    "type": "string"
+                },
+                "day": {
+                    "description": "日期",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "刪除時間",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "更新時間",
+                    "type": "string"
+                },
+                "updated_by": {
+                    "description": "更新者",
+                    "type": "string"
+                }
+            }
+        },
+        "event_marks.Update": {
+            "type": "object",
+            "properties": {
+                "day": {
+                    "description": "日期",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
+                }
+            }
+        },
+        "holidays.Create": {
+            "type": "object",
+            "properties": {
+                "calendar_uuid": {
+                    "description": "行事曆UUID",
+                    "type": "string"
+                },
+                "cssClass": {
+                    "description": "前端css",
+                    "type": "string"
+                },
+                "from": {
+                    "description": "起始日期",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "結束日期",
+                    "type": "string"
+                }
+            }
+        },
+        "holidays.List": {
+            "type": "object",
+            "required": [
+                "limit",
+                "page"
+            ],
+            "properties": {
+                "holidays": {
+                    "description": "多筆",
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "calendar_uuid": {
+                                "description": "行事曆UUID",
+                                "type": "string"
+                            },
+                            "created_at": {
+                                "description": "創建時間",
+                                "type": "string"
+                            },
+                            "created_by": {
+                                "description": "創建者",
+                                "type": "string"
+                            },
+                            "cssClass": {
+                                "description": "前端css",
+                                "type": "string"
+                            },
+                            "deleted_at": {
+                                "description": "刪除時間",
+                                "type": "string"
+                            },
+                            "from": {
+                                "description": "起始日期",
+                                "type": "string"
+                            },
+                            "id": {
+                                "description": "表ID",
+                                "type": "string"
+                            },
+                            "label": {
+                                "description": "名稱",
+                                "type": "string"
+                            },
+                            "to": {
+                                "description": "結束日期",
+                                "type": "string"
+                            },
+                            "updated_at": {
+                                "description": "更新時間",
+                                "type": "string"
+                            },
+                            "updated_by": {
+                                "description": "更新者",
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "limit": {
+                    "description": "筆數(請從1開始帶入,最高上限20)",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "頁數(請從1開始帶入)",
+                    "type": "integer"
+                },
+                "pages": {
+                    "description": "總頁數",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "總筆數",
+                    "type": "integer"
+                }
+            }
+        },
+        "holidays.Single": {
+            "type": "object",
+            "properties": {
+                "calendar_uuid": {
+                    "description": "行事曆UUID",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "創建時間",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "創建者",
+                    "type": "string"
+                },
+                "cssClass": {
+                    "description": "前端css",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "刪除時間",
+                    "type": "string"
+                },
+                "from": {
+                    "description": "起始日期",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "結束日期",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "更新時間",
+                    "type": "string"
+                },
+                "updated_by": {
+                    "description": "更新者",
+                    "type": "string"
+                }
+            }
+        },
+        "holidays.Update": {
+            "type": "object",
+            "properties": {
+                "calendar_uuid": {
+                    "description": "行事曆UUID (空字串表示移出行事曆)",
+                    "type": "string"
+                },
+                "cssClass": {
+                    "description": "前端css",
+                    "type": "string"
+                },
+                "from": {
+                    "description": "起始日期",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "結束日期",
+                    "type": "string"
+                }
+            }
+        },
+        "jwx.Refresh": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "description": "刷新令牌",
+                    "type": "string"
+                }
+            }
+        },
+        "jwx.Token": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "description": "授權令牌",
+                    "type": "string"
+                },
+                "is_complete": {
+                    "description": "是否填寫完整",
+                    "type": "boolean"
+                },
+                "refresh_token": {
+                    "description": "刷新令牌",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "角色",
+                    "type": "string"
+                }
+            }
+        },
+        "logins.Forget": {
+            "type": "object",
+            "required": [
+                "domain",
+                "email"
+            ],
+            "properties": {
+                "domain": {
+                    "description": "網域",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "使用者電子郵件",
+                    "type": "string"
+                },
+                "port": {
+                    "description": "連接埠",
+                    "type": "string"
+                }
+            }
+        },
+        "logins.Login": {
+            "type": "object",
+            "required": [
+                "password",
+                "user_name"
+            ],
+            "properties": {
+                "change_to": {
+                    "description": "更換驗證方式 1: email, 2: authenticator",
+                    "type": "integer"
+                },
+                "password": {
+                    "description": "密碼",
+                    "type": "string"
+                },
+                "user_name": {
+                    "description": "使用者名稱",
+                    "type": "string"
+                }
+            }
+        },
+        "logins.Register": {
+            "type": "object",
+            "required": [
+                "domain",
+                "email",
+                "password",
+                "user_name"
+            ],
+            "properties": {
+                "domain": {
+                    "description": "網域",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "使用者電子郵件",
+                    "type": "string"
+                },
+                "password": {
+                    "description": "使用者密碼",
+                    "type": "string"
+                },
+                "port": {
+                    "description": "連接埠",
+                    "type": "string"
+                },
+                "user_name": {
+                    "description": "使用者名稱",
+                    "type": "string"
+                }
+            }
+        },
+        "logins.Verify": {
+            "type": "object",
+            "required": [
+                "passcode",
+                "user_name"
+            ],
+            "properties": {
+                "passcode": {
+                    "description": "驗證碼",
+                    "type": "string"
+                },
+                "user_name": {
+                    "description": "使用者名稱",
+                    "type": "string"
+                }
+            }
+        },
+        "policies.PolicyRule": {
+            "type": "object",
+            "required": [
+                "method",
+                "path",
+                "role_name"
+            ],
+            "properties": {
+                "method": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "role_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "policies.Single": {
+            "type": "object",
+            "properties": {
+                "method": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "role_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "project_baseline_tasks.Single": {
+            "type": "object",
+            "properties": {
+                "baseline_uuid": {
+                    "description": "基準線UUID",
+                    "type": "string"
+                },
+                "cost": {
+                    "description": "花費時間",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "創建時間",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "刪除時間",
+                    "type": "string"
+                },
+                "duration": {
+                    "description": "期間",
+                    "type": "number"
+                },
+                "end_date": {
+                    "description": "結束日期",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "outline_number": {
+                    "description": "1.1.2、1.2、1.2.1",
+                    "type": "string"
+                },
+                "planned_work": {
+                    "description": "計畫工時 (核准工時表前的花費時間)",
+                    "type": "number"
+                },
+                "progress": {
+                    "description": "完成百分比",
+                    "type": "integer"
+                },
+                "start_date": {
+                    "description": "起始日期",
+                    "type": "string"
+                },
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
+                    "type": "string"
+                },
+                "task_name": {
+                    "description": "任務名稱",
+                    "type": "string"
+                },
+                "task_uuid": {
+                    "description": "任務UUID",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "更新時間",
+                    "type": "string"
+                }
+            }
+        },
+        "project_baselines.Comparison": {
+            "type": "object",
+            "properties": {
+                "source": {
+                    "description": "基準線",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project_baselines.Summary"
+                        }
+                    ]
+                },
+                "target": {
+                    "description": "比較的基準線 (空值表示目前排程)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project_baselines.Summary"
+                        }
+                    ]
+                },
+                "tasks": {
+                    "description": "任務差異",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project_baselines.TaskComparison"
+                    }
+                }
+            }
+        },
+        "project_baselines.Create": {
+            "type": "object",
+            "required": [
+                "name",
+                "project_uuid"
+            ],
+            "properties": {
+                "is_selected": {
+                    "description": "是否為選用的基準線",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
+                }
+            }
+        },
+        "project_baselines.List": {
+            "type": "object",
+            "required": [
+                "limit",
+                "page"
+            ],
+            "properties": {
+                "limit": {
+                    "description": "筆數(請從1開始帶入,最高上限20)",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "頁數(請從1開始帶入)",
+                    "type": "integer"
+                },
+                "pages": {
+                    "description": "總頁數",
+                    "type": "integer"
+                },
+                "project_baselines": {
+                    "description": "多筆",
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "created_at": {
+                                "description": "創建時間",
+                                "type": "string"
+                            },
+                            "created_by": {
+                                "description": "創建者",
+                                "type": "string"
+                            },
+                            "deleted_at": {
+                                "description": "刪除時間",
+                                "type": "string"
+                            },
+                            "id": {
+                                "description": "表ID",
+                                "type": "string"
+                            },
+                            "is_selected": {
+                                "description": "是否為選用的基準線",
+                                "type": "boolean"
+                            },
+                            "name": {
+                                "description": "名稱",
+                                "type": "string"
+                            },
+                            "project_uuid": {
+                                "description": "專案UUID",
+                                "type": "string"
+                            },
+                            "updated_at": {
+                                "description": "更新時間",
+                                "type": "string"
+                            },
+                            "updated_by": {
+                                "description": "更新者",
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "total": {
+                    "description": "總筆數",
+                    "type": "integer"
+                }
+            }
+        },
+        "project_baselines.Single": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "創建時間",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "創建者",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "刪除時間",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "is_selected": {
+                    "description": "是否為選用的基準線",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
+                },
+                "tasks": {
+                    "description": "任務快照",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project_baseline_tasks.Single"
+                    }
+                },
+                "updated_at": {
+                    "description": "更新時間",
+                    "type": "string"
+                },
+                "updated_by": {
+                    "description": "更新者",
+                    "type": "string"
+                }
+            }
+        },
+        "project_baselines.Summary": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "創建時間",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                }
+            }
+        },
+        "project_baselines.TaskComparison": {
+            "type": "object",
+            "properties": {
+                "cost_variance": {
+                    "description": "花費差異",
+                    "type": "integer"
+                },
+                "duration_variance": {
+                    "description": "期間差異",
+                    "type": "number"
+                },
+                "finish_variance": {
+                    "description": "完成差異 (工作天，正值表示延後)",
+                    "type": "number"
+                },
+                "outline_number": {
+                    "description": "1.1.2、1.2、1.2.1",
+                    "type": "string"
+                },
+                "source_cost": {
+                    "description": "基準線花費時間",
+                    "type": "integer"
+                },
+                "source_duration": {
+                    "description": "基準線期間",
+                    "type": "number"
+                },
+                "source_end_date": {
+                    "description": "基準線結束日期",
+                    "type": "string"
+                },
+                "source_progress": {
+                    "description": "基準線完成百分比",
+                    "type": "integer"
+                },
+                "source_start_date": {
+                    "description": "基準線起始日期",
+                    "type": "string"
+                },
+                "start_variance": {
+                    "description": "起始差異 (工作天，正值表示延後)",
+                    "type": "number"
+                },
+                "status": {
+                    "description": "狀態 (added: 僅存在於比較對象、removed: 僅存在於基準線、changed、unchanged)",
+                    "type": "string"
+                },
+                "target_cost": {
+                    "description": "比較對象花費時間",
+                    "type": "integer"
+                },
+                "target_duration": {
+                    "description": "比較對象期間",
+                    "type": "number"
+                },
+                "target_end_date": {
+                    "description": "比較對象結束日期",
+                    "type": "string"
+                },
+                "target_progress": {
+                    "description": "比較對象完成百分比",
+                    "type": "integer"
+                },
+                "target_start_date": {
+                    "description": "比較對象起始日期",
+                    "type": "string"
+                },
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
+                    "type": "string"
+                },
+                "task_name": {
+                    "description": "任務名稱",
+                    "type": "string"
+                },
+                "task_uuid": {
+                    "description": "任務UUID",
+                    "type": "string"
+                }
+            }
+        },
+        "project_baselines.TaskVariance": {
+            "type": "object",
+            "properties": {
+                "baseline_cost": {
+                    "description": "基準線花費時間",
+                    "type": "integer"
+                },
+                "baseline_duration": {
+                    "description": "基準線期間",
+                    "type": "number"
+                },
+                "baseline_end_date": {
+                    "description": "基準線結束日期",
+                    "type": "string"
+                },
+                "baseline_start_date": {
+                    "description": "基準線起始日期",
+                    "type": "string"
+                },
+                "cost": {
+                    "description": "花費時間",
+                    "type": "integer"
+                },
+                "cost_variance": {
+                    "description": "花費差異",
+                    "type": "integer"
+                },
+                "duration": {
+                    "description": "期間",
+                    "type": "number"
+                },
+                "duration_variance": {
+                    "description": "期間差異",
+                    "type": "number"
+                },
+                "end_date": {
+                    "description": "結束日期",
+                    "type": "string"
+                },
+                "finish_variance": {
+                    "description": "完成差異 (工作天，正值表示延後)",
+                    "type": "number"
+                },
+                "in_baseline": {
+                    "description": "是否存在於基準線",
+                    "type": "boolean"
+                },
+                "is_summary": {
+                    "description": "是否為摘要任務 (數值由子任務彙總)",
+                    "type": "boolean"
+                },
+                "outline_number": {
+                    "description": "1.1.2、1.2、1.2.1",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "起始日期",
+                    "type": "string"
+                },
+                "start_variance": {
+                    "description": "起始差異 (工作天，正值表示延後)",
+                    "type": "number"
+                },
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
+                    "type": "string"
+                },
+                "task_name": {
+                    "description": "任務名稱",
+                    "type": "string"
+                },
+                "task_uuid": {
+                    "description": "任務UUID",
+                    "type": "string"
+                }
+            }
+        },
+        "project_baselines.Update": {
+            "type": "object",
+            "properties": {
+                "is_selected": {
+                    "description": "是否為選用的基準線 (選用後將填入get-by-projects的基準線欄位)",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                }
+            }
+        },
+        "project_baselines.VarianceReport": {
+            "type": "object",
+            "properties": {
+                "baseline": {
+                    "description": "基準線",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project_baselines.Summary"
+                        }
+                    ]
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
+                },
+                "tasks": {
+                    "description": "任務差異",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project_baselines.TaskVariance"
+                    }
+                }
+            }
+        },
+        "project_resources.Filter": {
+            "type": "object",
+            "properties": {
+                "resource_group": {
+                    "description": "群組",
+                    "type": "string"
+                },
+                "resource_name": {
+                    "description": "名字",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "角色",
+                    "type": "string"
+                }
+            }
+        },
+        "project_resources.List": {
+            "type": "object",
+            "required": [
+                "limit",
+                "page"
+            ],
+            "properties": {
+                "limit": {
+                    "description": "筆數(請從1開始帶入,最高上限20)",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "頁數(請從1開始帶入)",
+                    "type": "integer"
+                },
+                "pages": {
+                    "description": "總頁數",
+                    "type": "integer"
+                },
+                "project_resources": {
+                    "description": "多筆",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project_resources.Single"
+                    }
+                },
+                "total": {
+                    "description": "總筆數",
+                    "type": "integer"
+                }
+            }
+        },
+        "project_resources.Single": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "創建時間",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "創建者",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "刪除時間",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "信箱",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "is_editable": {
+                    "description": "是否可編輯專案任務",
+                    "type": "boolean"
+                },
+                "is_expand": {
+                    "type": "boolean"
+                },
+                "phone": {
+                    "description": "電話",
+                    "type": "string"
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
+                },
+                "resource_group": {
+                    "description": "群組",
+                    "type": "string"
+                },
+                "resource_id": {
+                    "description": "舊編號 ＆ 頁面ID",
+                    "type": "integer"
+                },
+                "resource_name": {
+                    "description": "名字",
+                    "type": "string"
+                },
+                "resource_uuid": {
+                    "description": "資源UUID",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "專案角色",
+                    "type": "string"
+                },
+                "standard_cost": {
+                    "type": "number"
+                },
+                "total_cost": {
+                    "type": "number"
+                },
+                "total_load": {
+                    "description": "總負載",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "description": "更新時間",
+                    "type": "string"
+                },
+                "updated_by": {
+                    "description": "更新者",
+                    "type": "string"
+                }
+            }
+        },
+        "project_scenarios.Comparison": {
+            "type": "object",
+            "properties": {
+                "conflicts": {
+                    "description": "衝突任務 (建立情境後新增至目前排程的任務，合併時保留於情境任務之後)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project_scenarios.TaskComparison"
+                    }
+                },
+                "cost_variance": {
+                    "description": "花費差異",
+                    "type": "integer"
+                },
+                "duration_variance": {
+                    "description": "期間差異",
+                    "type": "number"
+                },
+                "end_date_variance": {
+                    "description": "結束日期差異 (工作天，正值表示延後)",
+                    "type": "number"
+                },
+                "project": {
+                    "description": "目前排程",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project_scenarios.Summary"
+                        }
+                    ]
+                },
+                "resources": {
+                    "description": "資源負載差異",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project_scenarios.ResourceComparison"
+                    }
+                },
+                "scenario": {
+                    "description": "情境排程",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project_scenarios.Summary"
+                        }
+                    ]
+                },
+                "tasks": {
+                    "description": "任務差異 (僅列出新增、刪除及變更的任務)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project_scenarios.TaskComparison"
+                    }
+                }
+            }
+        },
+        "project_scenarios.Create": {
+            "type": "object",
+            "required": [
+                "name",
+                "project_uuid"
+            ],
+            "properties": {
+                "description": {
+                    "description": "描述",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
+                }
+            }
+        },
+        "project_scenarios.List": {
+            "type": "object",
+            "required": [
+                "limit",
+                "page"
+            ],
+            "properties": {
+                "limit": {
+                    "description": "筆數(請從1開始帶入,最高上限20)",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "頁數(請從1開始帶入)",
+                    "type": "integer"
+                },
+                "pages": {
+                    "description": "總頁數",
+                    "type": "integer"
+                },
+                "project_scenarios": {
+                    "description": "多筆",
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "created_at": {
+                                "description": "創建時間",
+                                "type": "string"
+                            },
+                            "created_by": {
+                                "description": "創建者",
+                                "type": "string"
+                            },
+                            "deleted_at": {
+                                "description": "刪除時間",
+                                "type": "string"
+                            },
+                            "description": {
+                                "description": "描述",
+                                "type": "string"
+                            },
+                            "id": {
+                                "description": "表ID",
+                                "type": "string"
+                            },
+                            "name": {
+                                "description": "名稱",
+                                "type": "string"
+                            },
+                            "project_uuid": {
+                                "description": "專案UUID",
+                                "type": "string"
+                            },
+                            "scenario_project_uuid": {
+                                "description": "情境專案UUID (以此專案UUID編輯情境的任務)",
+                                "type": "string"
+                            },
+                            "updated_at": {
+                                "description": "更新時間",
+                                "type": "string"
+                            },
+                            "updated_by": {
+                                "description": "更新者",
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "total": {
+                    "description": "總筆數",
+                    "type": "integer"
+                }
+            }
+        },
+        "project_scenarios.ResourceComparison": {
+            "type": "object",
+            "properties": {
+                "hours_variance": {
+                    "description": "工時差異",
+                    "type": "number"
+                },
+                "project_hours": {
+                    "description": "目前排程的工時",
+                    "type": "number"
+                },
+                "project_overallocated": {
+                    "description": "目前排程是否過度配置",
+                    "type": "boolean"
+                },
+                "resource_name": {
+                    "description": "資源名稱",
+                    "type": "string"
+                },
+                "resource_uuid": {
+                    "description": "資源UUID",
+                    "type": "string"
+                },
+                "scenario_hours": {
+                    "description": "情境排程的工時",
+                    "type": "number"
+                },
+                "scenario_overallocated": {
+                    "description": "情境排程是否過度配置",
+                    "type": "boolean"
+                }
+            }
+        },
+        "project_scenarios.Single": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "創建時間",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "創建者",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "刪除時間",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "描述",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
+                },
+                "scenario_project_uuid": {
+                    "description": "情境專案UUID (以此專案UUID編輯情境的任務)",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "更新時間",
+                    "type": "string"
+                },
+                "updated_by": {
+                    "description": "更新者",
+                    "type": "string"
+                }
+            }
+        },
+        "project_scenarios.Summary": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "description": "花費時間",
+                    "type": "integer"
+                },
+                "duration": {
+                    "description": "期間 (工作天)",
+                    "type": "number"
+                },
+                "end_date": {
+                    "description": "結束日期",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "起始日期",
+                    "type": "string"
+                }
+            }
+        },
+        "project_scenarios.TaskComparison": {
+            "type": "object",
+            "properties": {
+                "finish_variance": {
+                    "description": "完成差異 (工作天，正值表示延後)",
+                    "type": "number"
+                },
+                "outline_number": {
+                    "description": "1.1.2、1.2、1.2.1",
+                    "type": "string"
+                },
+                "project_cost": {
+                    "description": "目前花費時間",
+                    "type": "integer"
+                },
+                "project_duration": {
+                    "description": "目前期間",
+                    "type": "number"
+                },
+                "project_end_date": {
+                    "description": "目前結束日期",
+                    "type": "string"
+                },
+                "project_start_date": {
+                    "description": "目前起始日期",
+                    "type": "string"
+                },
+                "scenario_cost": {
+                    "description": "情境花費時間",
+                    "type": "integer"
+                },
+                "scenario_duration": {
+                    "description": "情境期間",
+                    "type": "number"
+                },
+                "scenario_end_date": {
+                    "description": "情境結束日期",
+                    "type": "string"
+                },
+                "scenario_start_date": {
+                    "description": "情境起始日期",
+                    "type": "string"
+                },
+                "scenario_task_uuid": {
+                    "description": "情境任務UUID (空值表示已自情境刪除)",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "狀態 (added: 僅存在於情境、removed: 僅存在於目前排程、changed、conflict: 建立情境後新增至目前排程)",
+                    "type": "string"
+                },
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
+                    "type": "string"
+                },
+                "task_name": {
+                    "description": "任務名稱",
+                    "type": "string"
+                },
+                "task_uuid": {
+                    "description": "任務UUID (空值表示僅存在於情境)",
+                    "type": "string"
+                }
+            }
+        },
+        "project_scenarios.Update": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "描述",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                }
+            }
+        },
+        "project_templates.Create": {
+            "type": "object",
+            "required": [
+                "name",
+                "project_uuid"
+            ],
+            "properties": {
+                "description": {
+                    "description": "描述",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "project_uuid": {
+                    "description": "來源專案UUID",
+                    "type": "string"
+                },
+                "type_id": {
+                    "description": "類別ID",
+                    "type": "string"
+                }
+            }
+        },
+        "project_templates.EventMark": {
+            "type": "object",
+            "properties": {
+                "day": {
+                    "description": "日期",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "名稱",
+                    "type": "string"
+                }
+            }
+        },
+        "project_templates.List": {
+            "type": "object",
+            "required": [
+                "limit",
+                "page"
+            ],
+            "properties": {
+                "limit": {
+                    "description": "筆數(請從1開始帶入,最高上限20)",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "頁數(請從1開始帶入)",
+                    "type": "integer"
+                },
+                "pages": {
+                    "description": "總頁數",
+                    "type": "integer"
+                },
+                "project_templates": {
+                    "description": "多筆",
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "created_at": {
+                                "description": "創建時間",
+                                "type": "string"
+                            },
+                            "created_by": {
+                                "description": "創建者",
+                                "type": "string"
+                            },
+                            "deleted_at": {
+                                "description": "刪除時間",
+                                "type": "string"
+                            },
+                            "description": {
+                                "description": "描述",
+                                "type": "string"
+                            },
+                            "id": {
+                                "description": "表ID",
+                                "type": "string"
+                            },
+                            "name": {
+                                "description": "名稱",
+                                "type": "string"
+                            },
+                            "start_date": {
+                                "description": "基準日期",
+                                "type": "string"
+                            },
+                            "type_id": {
+                                "description": "類別ID",
+                                "type": "string"
+                            },
+                            "type_name": {
+                                "description": "類別名稱",
+                                "type": "string"
+                            },
+                            "updated_at": {
+                                "description": "更新時間",
+                                "type": "string"
+                            },
+                            "updated_by": {
+                                "description": "更新者",
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "total": {
+                    "description": "總筆數",
+                    "type": "integer"
+                }
+            }
+        },
+        "project_templates.Resource": {
+            "type": "object",
+            "properties": {
+                "is_editable": {
+                    "description": "是否可編輯專案任務",
+                    "type": "boolean"
+                },
+                "resource_uuid": {
+                    "description": "資源UUID",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "專案角色",
+                    "type": "string"
+                }
+            }
+        },
+        "project_templates.Single": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "創建時間",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "創建者",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "刪除時間",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "描述",
+                    "type": "string"
+                },
+                "event_marks": {
+                    "description": "事件標記",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project_templates.EventMark"
+                    }
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "resources": {
+                    "description": "專案資源",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project_templates.Resource"
+                    }
+                },
+                "start_date": {
+                    "description": "基準日期 (任務日期依此日期平移)",
+                    "type": "string"
+                },
+                "tasks": {
+                    "description": "任務",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project_templates.Task"
+                    }
+                },
+                "type_id": {
+                    "description": "類別ID",
+                    "type": "string"
+                },
+                "type_name": {
+                    "description": "類別名稱",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "更新時間",
+                    "type": "string"
+                },
+                "updated_by": {
+                    "description": "更新者",
+                    "type": "string"
+                }
+            }
+        },
+        "project_templates.Task": {
+            "type": "object",
+            "properties": {
+                "assignments": {
+                    "description": "未知",
+                    "type": "string"
+                },
+                "baseline_duration": {
+                    "description": "基準線工作天",
+                    "type": "number"
+                },
+                "baseline_end_date": {
+                    "description": "基準線結束日期",
+                    "type": "string"
+                },
+                "baseline_start_date": {
+                    "description": "基準線起始日期",
+                    "type": "string"
+                },
+                "constraint_date": {
+                    "description": "限制日期",
+                    "type": "string"
+                },
+                "constraint_type": {
+                    "description": "限制類型 (ASAP、ALAP、SNET、FNLT、MSO、MFO)",
+                    "type": "string"
+                },
+                "cost": {
+                    "description": "花費時間",
+                    "type": "integer"
+                },
+                "deadline": {
+                    "description": "期限",
+                    "type": "string"
+                },
+                "duration": {
+                    "description": "期間",
+                    "type": "number"
+                },
+                "end_date": {
+                    "description": "結束日期",
+                    "type": "string"
+                },
+                "indicator": {
+                    "description": "任務標示(陣列的字串型態)",
+                    "type": "string"
+                },
+                "is_milestone": {
+                    "description": "是否為里程碑",
+                    "type": "boolean"
+                },
+                "is_subtask": {
+                    "description": "是否為任務",
+                    "type": "boolean"
+                },
+                "most_likely_duration": {
+                    "description": "最可能期間",
+                    "type": "number"
+                },
+                "notes": {
+                    "description": "備註",
+                    "type": "string"
+                },
+                "optimistic_duration": {
+                    "description": "樂觀期間",
+                    "type": "number"
+                },
+                "outline_number": {
+                    "description": "1.1.2、1.2、1.2.1",
+                    "type": "string"
+                },
+                "pessimistic_duration": {
+                    "description": "悲觀期間",
+                    "type": "number"
+                },
+                "predecessor": {
+                    "description": "前任",
+                    "type": "string"
+                },
+                "priority": {
+                    "description": "優先順序 (0~1000)",
+                    "type": "integer"
+                },
+                "resources": {
+                    "description": "人力資源",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project_templates.TaskResource"
+                    }
+                },
+                "segment": {
+                    "description": "任務分段(陣列的字串型態)",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "起始日期",
+                    "type": "string"
+                },
+                "task_color": {
+                    "description": "紀錄標的顏色",
+                    "type": "string"
+                },
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
+                    "type": "string"
+                },
+                "task_name": {
+                    "description": "任務名稱",
+                    "type": "string"
+                },
+                "web_link": {
+                    "description": "預留：外部連結",
+                    "type": "string"
+                }
+            }
+        },
+        "project_templates.TaskResource": {
+            "type": "object",
+            "properties": {
+                "resource_uuid": {
+                    "description": "資源UUID",
+                    "type": "string"
+                },
+                "unit": {
+                    "description": "單位",
+                    "type": "number"
+                }
+            }
+        },
+        "project_templates.Update": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "描述",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "type_id": {
+                    "description": "類別ID",
+                    "type": "string"
+                }
+            }
+        },
+        "project_types.Create": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "transitions": {
+                    "description": "任務狀態轉換 (key: 目前狀態，value: 可變更的狀態，空值表示預設轉換)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "project_types.List": {
+            "type": "object",
+            "required": [
+                "limit",
+                "page"
+            ],
+            "properties": {
+                "limit": {
+                    "description": "筆數(請從1開始帶入,最高上限20)",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "頁數(請從1開始帶入)",
+                    "type": "integer"
+                },
+                "pages": {
+                    "description": "總頁數",
+                    "type": "integer"
+                },
+                "project_types": {
+                    "description": "多筆",
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "created_at": {
+                                "description": "創建時間",
+                                "type": "string"
+                            },
+                            "created_by": {
+                                "description": "創建者",
+                                "type": "string"
+                            },
+                            "deleted_at": {
+                                "description": "刪除時間",
+                                "type": "string"
+                            },
+                            "id": {
+                                "description": "表ID",
+                                "type": "string"
+                            },
+                            "name": {
+                                "description": "名稱",
+                                "type": "string"
+                            },
+                            "transitions": {
+                                "description": "任務狀態轉換 (key: 目前狀態，value: 可變更的狀態)",
+                                "type": "object",
+                                "additionalProperties": {
+                                    "type": "array",
+                                    "items": {
+                                        "type": "string"
+                                    }
+                                }
+                            },
+                            "updated_at": {
+                                "description": "更新時間",
+                                "type": "string"
+                            },
+                            "updated_by": {
+                                "description": "更新者",
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "total": {
+                    "description": "總筆數",
+                    "type": "integer"
+                }
+            }
+        },
+        "project_types.Single": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "創建時間",
                     "type": "string"
                 },
-                "is_supervisor": {
-                    "description": "是否為主管",
-                    "type": "boolean"
+                "created_by": {
+                    "description": "創建者",
+                    "type": "string"
                 },
-                "job_title": {
-                    "description": "職稱",
+                "deleted_at": {
+                    "description": "刪除時間",
                     "type": "string"
                 },
-                "name": {
-                    "description": "部門",
+                "id": {
+                    "description": "表ID",
                     "type": "string"
-                }
-            }
-        },
-        "affiliations.Update": {
-            "type": "object",
-            "properties": {
-                "dept_id": {
-                    "description": "部門ID",
+                },
+                "name": {
+                    "description": "名稱",
                     "type": "string"
                 },
-                "is_supervisor": {
-                    "description": "是否為主管",
-                    "type": "boolean"
+                "transitions": {
+                    "description": "任務狀態轉換 (key: 目前狀態，value: 可變更的狀態)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
                 },
-                "job_title": {
-                    "description": "職稱",
+                "updated_at": {
+                    "description": "更新時間",
                     "type": "string"
                 },
-                "user_id": {
-                    "description": "使用者ID",
+                "updated_by": {
+                    "description": "更新者",
                     "type": "string"
                 }
             }
         },
-        "code.ErrorMessage": {
+        "project_types.Update": {
             "type": "object",
             "properties": {
-                "code": {
-                    "description": "回傳代碼",
-                    "type": "integer"
-                },
-                "detailed": {
-                    "description": "詳細錯誤內容"
-                },
-                "message": {
-                    "description": "錯誤回傳訊息",
+                "name": {
+                    "description": "名稱",
                     "type": "string"
                 },
-                "timestamp": {
-                    "description": "錯誤時間",
-                    "type": "string",
-                    "example": "2021-07-29T07:23:47Z"
+                "transitions": {
+                    "description": "任務狀態轉換 (key: 目前狀態，value: 可變更的狀態，空物件表示改回預設轉換)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
                 }
             }
         },
-        "code.SuccessfulMessage": {
+        "projects.Clone": {
             "type": "object",
+            "required": [
+                "project_name",
+                "start_date"
+            ],
             "properties": {
-                "body": {
-                    "description": "正確回傳內容"
+                "client": {
+                    "description": "客戶 (未填則同來源專案)",
+                    "type": "string"
                 },
                 "code": {
-                    "description": "回傳代碼",
-                    "type": "integer"
+                    "description": "代號",
+                    "type": "string"
                 },
-                "timestamp": {
-                    "description": "錯誤時間",
-                    "type": "string",
-                    "example": "2021-07-29T07:23:47Z"
+                "project_name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "起始日期 (任務日期依此日期平移)",
+                    "type": "string"
                 }
             }
         },
-        "departments.Create": {
+        "projects.Create": {
             "type": "object",
             "required": [
-                "name"
+                "status"
             ],
             "properties": {
-                "affiliations": {
-                    "description": "affiliations data",
+                "calendar_uuid": {
+                    "description": "行事曆UUID",
+                    "type": "string"
+                },
+                "client": {
+                    "description": "客戶",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "代號",
+                    "type": "string"
+                },
+                "end_date": {
+                    "description": "結束日期",
+                    "type": "string"
+                },
+                "progress_from_checklist": {
+                    "description": "是否依檢查清單的完成比例計算任務進度",
+                    "type": "boolean"
+                },
+                "project_name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "resource": {
+                    "description": "資源",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/affiliations.CreateForDept"
+                        "$ref": "#/definitions/projects.ProjectResource"
                     }
                 },
-                "fax": {
-                    "description": "傳真",
+                "start_date": {
+                    "description": "起始日期",
                     "type": "string"
                 },
-                "name": {
-                    "description": "名稱",
+                "status": {
+                    "description": "狀態",
                     "type": "string"
                 },
-                "supervisor_id": {
-                    "description": "部門主管ID(user_id)",
+                "template_uuid": {
+                    "description": "範本UUID (依範本建立任務、專案資源及事件標記，日期依起始日期平移)",
                     "type": "string"
                 },
-                "tel": {
-                    "description": "電話",
+                "type_id": {
+                    "description": "類別ID",
                     "type": "string"
                 }
             }
         },
-        "departments.List": {
+        "projects.Filter": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "description": "客戶",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "代號",
+                    "type": "string"
+                },
+                "end_date": {
+                    "description": "結束日期",
+                    "type": "string"
+                },
+                "manager": {
+                    "description": "負責人",
+                    "type": "string"
+                },
+                "project_name": {
+                    "description": "名稱",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "起始日期",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "狀態",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "description": "類別",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "projects.List": {
             "type": "object",
             "required": [
                 "limit",
                 "page"
             ],
             "properties": {
-                "departments": {
+                "limit": {
+                    "description": "筆數(請從1開始帶入,最高上限20)",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "頁數(請從1開始帶入)",
+                    "type": "integer"
+                },
+                "pages": {
+                    "description": "總頁數",
+                    "type": "integer"
+                },
+                "projects": {
                     "description": "多筆",
                     "type": "array",
                     "items": {
                         "type": "object",
                         "properties": {
-                            "affiliations": {
-                                "description": "affiliations data",
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/affiliations.Single"
-                                }
+                            "calendar_uuid": {
+                                "description": "行事曆UUID",
+                                "type": "string"
+                            },
+                            "client": {
+                                "description": "客戶",
+                                "type": "string"
+                            },
+                            "code": {
+                                "description": "代號",
+                                "type": "string"
                             },
                             "created_at": {
                                 "description": "創建時間",
@@ -7373,24 +15747,48 @@ const docTemplate = `{
                                 "description": "刪除時間",
                                 "type": "string"
                             },
-                            "fax": {
-                                "description": "傳真",
+                            "end_date": {
+                                "description": "結束日期",
+                                "type": "string"
+                            },
+                            "is_editable": {
+                                "description": "是否可編輯或刪除專案",
+                                "type": "boolean"
+                            },
+                            "manager": {
+                                "description": "負責人",
+                                "type": "string"
+                            },
+                            "progress": {
+                                "description": "專案進度",
+                                "type": "integer"
+                            },
+                            "progress_from_checklist": {
+                                "description": "是否依檢查清單的完成比例計算任務進度",
+                                "type": "boolean"
+                            },
+                            "project_id": {
+                                "description": "前端編號 (非表ID)",
+                                "type": "string"
+                            },
+                            "project_name": {
+                                "description": "名稱",
                                 "type": "string"
                             },
-                            "id": {
+                            "project_uuid": {
                                 "description": "表ID",
                                 "type": "string"
                             },
-                            "name": {
-                                "description": "名稱",
+                            "start_date": {
+                                "description": "起始日期",
                                 "type": "string"
                             },
-                            "supervisor": {
-                                "description": "部門主管",
+                            "status": {
+                                "description": "狀態",
                                 "type": "string"
                             },
-                            "tel": {
-                                "description": "電話",
+                            "type": {
+                                "description": "類別",
                                 "type": "string"
                             },
                             "updated_at": {
@@ -7404,33 +15802,43 @@ const docTemplate = `{
                         }
                     }
                 },
-                "limit": {
-                    "description": "筆數(請從1開始帶入,最高上限20)",
-                    "type": "integer"
-                },
-                "page": {
-                    "description": "頁數(請從1開始帶入)",
-                    "type": "integer"
-                },
-                "pages": {
-                    "description": "總頁數",
-                    "type": "integer"
-                },
                 "total": {
                     "description": "總筆數",
                     "type": "integer"
                 }
             }
         },
-        "departments.Single": {
+        "projects.ProjectResource": {
             "type": "object",
             "properties": {
-                "affiliations": {
-                    "description": "affiliations data",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/affiliations.Single"
-                    }
+                "is_editable": {
+                    "description": "是否可編輯專案任務",
+                    "type": "boolean"
+                },
+                "resource_uuid": {
+                    "description": "資源UUID",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "專案角色",
+                    "type": "string"
+                }
+            }
+        },
+        "projects.Single": {
+            "type": "object",
+            "properties": {
+                "calendar_uuid": {
+                    "description": "行事曆UUID",
+                    "type": "string"
+                },
+                "client": {
+                    "description": "客戶",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "代號",
+                    "type": "string"
                 },
                 "created_at": {
                     "description": "創建時間",
@@ -7444,171 +15852,157 @@ const docTemplate = `{
                     "description": "刪除時間",
                     "type": "string"
                 },
-                "fax": {
-                    "description": "傳真",
-                    "type": "string"
-                },
-                "id": {
-                    "description": "表ID",
+                "end_date": {
+                    "description": "結束日期",
                     "type": "string"
                 },
-                "name": {
-                    "description": "名稱",
+                "manager": {
+                    "description": "負責人",
                     "type": "string"
                 },
-                "supervisor": {
-                    "description": "部門主管",
-                    "type": "string"
+                "progress": {
+                    "description": "專案進度",
+                    "type": "integer"
                 },
-                "tel": {
-                    "description": "電話",
-                    "type": "string"
+                "progress_from_checklist": {
+                    "description": "是否依檢查清單的完成比例計算任務進度",
+                    "type": "boolean"
                 },
-                "updated_at": {
-                    "description": "更新時間",
+                "project_id": {
+                    "description": "前端編號 (非表ID)",
                     "type": "string"
                 },
-                "updated_by": {
-                    "description": "更新者",
+                "project_name": {
+                    "description": "名稱",
                     "type": "string"
-                }
-            }
-        },
-        "departments.Update": {
-            "type": "object",
-            "properties": {
-                "affiliations": {
-                    "description": "affiliations data",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/affiliations.Update"
-                    }
                 },
-                "fax": {
-                    "description": "傳真",
+                "project_uuid": {
+                    "description": "表ID",
                     "type": "string"
                 },
-                "name": {
-                    "description": "名稱",
+                "start_date": {
+                    "description": "起始日期",
                     "type": "string"
                 },
-                "supervisor_id": {
-                    "description": "部門主管ID(user_id)",
+                "status": {
+                    "description": "狀態",
                     "type": "string"
                 },
-                "tel": {
-                    "description": "電話",
-                    "type": "string"
-                }
-            }
-        },
-        "event_marks.Create": {
-            "type": "object",
-            "required": [
-                "project_uuid"
-            ],
-            "properties": {
-                "day": {
-                    "description": "日期",
+                "type": {
+                    "description": "類別",
                     "type": "string"
                 },
-                "label": {
-                    "description": "名稱",
+                "updated_at": {
+                    "description": "更新時間",
                     "type": "string"
                 },
-                "project_uuid": {
-                    "description": "專案UUID",
+                "updated_by": {
+                    "description": "更新者",
                     "type": "string"
                 }
             }
         },
-        "event_marks.Single": {
+        "projects.Update": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "description": "創建時間",
+                "calendar_uuid": {
+                    "description": "行事曆UUID (空字串表示改用預設行事曆)",
                     "type": "string"
                 },
-                "created_by": {
-                    "description": "創建者",
+                "client": {
+                    "description": "客戶",
                     "type": "string"
                 },
-                "day": {
-                    "description": "日期",
+                "code": {
+                    "description": "代號",
                     "type": "string"
                 },
-                "deleted_at": {
-                    "description": "刪除時間",
+                "end_date": {
+                    "description": "結束日期",
                     "type": "string"
                 },
-                "id": {
-                    "description": "表ID",
-                    "type": "string"
+                "progress_from_checklist": {
+                    "description": "是否依檢查清單的完成比例計算任務進度",
+                    "type": "boolean"
                 },
-                "label": {
+                "project_name": {
                     "description": "名稱",
                     "type": "string"
                 },
-                "project_uuid": {
-                    "description": "專案UUID",
+                "resource": {
+                    "description": "資源",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/projects.ProjectResource"
+                    }
+                },
+                "start_date": {
+                    "description": "起始日期",
                     "type": "string"
                 },
-                "updated_at": {
-                    "description": "更新時間",
+                "status": {
+                    "description": "狀態",
                     "type": "string"
                 },
-                "updated_by": {
-                    "description": "更新者",
+                "type_id": {
+                    "description": "類別ID",
                     "type": "string"
                 }
             }
         },
-        "event_marks.Update": {
+        "resource_exceptions.Create": {
             "type": "object",
+            "required": [
+                "end_date",
+                "resource_uuid",
+                "start_date"
+            ],
             "properties": {
-                "day": {
-                    "description": "日期",
+                "end_date": {
+                    "description": "結束日期",
                     "type": "string"
                 },
-                "label": {
+                "name": {
                     "description": "名稱",
                     "type": "string"
                 },
-                "project_uuid": {
-                    "description": "專案UUID",
-                    "type": "string"
-                }
-            }
-        },
-        "holidays.Create": {
-            "type": "object",
-            "properties": {
-                "cssClass": {
-                    "description": "前端css",
+                "resource_uuid": {
+                    "description": "資源UUID",
                     "type": "string"
                 },
-                "from": {
+                "start_date": {
                     "description": "起始日期",
                     "type": "string"
                 },
-                "label": {
-                    "description": "名稱",
-                    "type": "string"
-                },
-                "to": {
-                    "description": "結束日期",
-                    "type": "string"
+                "workWeek": {
+                    "description": "工作日 (空值表示請假，否則為兼職期間的工作日)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "holidays.List": {
+        "resource_exceptions.List": {
             "type": "object",
             "required": [
                 "limit",
                 "page"
             ],
             "properties": {
-                "holidays": {
+                "limit": {
+                    "description": "筆數(請從1開始帶入,最高上限20)",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "頁數(請從1開始帶入)",
+                    "type": "integer"
+                },
+                "pages": {
+                    "description": "總頁數",
+                    "type": "integer"
+                },
+                "resource_exceptions": {
                     "description": "多筆",
                     "type": "array",
                     "items": {
@@ -7622,28 +16016,28 @@ const docTemplate = `{
                                 "description": "創建者",
                                 "type": "string"
                             },
-                            "cssClass": {
-                                "description": "前端css",
-                                "type": "string"
-                            },
                             "deleted_at": {
                                 "description": "刪除時間",
                                 "type": "string"
                             },
-                            "from": {
-                                "description": "起始日期",
+                            "end_date": {
+                                "description": "結束日期",
                                 "type": "string"
                             },
                             "id": {
                                 "description": "表ID",
                                 "type": "string"
                             },
-                            "label": {
+                            "name": {
                                 "description": "名稱",
                                 "type": "string"
                             },
-                            "to": {
-                                "description": "結束日期",
+                            "resource_uuid": {
+                                "description": "資源UUID",
+                                "type": "string"
+                            },
+                            "start_date": {
+                                "description": "起始日期",
                                 "type": "string"
                             },
                             "updated_at": {
@@ -7653,29 +16047,24 @@ const docTemplate = `{
                             "updated_by": {
                                 "description": "更新者",
                                 "type": "string"
+                            },
+                            "workWeek": {
+                                "description": "工作日",
+                                "type": "array",
+                                "items": {
+                                    "type": "string"
+                                }
                             }
                         }
                     }
                 },
-                "limit": {
-                    "description": "筆數(請從1開始帶入,最高上限20)",
-                    "type": "integer"
-                },
-                "page": {
-                    "description": "頁數(請從1開始帶入)",
-                    "type": "integer"
-                },
-                "pages": {
-                    "description": "總頁數",
-                    "type": "integer"
-                },
                 "total": {
                     "description": "總筆數",
                     "type": "integer"
                 }
             }
         },
-        "holidays.Single": {
+        "resource_exceptions.Single": {
             "type": "object",
             "properties": {
                 "created_at": {
@@ -7686,28 +16075,28 @@ const docTemplate = `{
                     "description": "創建者",
                     "type": "string"
                 },
-                "cssClass": {
-                    "description": "前端css",
-                    "type": "string"
-                },
                 "deleted_at": {
                     "description": "刪除時間",
                     "type": "string"
                 },
-                "from": {
-                    "description": "起始日期",
+                "end_date": {
+                    "description": "結束日期",
                     "type": "string"
                 },
                 "id": {
                     "description": "表ID",
                     "type": "string"
                 },
-                "label": {
+                "name": {
                     "description": "名稱",
                     "type": "string"
                 },
-                "to": {
-                    "description": "結束日期",
+                "resource_uuid": {
+                    "description": "資源UUID",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "起始日期",
                     "type": "string"
                 },
                 "updated_at": {
@@ -7717,236 +16106,367 @@ const docTemplate = `{
                 "updated_by": {
                     "description": "更新者",
                     "type": "string"
+                },
+                "workWeek": {
+                    "description": "工作日",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "holidays.Update": {
+        "resource_exceptions.Update": {
             "type": "object",
             "properties": {
-                "cssClass": {
-                    "description": "前端css",
-                    "type": "string"
-                },
-                "from": {
-                    "description": "起始日期",
+                "end_date": {
+                    "description": "結束日期",
                     "type": "string"
                 },
-                "label": {
+                "name": {
                     "description": "名稱",
                     "type": "string"
                 },
-                "to": {
-                    "description": "結束日期",
+                "start_date": {
+                    "description": "起始日期",
                     "type": "string"
+                },
+                "workWeek": {
+                    "description": "工作日 (空陣列表示改為請假)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "jwx.Refresh": {
+        "resources.Create": {
             "type": "object",
             "required": [
-                "refresh_token"
+                "email"
             ],
             "properties": {
-                "refresh_token": {
-                    "description": "刷新令牌",
-                    "type": "string"
-                }
-            }
-        },
-        "jwx.Token": {
-            "type": "object",
-            "properties": {
-                "access_token": {
-                    "description": "授權令牌",
+                "email": {
+                    "description": "信箱",
                     "type": "string"
                 },
-                "is_complete": {
-                    "description": "是否填寫完整",
+                "is_expand": {
                     "type": "boolean"
                 },
-                "refresh_token": {
-                    "description": "刷新令牌",
+                "max_units": {
+                    "description": "最大單位 (百分比，預設100)",
+                    "type": "number"
+                },
+                "phone": {
+                    "description": "電話",
                     "type": "string"
                 },
-                "role": {
-                    "description": "角色",
+                "resource_groups": {
+                    "description": "群組",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resource_name": {
+                    "description": "名字",
                     "type": "string"
+                },
+                "standard_cost": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "total_cost": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "total_load": {
+                    "description": "總負載",
+                    "type": "number",
+                    "minimum": 0
                 }
             }
         },
-        "logins.Forget": {
+        "resources.Filter": {
             "type": "object",
-            "required": [
-                "domain",
-                "email"
-            ],
             "properties": {
-                "domain": {
-                    "description": "網域",
+                "email": {
+                    "description": "信箱",
                     "type": "string"
                 },
-                "email": {
-                    "description": "使用者電子郵件",
+                "phone": {
+                    "description": "電話",
                     "type": "string"
                 },
-                "port": {
-                    "description": "連接埠",
+                "resource_groups": {
+                    "description": "群組",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resource_name": {
+                    "description": "名字",
                     "type": "string"
                 }
             }
         },
-        "logins.Login": {
+        "resources.Import": {
             "type": "object",
             "required": [
-                "password",
-                "user_name"
+                "base64"
             ],
             "properties": {
-                "change_to": {
-                    "description": "更換驗證方式 1: email, 2: authenticator",
-                    "type": "integer"
-                },
-                "password": {
-                    "description": "密碼",
-                    "type": "string"
-                },
-                "user_name": {
-                    "description": "使用者名稱",
+                "base64": {
+                    "description": "Base64",
                     "type": "string"
                 }
             }
         },
-        "logins.Register": {
+        "resources.List": {
             "type": "object",
             "required": [
-                "domain",
-                "email",
-                "password",
-                "user_name"
+                "limit",
+                "page"
             ],
             "properties": {
-                "domain": {
-                    "description": "網域",
-                    "type": "string"
+                "limit": {
+                    "description": "筆數(請從1開始帶入,最高上限20)",
+                    "type": "integer"
                 },
-                "email": {
-                    "description": "使用者電子郵件",
-                    "type": "string"
+                "page": {
+                    "description": "頁數(請從1開始帶入)",
+                    "type": "integer"
                 },
-                "password": {
-                    "description": "使用者密碼",
-                    "type": "string"
+                "pages": {
+                    "description": "總頁數",
+                    "type": "integer"
                 },
-                "port": {
-                    "description": "連接埠",
-                    "type": "string"
+                "resources": {
+                    "description": "多筆",
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "created_at": {
+                                "description": "創建時間",
+                                "type": "string"
+                            },
+                            "created_by": {
+                                "description": "創建者",
+                                "type": "string"
+                            },
+                            "deleted_at": {
+                                "description": "刪除時間",
+                                "type": "string"
+                            },
+                            "email": {
+                                "description": "信箱",
+                                "type": "string"
+                            },
+                            "is_bind": {
+                                "description": "是否綁定",
+                                "type": "boolean"
+                            },
+                            "is_editable": {
+                                "description": "是否可編輯或刪除資源",
+                                "type": "boolean"
+                            },
+                            "is_expand": {
+                                "type": "boolean"
+                            },
+                            "is_overallocated": {
+                                "description": "是否過度配置",
+                                "type": "boolean"
+                            },
+                            "max_units": {
+                                "description": "最大單位 (百分比)",
+                                "type": "number"
+                            },
+                            "phone": {
+                                "description": "電話",
+                                "type": "string"
+                            },
+                            "resource_groups": {
+                                "description": "群組",
+                                "type": "array",
+                                "items": {
+                                    "type": "string"
+                                }
+                            },
+                            "resource_id": {
+                                "description": "舊編號 ＆ 頁面ID",
+                                "type": "integer"
+                            },
+                            "resource_name": {
+                                "description": "名字",
+                                "type": "string"
+                            },
+                            "resource_uuid": {
+                                "description": "表ID",
+                                "type": "string"
+                            },
+                            "standard_cost": {
+                                "type": "number"
+                            },
+                            "total_cost": {
+                                "type": "number"
+                            },
+                            "total_load": {
+                                "description": "總負載",
+                                "type": "number"
+                            },
+                            "updated_at": {
+                                "description": "更新時間",
+                                "type": "string"
+                            },
+                            "updated_by": {
+                                "description": "更新者",
+                                "type": "string"
+                            }
+                        }
+                    }
                 },
-                "user_name": {
-                    "description": "使用者名稱",
-                    "type": "string"
+                "total": {
+                    "description": "總筆數",
+                    "type": "integer"
                 }
             }
         },
-        "logins.Verify": {
+        "resources.LoadList": {
             "type": "object",
-            "required": [
-                "passcode",
-                "user_name"
-            ],
             "properties": {
-                "passcode": {
-                    "description": "驗證碼",
+                "end_date": {
+                    "description": "結束日期",
                     "type": "string"
                 },
-                "user_name": {
-                    "description": "使用者名稱",
+                "interval": {
+                    "description": "區間",
+                    "type": "string"
+                },
+                "resources": {
+                    "description": "多筆",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.ResourceLoad"
+                    }
+                },
+                "start_date": {
+                    "description": "起始日期",
                     "type": "string"
                 }
             }
         },
-        "policies.PolicyRule": {
+        "resources.OverallocatedTask": {
             "type": "object",
-            "required": [
-                "method",
-                "path",
-                "role_name"
-            ],
             "properties": {
-                "method": {
+                "project_uuid": {
+                    "description": "專案UUID",
                     "type": "string"
                 },
-                "path": {
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
                     "type": "string"
                 },
-                "role_name": {
+                "task_name": {
+                    "description": "任務名稱",
+                    "type": "string"
+                },
+                "task_uuid": {
+                    "description": "任務UUID",
                     "type": "string"
                 }
             }
         },
-        "policies.Single": {
+        "resources.Overallocation": {
             "type": "object",
             "properties": {
-                "method": {
+                "end_date": {
+                    "description": "結束日期",
                     "type": "string"
                 },
-                "path": {
+                "max_units": {
+                    "description": "最大單位 (百分比)",
+                    "type": "number"
+                },
+                "resource_name": {
+                    "description": "資源名字",
                     "type": "string"
                 },
-                "role_name": {
+                "resource_uuid": {
+                    "description": "資源UUID",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "起始日期",
                     "type": "string"
+                },
+                "tasks": {
+                    "description": "衝突的任務",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.OverallocatedTask"
+                    }
+                },
+                "units": {
+                    "description": "合計單位 (百分比)",
+                    "type": "number"
                 }
             }
         },
-        "project_resources.Filter": {
+        "resources.PeriodLoad": {
             "type": "object",
             "properties": {
-                "resource_group": {
-                    "description": "群組",
-                    "type": "string"
+                "available_hours": {
+                    "description": "可用工時 (依預設工作行事曆及資源例外計算)",
+                    "type": "number"
                 },
-                "resource_name": {
-                    "description": "名字",
+                "end_date": {
+                    "description": "區間結束日期",
                     "type": "string"
                 },
-                "role": {
-                    "description": "角色",
+                "hours": {
+                    "description": "分配工時 (依單位及工作行事曆計算，跨專案加總)",
+                    "type": "number"
+                },
+                "start_date": {
+                    "description": "區間起始日期",
                     "type": "string"
                 }
             }
         },
-        "project_resources.List": {
+        "resources.ResourceLoad": {
             "type": "object",
-            "required": [
-                "limit",
-                "page"
-            ],
             "properties": {
-                "limit": {
-                    "description": "筆數(請從1開始帶入,最高上限20)",
-                    "type": "integer"
-                },
-                "page": {
-                    "description": "頁數(請從1開始帶入)",
-                    "type": "integer"
-                },
-                "pages": {
-                    "description": "總頁數",
-                    "type": "integer"
+                "loads": {
+                    "description": "各區間負載",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.PeriodLoad"
+                    }
                 },
-                "project_resources": {
-                    "description": "多筆",
+                "resource_groups": {
+                    "description": "群組",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/project_resources.Single"
+                        "type": "string"
                     }
                 },
-                "total": {
-                    "description": "總筆數",
-                    "type": "integer"
+                "resource_name": {
+                    "description": "名字",
+                    "type": "string"
+                },
+                "resource_uuid": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "total_hours": {
+                    "description": "分配工時合計",
+                    "type": "number"
                 }
             }
         },
-        "project_resources.Single": {
+        "resources.Single": {
             "type": "object",
             "properties": {
                 "created_at": {
@@ -7965,29 +16485,100 @@ const docTemplate = `{
                     "description": "信箱",
                     "type": "string"
                 },
-                "id": {
-                    "description": "表ID",
-                    "type": "string"
+                "is_bind": {
+                    "description": "是否綁定",
+                    "type": "boolean"
                 },
                 "is_editable": {
-                    "description": "是否可編輯專案任務",
+                    "description": "是否可編輯或刪除資源",
                     "type": "boolean"
                 },
                 "is_expand": {
                     "type": "boolean"
                 },
+                "is_overallocated": {
+                    "description": "是否過度配置",
+                    "type": "boolean"
+                },
+                "max_units": {
+                    "description": "最大單位 (百分比)",
+                    "type": "number"
+                },
+                "overallocations": {
+                    "description": "過度配置的期間",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.Overallocation"
+                    }
+                },
                 "phone": {
                     "description": "電話",
                     "type": "string"
                 },
-                "project_uuid": {
-                    "description": "專案UUID",
+                "resource_groups": {
+                    "description": "群組",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resource_id": {
+                    "description": "舊編號 ＆ 頁面ID",
+                    "type": "integer"
+                },
+                "resource_name": {
+                    "description": "名字",
                     "type": "string"
                 },
-                "resource_group": {
-                    "description": "群組",
+                "resource_uuid": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "standard_cost": {
+                    "type": "number"
+                },
+                "total_cost": {
+                    "type": "number"
+                },
+                "total_load": {
+                    "description": "總負載",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "description": "更新時間",
+                    "type": "string"
+                },
+                "updated_by": {
+                    "description": "更新者",
+                    "type": "string"
+                }
+            }
+        },
+        "resources.TaskSingle": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "信箱",
+                    "type": "string"
+                },
+                "is_expand": {
+                    "type": "boolean"
+                },
+                "is_overallocated": {
+                    "description": "是否過度配置",
+                    "type": "boolean"
+                },
+                "phone": {
+                    "description": "電話",
                     "type": "string"
                 },
+                "resource_groups": {
+                    "description": "群組",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "resource_id": {
                     "description": "舊編號 ＆ 頁面ID",
                     "type": "integer"
@@ -7996,44 +16587,99 @@ const docTemplate = `{
                     "description": "名字",
                     "type": "string"
                 },
-                "resource_uuid": {
-                    "description": "資源UUID",
-                    "type": "string"
-                },
-                "role": {
-                    "description": "專案角色",
-                    "type": "string"
-                },
+                "resource_uuid": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "專案角色",
+                    "type": "string"
+                },
+                "standard_cost": {
+                    "type": "number"
+                },
+                "total_cost": {
+                    "type": "number"
+                },
+                "total_load": {
+                    "description": "總負載",
+                    "type": "number"
+                },
+                "unavailable_dates": {
+                    "description": "任務期間內資源不上班的工作日",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "unit": {
+                    "description": "單位",
+                    "type": "number"
+                }
+            }
+        },
+        "resources.Update": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "信箱",
+                    "type": "string"
+                },
+                "is_expand": {
+                    "type": "boolean"
+                },
+                "max_units": {
+                    "description": "最大單位 (百分比)",
+                    "type": "number"
+                },
+                "phone": {
+                    "description": "電話",
+                    "type": "string"
+                },
+                "resource_groups": {
+                    "description": "群組",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resource_name": {
+                    "description": "名字",
+                    "type": "string"
+                },
                 "standard_cost": {
-                    "type": "number"
+                    "type": "number",
+                    "minimum": 0
                 },
                 "total_cost": {
-                    "type": "number"
+                    "type": "number",
+                    "minimum": 0
                 },
                 "total_load": {
                     "description": "總負載",
-                    "type": "number"
-                },
-                "updated_at": {
-                    "description": "更新時間",
-                    "type": "string"
-                },
-                "updated_by": {
-                    "description": "更新者",
-                    "type": "string"
+                    "type": "number",
+                    "minimum": 0
                 }
             }
         },
-        "project_types.Create": {
+        "roles.Create": {
             "type": "object",
+            "required": [
+                "display_name",
+                "name"
+            ],
             "properties": {
+                "display_name": {
+                    "description": "角色顯示名稱",
+                    "type": "string"
+                },
                 "name": {
-                    "description": "名稱",
+                    "description": "角色名稱",
                     "type": "string"
                 }
             }
         },
-        "project_types.List": {
+        "roles.List": {
             "type": "object",
             "required": [
                 "limit",
@@ -8052,7 +16698,7 @@ const docTemplate = `{
                     "description": "總頁數",
                     "type": "integer"
                 },
-                "project_types": {
+                "roles": {
                     "description": "多筆",
                     "type": "array",
                     "items": {
@@ -8070,12 +16716,20 @@ const docTemplate = `{
                                 "description": "刪除時間",
                                 "type": "string"
                             },
+                            "display_name": {
+                                "description": "角色顯示名稱",
+                                "type": "string"
+                            },
                             "id": {
                                 "description": "表ID",
                                 "type": "string"
                             },
+                            "is_enable": {
+                                "description": "角色是否啟用",
+                                "type": "boolean"
+                            },
                             "name": {
-                                "description": "名稱",
+                                "description": "角色名稱",
                                 "type": "string"
                             },
                             "updated_at": {
@@ -8095,7 +16749,7 @@ const docTemplate = `{
                 }
             }
         },
-        "project_types.Single": {
+        "roles.Single": {
             "type": "object",
             "properties": {
                 "created_at": {
@@ -8110,12 +16764,20 @@ const docTemplate = `{
                     "description": "刪除時間",
                     "type": "string"
                 },
+                "display_name": {
+                    "description": "角色顯示名稱",
+                    "type": "string"
+                },
                 "id": {
                     "description": "表ID",
                     "type": "string"
                 },
+                "is_enable": {
+                    "description": "角色是否啟用",
+                    "type": "boolean"
+                },
                 "name": {
-                    "description": "名稱",
+                    "description": "角色名稱",
                     "type": "string"
                 },
                 "updated_at": {
@@ -8128,231 +16790,140 @@ const docTemplate = `{
                 }
             }
         },
-        "project_types.Update": {
-            "type": "object",
-            "properties": {
-                "name": {
-                    "description": "名稱",
-                    "type": "string"
-                }
-            }
-        },
-        "projects.Create": {
-            "type": "object",
-            "required": [
-                "status"
-            ],
-            "properties": {
-                "client": {
-                    "description": "客戶",
-                    "type": "string"
-                },
-                "code": {
-                    "description": "代號",
-                    "type": "string"
-                },
-                "end_date": {
-                    "description": "結束日期",
-                    "type": "string"
-                },
-                "project_name": {
-                    "description": "名稱",
-                    "type": "string"
-                },
-                "resource": {
-                    "description": "資源",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/projects.ProjectResource"
-                    }
-                },
-                "start_date": {
-                    "description": "起始日期",
-                    "type": "string"
-                },
-                "status": {
-                    "description": "狀態",
-                    "type": "string"
-                },
-                "type_id": {
-                    "description": "類別ID",
-                    "type": "string"
-                }
-            }
-        },
-        "projects.Filter": {
+        "roles.Update": {
             "type": "object",
             "properties": {
-                "client": {
-                    "description": "客戶",
-                    "type": "string"
-                },
-                "code": {
-                    "description": "代號",
-                    "type": "string"
-                },
-                "end_date": {
-                    "description": "結束日期",
-                    "type": "string"
-                },
-                "manager": {
-                    "description": "負責人",
+                "display_name": {
+                    "description": "角色顯示名稱",
                     "type": "string"
                 },
-                "project_name": {
-                    "description": "名稱",
-                    "type": "string"
+                "is_enable": {
+                    "description": "角色是否啟用",
+                    "type": "boolean"
                 },
-                "start_date": {
-                    "description": "起始日期",
+                "name": {
+                    "description": "角色名稱",
                     "type": "string"
-                },
-                "status": {
-                    "description": "狀態",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "type": {
-                    "description": "類別",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
                 }
             }
-        },
-        "projects.List": {
-            "type": "object",
-            "required": [
-                "limit",
-                "page"
-            ],
-            "properties": {
-                "limit": {
-                    "description": "筆數(請從1開始帶入,最高上限20)",
-                    "type": "integer"
-                },
-                "page": {
-                    "description": "頁數(請從1開始帶入)",
-                    "type": "integer"
-                },
-                "pages": {
-                    "description": "總頁數",
-                    "type": "integer"
-                },
-                "projects": {
-                    "description": "多筆",
-                    "type": "array",
-                    "items": {
-                        "type": "object",
-                        "properties": {
-                            "client": {
-                                "description": "客戶",
-                                "type": "string"
-                            },
-                            "code": {
-                                "description": "代號",
-                                "type": "string"
-                            },
-                            "created_at": {
-                                "description": "創建時間",
-                                "type": "string"
-                            },
-                            "created_by": {
-                                "description": "創建者",
-                                "type": "string"
-                            },
-                            "deleted_at": {
-                                "description": "刪除時間",
-                                "type": "string"
-                            },
-                            "end_date": {
-                                "description": "結束日期",
-                                "type": "string"
-                            },
-                            "is_editable": {
-                                "description": "是否可編輯或刪除專案",
-                                "type": "boolean"
-                            },
-                            "manager": {
-                                "description": "負責人",
-                                "type": "string"
-                            },
-                            "progress": {
-                                "description": "專案進度",
-                                "type": "integer"
-                            },
-                            "project_id": {
-                                "description": "前端編號 (非表ID)",
-                                "type": "string"
-                            },
-                            "project_name": {
-                                "description": "名稱",
-                                "type": "string"
-                            },
-                            "project_uuid": {
-                                "description": "表ID",
-                                "type": "string"
-                            },
-                            "start_date": {
-                                "description": "起始日期",
-                                "type": "string"
-                            },
-                            "status": {
-                                "description": "狀態",
-                                "type": "string"
-                            },
-                            "type": {
-                                "description": "類別",
-                                "type": "string"
-                            },
-                            "updated_at": {
-                                "description": "更新時間",
-                                "type": "string"
-                            },
-                            "updated_by": {
-                                "description": "更新者",
-                                "type": "string"
-                            }
-                        }
-                    }
+        },
+        "s3_files.Create": {
+            "type": "object",
+            "required": [
+                "base64",
+                "file_name",
+                "source_uuid"
+            ],
+            "properties": {
+                "base64": {
+                    "description": "Base64",
+                    "type": "string"
                 },
-                "total": {
-                    "description": "總筆數",
-                    "type": "integer"
+                "file_name": {
+                    "description": "檔案名稱",
+                    "type": "string"
+                },
+                "source_uuid": {
+                    "description": "來源UUID",
+                    "type": "string"
                 }
             }
         },
-        "projects.ProjectResource": {
+        "s3_files.Single": {
             "type": "object",
             "properties": {
-                "is_editable": {
-                    "description": "是否可編輯專案任務",
+                "created_at": {
+                    "description": "創建時間",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "創建者",
+                    "type": "string"
+                },
+                "file_name": {
+                    "description": "檔案名稱",
+                    "type": "string"
+                },
+                "file_url": {
+                    "description": "檔案連結",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                }
+            }
+        },
+        "task_checklist_items.Create": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "due_date": {
+                    "description": "到期日",
+                    "type": "string"
+                },
+                "is_done": {
+                    "description": "是否完成",
                     "type": "boolean"
                 },
                 "resource_uuid": {
-                    "description": "資源UUID",
+                    "description": "負責資源UUID",
                     "type": "string"
                 },
-                "role": {
-                    "description": "專案角色",
+                "title": {
+                    "description": "標題",
                     "type": "string"
                 }
             }
         },
-        "projects.Single": {
+        "task_checklist_items.List": {
             "type": "object",
             "properties": {
-                "client": {
-                    "description": "客戶",
-                    "type": "string"
+                "done": {
+                    "description": "完成項目數",
+                    "type": "integer"
                 },
-                "code": {
-                    "description": "代號",
+                "items": {
+                    "description": "多筆",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/task_checklist_items.Single"
+                    }
+                },
+                "progress": {
+                    "description": "完成百分比",
+                    "type": "integer"
+                },
+                "task_uuid": {
+                    "description": "任務UUID",
                     "type": "string"
                 },
+                "total": {
+                    "description": "項目總數",
+                    "type": "integer"
+                }
+            }
+        },
+        "task_checklist_items.Reorder": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "description": "項目IDs (依新順序排列，須包含任務的全部項目)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "task_checklist_items.Single": {
+            "type": "object",
+            "properties": {
                 "created_at": {
                     "description": "創建時間",
                     "type": "string"
@@ -8365,40 +16936,36 @@ const docTemplate = `{
                     "description": "刪除時間",
                     "type": "string"
                 },
-                "end_date": {
-                    "description": "結束日期",
+                "due_date": {
+                    "description": "到期日",
                     "type": "string"
                 },
-                "manager": {
-                    "description": "負責人",
+                "id": {
+                    "description": "表ID",
                     "type": "string"
                 },
-                "progress": {
-                    "description": "專案進度",
-                    "type": "integer"
-                },
-                "project_id": {
-                    "description": "前端編號 (非表ID)",
-                    "type": "string"
+                "is_done": {
+                    "description": "是否完成",
+                    "type": "boolean"
                 },
-                "project_name": {
-                    "description": "名稱",
+                "resource_name": {
+                    "description": "負責資源名稱",
                     "type": "string"
                 },
-                "project_uuid": {
-                    "description": "表ID",
+                "resource_uuid": {
+                    "description": "負責資源UUID",
                     "type": "string"
                 },
-                "start_date": {
-                    "description": "起始日期",
-                    "type": "string"
+                "sort_order": {
+                    "description": "排序",
+                    "type": "integer"
                 },
-                "status": {
-                    "description": "狀態",
+                "task_uuid": {
+                    "description": "任務UUID",
                     "type": "string"
                 },
-                "type": {
-                    "description": "類別",
+                "title": {
+                    "description": "標題",
                     "type": "string"
                 },
                 "updated_at": {
@@ -8411,417 +16978,583 @@ const docTemplate = `{
                 }
             }
         },
-        "projects.Update": {
+        "task_checklist_items.Update": {
             "type": "object",
             "properties": {
-                "client": {
-                    "description": "客戶",
+                "due_date": {
+                    "description": "到期日 (傳入0001-01-01T00:00:00Z可移除到期日)",
                     "type": "string"
                 },
-                "code": {
-                    "description": "代號",
+                "is_done": {
+                    "description": "是否完成",
+                    "type": "boolean"
+                },
+                "resource_uuid": {
+                    "description": "負責資源UUID (空字串表示移除負責資源)",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "標題",
+                    "type": "string",
+                    "minLength": 1
+                }
+            }
+        },
+        "tasks.Create": {
+            "type": "object",
+            "required": [
+                "project_uuid",
+                "task_id"
+            ],
+            "properties": {
+                "assignments": {
+                    "description": "未知",
+                    "type": "string"
+                },
+                "baseline_duration": {
+                    "description": "基準線工作天",
+                    "type": "number"
+                },
+                "baseline_end_date": {
+                    "description": "基準線結束日期",
+                    "type": "string"
+                },
+                "baseline_start_date": {
+                    "description": "基準線起始日期",
+                    "type": "string"
+                },
+                "constraint_date": {
+                    "description": "限制日期 (SNET、FNLT、MSO、MFO必填)",
+                    "type": "string"
+                },
+                "constraint_type": {
+                    "description": "限制類型 (ASAP、ALAP、SNET、FNLT、MSO、MFO)",
                     "type": "string"
                 },
+                "cost": {
+                    "description": "花費時間",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "deadline": {
+                    "description": "期限",
+                    "type": "string"
+                },
+                "duration": {
+                    "description": "期間",
+                    "type": "number",
+                    "minimum": 0
+                },
                 "end_date": {
                     "description": "結束日期",
                     "type": "string"
                 },
-                "project_name": {
-                    "description": "名稱",
+                "indicators": {
+                    "description": "任務標示",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.Indicators"
+                    }
+                },
+                "is_milestone": {
+                    "description": "是否為里程碑",
+                    "type": "boolean"
+                },
+                "is_subtask": {
+                    "description": "是否為任務",
+                    "type": "boolean"
+                },
+                "most_likely_duration": {
+                    "description": "最可能期間",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "notes": {
+                    "description": "備註",
                     "type": "string"
                 },
-                "resource": {
-                    "description": "資源",
+                "optimistic_duration": {
+                    "description": "樂觀期間 (三點估計須一併帶入，樂觀 \u003c= 最可能 \u003c= 悲觀)",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "outline_number": {
+                    "description": "1.1.2、1.2、1.2.1",
+                    "type": "string"
+                },
+                "parent_uuid": {
+                    "description": "父層級UUID",
+                    "type": "string"
+                },
+                "pessimistic_duration": {
+                    "description": "悲觀期間",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "predecessor": {
+                    "description": "前任",
+                    "type": "string"
+                },
+                "priority": {
+                    "description": "優先順序 (0~1000，預設500，1000表示不調配資源)",
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 0
+                },
+                "progress": {
+                    "description": "完成百分比",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
+                },
+                "recurrence": {
+                    "description": "週期規則 (帶入時此任務為摘要任務，依規則產生週期任務為其子任務，起始日期必填)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/tasks.Recurrence"
+                        }
+                    ]
+                },
+                "resources": {
+                    "description": "人力資源",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/projects.ProjectResource"
+                        "$ref": "#/definitions/resources.TaskSingle"
+                    }
+                },
+                "segments": {
+                    "description": "任務分段",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.Segments"
+                    }
+                },
+                "start_date": {
+                    "description": "起始日期",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "狀態 (not_started、in_progress、blocked、on_hold、done、cancelled，空值依完成百分比判斷)",
+                    "type": "string",
+                    "enum": [
+                        "not_started",
+                        "in_progress",
+                        "blocked",
+                        "on_hold",
+                        "done",
+                        "cancelled"
+                    ]
+                },
+                "subtasks": {
+                    "description": "子任務",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.Create"
                     }
                 },
-                "start_date": {
-                    "description": "起始日期",
+                "task_color": {
+                    "description": "紀錄標的顏色",
                     "type": "string"
                 },
-                "status": {
-                    "description": "狀態",
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
                     "type": "string"
                 },
-                "type_id": {
-                    "description": "類別ID",
+                "task_name": {
+                    "description": "任務名稱",
+                    "type": "string"
+                },
+                "web_link": {
+                    "description": "預留：外部連結",
                     "type": "string"
                 }
             }
         },
-        "resources.Create": {
+        "tasks.CriticalPath": {
             "type": "object",
-            "required": [
-                "email"
-            ],
             "properties": {
-                "email": {
-                    "description": "信箱",
-                    "type": "string"
-                },
-                "is_expand": {
-                    "type": "boolean"
-                },
-                "phone": {
-                    "description": "電話",
-                    "type": "string"
-                },
-                "resource_groups": {
-                    "description": "群組",
+                "critical_tasks": {
+                    "description": "要徑任務UUIDs",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "resource_name": {
-                    "description": "名字",
+                "finish_date": {
+                    "description": "專案最早完成日期",
                     "type": "string"
                 },
-                "standard_cost": {
-                    "type": "number",
-                    "minimum": 0
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
                 },
-                "total_cost": {
-                    "type": "number",
-                    "minimum": 0
+                "start_date": {
+                    "description": "專案最早開始日期",
+                    "type": "string"
                 },
-                "total_load": {
-                    "description": "總負載",
-                    "type": "number",
-                    "minimum": 0
+                "tasks": {
+                    "description": "多筆",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.Single"
+                    }
                 }
             }
         },
-        "resources.Filter": {
+        "tasks.Duplicate": {
             "type": "object",
             "properties": {
-                "email": {
-                    "description": "信箱",
-                    "type": "string"
+                "include_attachments": {
+                    "description": "是否複製附件",
+                    "type": "boolean"
                 },
-                "phone": {
-                    "description": "電話",
-                    "type": "string"
+                "position": {
+                    "description": "位置 (before: 目標任務之前、after: 目標任務之後、under: 目標任務的最後一個子任務，預設after)",
+                    "type": "string",
+                    "enum": [
+                        "before",
+                        "after",
+                        "under"
+                    ]
                 },
-                "resource_groups": {
-                    "description": "群組",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "project_uuid": {
+                    "description": "目標專案UUID (未填則為原專案)",
+                    "type": "string"
                 },
-                "resource_name": {
-                    "description": "名字",
+                "target_uuid": {
+                    "description": "目標任務UUID (未填則置於專案最後)",
                     "type": "string"
                 }
             }
         },
-        "resources.Import": {
+        "tasks.DuplicatedTask": {
             "type": "object",
-            "required": [
-                "base64"
-            ],
             "properties": {
-                "base64": {
-                    "description": "Base64",
+                "outline_number": {
+                    "description": "1.1.2、1.2、1.2.1",
+                    "type": "string"
+                },
+                "source_task_uuid": {
+                    "description": "來源任務UUID",
+                    "type": "string"
+                },
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
+                    "type": "string"
+                },
+                "task_uuid": {
+                    "description": "表ID",
                     "type": "string"
                 }
             }
         },
-        "resources.List": {
+        "tasks.EarnedValue": {
             "type": "object",
-            "required": [
-                "limit",
-                "page"
-            ],
             "properties": {
-                "limit": {
-                    "description": "筆數(請從1開始帶入,最高上限20)",
-                    "type": "integer"
+                "project": {
+                    "description": "專案實獲值",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/tasks.EarnedValueMetrics"
+                        }
+                    ]
                 },
-                "page": {
-                    "description": "頁數(請從1開始帶入)",
-                    "type": "integer"
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
                 },
-                "pages": {
-                    "description": "總頁數",
-                    "type": "integer"
+                "status_date": {
+                    "description": "狀態日期",
+                    "type": "string"
                 },
-                "resources": {
-                    "description": "多筆",
+                "tasks": {
+                    "description": "任務實獲值",
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "properties": {
-                            "created_at": {
-                                "description": "創建時間",
-                                "type": "string"
-                            },
-                            "created_by": {
-                                "description": "創建者",
-                                "type": "string"
-                            },
-                            "deleted_at": {
-                                "description": "刪除時間",
-                                "type": "string"
-                            },
-                            "email": {
-                                "description": "信箱",
-                                "type": "string"
-                            },
-                            "is_bind": {
-                                "description": "是否綁定",
-                                "type": "boolean"
-                            },
-                            "is_editable": {
-                                "description": "是否可編輯或刪除資源",
-                                "type": "boolean"
-                            },
-                            "is_expand": {
-                                "type": "boolean"
-                            },
-                            "phone": {
-                                "description": "電話",
-                                "type": "string"
-                            },
-                            "resource_groups": {
-                                "description": "群組",
-                                "type": "array",
-                                "items": {
-                                    "type": "string"
-                                }
-                            },
-                            "resource_id": {
-                                "description": "舊編號 ＆ 頁面ID",
-                                "type": "integer"
-                            },
-                            "resource_name": {
-                                "description": "名字",
-                                "type": "string"
-                            },
-                            "resource_uuid": {
-                                "description": "表ID",
-                                "type": "string"
-                            },
-                            "standard_cost": {
-                                "type": "number"
-                            },
-                            "total_cost": {
-                                "type": "number"
-                            },
-                            "total_load": {
-                                "description": "總負載",
-                                "type": "number"
-                            },
-                            "updated_at": {
-                                "description": "更新時間",
-                                "type": "string"
-                            },
-                            "updated_by": {
-                                "description": "更新者",
-                                "type": "string"
-                            }
-                        }
+                        "$ref": "#/definitions/tasks.TaskEarnedValue"
                     }
-                },
-                "total": {
-                    "description": "總筆數",
-                    "type": "integer"
                 }
             }
         },
-        "resources.Single": {
+        "tasks.EarnedValueMetrics": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "description": "創建時間",
-                    "type": "string"
+                "ac": {
+                    "description": "實際成本 (AC)",
+                    "type": "number"
                 },
-                "created_by": {
-                    "description": "創建者",
-                    "type": "string"
+                "bac": {
+                    "description": "完工預算 (BAC)",
+                    "type": "number"
                 },
-                "deleted_at": {
-                    "description": "刪除時間",
-                    "type": "string"
+                "cpi": {
+                    "description": "成本績效指標 (CPI = EV / AC，AC為0時為空值)",
+                    "type": "number"
                 },
-                "email": {
-                    "description": "信箱",
-                    "type": "string"
+                "cv": {
+                    "description": "成本差異 (CV = EV - AC)",
+                    "type": "number"
                 },
-                "is_bind": {
-                    "description": "是否綁定",
-                    "type": "boolean"
+                "eac": {
+                    "description": "完工估算 (EAC)",
+                    "type": "number"
                 },
-                "is_editable": {
-                    "description": "是否可編輯或刪除資源",
-                    "type": "boolean"
+                "etc": {
+                    "description": "完工尚需估算 (ETC = EAC - AC)",
+                    "type": "number"
                 },
-                "is_expand": {
+                "ev": {
+                    "description": "實獲值 (EV)",
+                    "type": "number"
+                },
+                "pv": {
+                    "description": "計畫值 (PV)",
+                    "type": "number"
+                },
+                "spi": {
+                    "description": "時程績效指標 (SPI = EV / PV，PV為0時為空值)",
+                    "type": "number"
+                },
+                "sv": {
+                    "description": "時程差異 (SV = EV - PV)",
+                    "type": "number"
+                }
+            }
+        },
+        "tasks.Filter": {
+            "type": "object",
+            "properties": {
+                "is_milestone": {
+                    "description": "是否為里程碑",
                     "type": "boolean"
+                }
+            }
+        },
+        "tasks.Forecast": {
+            "type": "object",
+            "properties": {
+                "finish_date": {
+                    "description": "目前排程的完成日期",
+                    "type": "string"
                 },
-                "phone": {
-                    "description": "電話",
+                "iterations": {
+                    "description": "模擬次數",
+                    "type": "integer"
+                },
+                "milestones": {
+                    "description": "里程碑完成日期的機率分布",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.MilestoneForecast"
+                    }
+                },
+                "project": {
+                    "description": "專案完成日期的機率分布",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/tasks.ForecastDates"
+                        }
+                    ]
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
                     "type": "string"
                 },
-                "resource_groups": {
-                    "description": "群組",
+                "seed": {
+                    "description": "亂數種子 (帶入相同種子可重現此結果)",
+                    "type": "integer"
+                },
+                "tasks": {
+                    "description": "任務的三點估計及要徑指數",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/tasks.TaskForecast"
                     }
-                },
-                "resource_id": {
-                    "description": "舊編號 ＆ 頁面ID",
-                    "type": "integer"
-                },
-                "resource_name": {
-                    "description": "名字",
+                }
+            }
+        },
+        "tasks.ForecastDates": {
+            "type": "object",
+            "properties": {
+                "p50": {
+                    "description": "50%機率可完成的日期",
                     "type": "string"
                 },
-                "resource_uuid": {
-                    "description": "表ID",
+                "p80": {
+                    "description": "80%機率可完成的日期",
                     "type": "string"
                 },
-                "standard_cost": {
-                    "type": "number"
-                },
-                "total_cost": {
-                    "type": "number"
-                },
-                "total_load": {
-                    "description": "總負載",
-                    "type": "number"
-                },
-                "updated_at": {
-                    "description": "更新時間",
+                "p95": {
+                    "description": "95%機率可完成的日期",
+                    "type": "string"
+                }
+            }
+        },
+        "tasks.Import": {
+            "type": "object",
+            "required": [
+                "base64",
+                "file_type",
+                "project_uuid"
+            ],
+            "properties": {
+                "base64": {
+                    "description": "Base64",
                     "type": "string"
                 },
-                "updated_by": {
-                    "description": "更新者",
+                "file_type": {
+                    "description": "檔案類型 1:gantt project 2:saas pmi",
+                    "type": "integer"
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
                     "type": "string"
                 }
             }
         },
-        "resources.TaskSingle": {
+        "tasks.Indicators": {
             "type": "object",
             "properties": {
-                "email": {
-                    "description": "信箱",
+                "date": {
+                    "description": "日期",
                     "type": "string"
                 },
-                "is_expand": {
-                    "type": "boolean"
+                "iconClass": {
+                    "type": "string"
                 },
-                "phone": {
-                    "description": "電話",
+                "name": {
+                    "description": "名稱",
                     "type": "string"
                 },
-                "resource_groups": {
-                    "description": "群組",
+                "tooltip": {
+                    "description": "工具提示",
+                    "type": "string"
+                }
+            }
+        },
+        "tasks.Level": {
+            "type": "object",
+            "properties": {
+                "mode": {
+                    "description": "模式 (preview: 預覽調配結果(預設)、commit: 套用調配結果)",
+                    "type": "string",
+                    "enum": [
+                        "preview",
+                        "commit"
+                    ]
+                }
+            }
+        },
+        "tasks.LevelResult": {
+            "type": "object",
+            "properties": {
+                "leveled_tasks": {
+                    "description": "調配移動的任務",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/tasks.LeveledTask"
                     }
                 },
-                "resource_id": {
-                    "description": "舊編號 ＆ 頁面ID",
-                    "type": "integer"
-                },
-                "resource_name": {
-                    "description": "名字",
-                    "type": "string"
-                },
-                "resource_uuid": {
-                    "description": "表ID",
+                "mode": {
+                    "description": "模式 (preview、commit)",
                     "type": "string"
                 },
-                "role": {
-                    "description": "專案角色",
-                    "type": "string"
+                "moved_tasks": {
+                    "description": "連動移動的任務 (套用時)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.MovedTask"
+                    }
                 },
-                "standard_cost": {
-                    "type": "number"
+                "overallocations": {
+                    "description": "調配後仍過度配置的資源",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.Overallocation"
+                    }
                 },
-                "total_cost": {
-                    "type": "number"
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
                 },
-                "total_load": {
-                    "description": "總負載",
-                    "type": "number"
+                "summary_tasks": {
+                    "description": "重新彙總的摘要任務 (套用時)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.SummaryTask"
+                    }
                 },
-                "unit": {
-                    "description": "單位",
-                    "type": "number"
+                "warnings": {
+                    "description": "資源不上班的警告 (套用時)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.ResourceWarning"
+                    }
                 }
             }
         },
-        "resources.Update": {
+        "tasks.LeveledTask": {
             "type": "object",
             "properties": {
-                "email": {
-                    "description": "信箱",
+                "delay": {
+                    "description": "延後天數 (工作天)",
+                    "type": "number"
+                },
+                "end_date": {
+                    "description": "結束日期",
                     "type": "string"
                 },
-                "is_expand": {
+                "is_summary": {
+                    "description": "是否為摘要任務 (日期由子任務彙總)",
                     "type": "boolean"
                 },
-                "phone": {
-                    "description": "電話",
+                "original_end_date": {
+                    "description": "原結束日期",
                     "type": "string"
                 },
-                "resource_groups": {
-                    "description": "群組",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "resource_name": {
-                    "description": "名字",
+                "original_start_date": {
+                    "description": "原起始日期",
                     "type": "string"
                 },
-                "standard_cost": {
-                    "type": "number",
-                    "minimum": 0
+                "start_date": {
+                    "description": "起始日期",
+                    "type": "string"
                 },
-                "total_cost": {
-                    "type": "number",
-                    "minimum": 0
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
+                    "type": "string"
                 },
-                "total_load": {
-                    "description": "總負載",
-                    "type": "number",
-                    "minimum": 0
-                }
-            }
-        },
-        "roles.Create": {
-            "type": "object",
-            "required": [
-                "display_name",
-                "name"
-            ],
-            "properties": {
-                "display_name": {
-                    "description": "角色顯示名稱",
+                "task_name": {
+                    "description": "任務名稱",
                     "type": "string"
                 },
-                "name": {
-                    "description": "角色名稱",
+                "task_uuid": {
+                    "description": "表ID",
                     "type": "string"
                 }
             }
         },
-        "roles.List": {
+        "tasks.List": {
             "type": "object",
             "required": [
                 "limit",
                 "page"
             ],
             "properties": {
+                "event_marks": {
+                    "description": "event_marks",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event_marks.Single"
+                    }
+                },
+                "is_editable": {
+                    "description": "是否可編輯專案任務",
+                    "type": "boolean"
+                },
                 "limit": {
                     "description": "筆數(請從1開始帶入,最高上限20)",
                     "type": "integer"
@@ -8834,264 +17567,292 @@ const docTemplate = `{
                     "description": "總頁數",
                     "type": "integer"
                 },
-                "roles": {
+                "project_start_date": {
+                    "description": "專案開始日期",
+                    "type": "string"
+                },
+                "project_status": {
+                    "description": "專案狀態",
+                    "type": "string"
+                },
+                "tasks": {
                     "description": "多筆",
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "properties": {
-                            "created_at": {
-                                "description": "創建時間",
-                                "type": "string"
-                            },
-                            "created_by": {
-                                "description": "創建者",
-                                "type": "string"
-                            },
-                            "deleted_at": {
-                                "description": "刪除時間",
-                                "type": "string"
-                            },
-                            "display_name": {
-                                "description": "角色顯示名稱",
-                                "type": "string"
-                            },
-                            "id": {
-                                "description": "表ID",
-                                "type": "string"
-                            },
-                            "is_enable": {
-                                "description": "角色是否啟用",
-                                "type": "boolean"
-                            },
-                            "name": {
-                                "description": "角色名稱",
-                                "type": "string"
-                            },
-                            "updated_at": {
-                                "description": "更新時間",
-                                "type": "string"
-                            },
-                            "updated_by": {
-                                "description": "更新者",
-                                "type": "string"
-                            }
-                        }
+                        "$ref": "#/definitions/tasks.Single"
                     }
                 },
-                "total": {
-                    "description": "總筆數",
+                "total": {
+                    "description": "總筆數",
+                    "type": "integer"
+                }
+            }
+        },
+        "tasks.Milestone": {
+            "type": "object",
+            "properties": {
+                "baseline_date": {
+                    "description": "基準線日期",
+                    "type": "string"
+                },
+                "date": {
+                    "description": "里程碑日期",
+                    "type": "string"
+                },
+                "deadline": {
+                    "description": "期限",
+                    "type": "string"
+                },
+                "outline_number": {
+                    "description": "1.1.2、1.2、1.2.1",
+                    "type": "string"
+                },
+                "progress": {
+                    "description": "完成百分比",
                     "type": "integer"
+                },
+                "project_name": {
+                    "description": "專案名稱",
+                    "type": "string"
+                },
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "狀態 due:未到期 achieved:已達成 late:逾期",
+                    "type": "string"
+                },
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
+                    "type": "string"
+                },
+                "task_name": {
+                    "description": "任務名稱",
+                    "type": "string"
+                },
+                "task_uuid": {
+                    "description": "任務UUID",
+                    "type": "string"
                 }
             }
         },
-        "roles.Single": {
+        "tasks.MilestoneForecast": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "description": "創建時間",
+                "date": {
+                    "description": "目前排程的日期",
                     "type": "string"
                 },
-                "created_by": {
-                    "description": "創建者",
+                "outline_number": {
+                    "description": "1.1.2、1.2、1.2.1",
                     "type": "string"
                 },
-                "deleted_at": {
-                    "description": "刪除時間",
+                "p50": {
+                    "description": "50%機率可完成的日期",
                     "type": "string"
                 },
-                "display_name": {
-                    "description": "角色顯示名稱",
+                "p80": {
+                    "description": "80%機率可完成的日期",
                     "type": "string"
                 },
-                "id": {
-                    "description": "表ID",
+                "p95": {
+                    "description": "95%機率可完成的日期",
                     "type": "string"
                 },
-                "is_enable": {
-                    "description": "角色是否啟用",
-                    "type": "boolean"
-                },
-                "name": {
-                    "description": "角色名稱",
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
                     "type": "string"
                 },
-                "updated_at": {
-                    "description": "更新時間",
+                "task_name": {
+                    "description": "任務名稱",
                     "type": "string"
                 },
-                "updated_by": {
-                    "description": "更新者",
+                "task_uuid": {
+                    "description": "任務UUID",
                     "type": "string"
                 }
             }
         },
-        "roles.Update": {
+        "tasks.MilestoneList": {
             "type": "object",
             "properties": {
-                "display_name": {
-                    "description": "角色顯示名稱",
-                    "type": "string"
+                "achieved": {
+                    "description": "已達成數量",
+                    "type": "integer"
                 },
-                "is_enable": {
-                    "description": "角色是否啟用",
-                    "type": "boolean"
+                "due": {
+                    "description": "未到期數量",
+                    "type": "integer"
                 },
-                "name": {
-                    "description": "角色名稱",
-                    "type": "string"
+                "late": {
+                    "description": "逾期數量",
+                    "type": "integer"
+                },
+                "milestones": {
+                    "description": "里程碑 (依日期排序)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.Milestone"
+                    }
                 }
             }
         },
-        "s3_files.Create": {
+        "tasks.Move": {
             "type": "object",
             "required": [
-                "base64",
-                "file_name",
-                "source_uuid"
+                "position",
+                "target_uuid"
             ],
             "properties": {
-                "base64": {
-                    "description": "Base64",
-                    "type": "string"
-                },
-                "file_name": {
-                    "description": "檔案名稱",
-                    "type": "string"
+                "position": {
+                    "description": "位置 (before: 目標任務之前、after: 目標任務之後、under: 目標任務的最後一個子任務)",
+                    "type": "string",
+                    "enum": [
+                        "before",
+                        "after",
+                        "under"
+                    ]
                 },
-                "source_uuid": {
-                    "description": "來源UUID",
+                "target_uuid": {
+                    "description": "目標任務UUID",
                     "type": "string"
                 }
             }
         },
-        "s3_files.Single": {
+        "tasks.MovedTask": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "description": "創建時間",
-                    "type": "string"
-                },
-                "created_by": {
-                    "description": "創建者",
+                "end_date": {
+                    "description": "結束日期",
                     "type": "string"
                 },
-                "file_name": {
-                    "description": "檔案名稱",
+                "start_date": {
+                    "description": "起始日期",
                     "type": "string"
                 },
-                "file_url": {
-                    "description": "檔案連結",
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
                     "type": "string"
                 },
-                "id": {
+                "task_uuid": {
                     "description": "表ID",
                     "type": "string"
                 }
             }
         },
-        "tasks.Create": {
+        "tasks.OutlineTask": {
             "type": "object",
-            "required": [
-                "project_uuid",
-                "task_id"
-            ],
             "properties": {
-                "assignments": {
-                    "description": "未知",
-                    "type": "string"
-                },
-                "baseline_duration": {
-                    "description": "基準線工作天",
-                    "type": "number"
+                "is_subtask": {
+                    "description": "是否為子任務",
+                    "type": "boolean"
                 },
-                "baseline_end_date": {
-                    "description": "基準線結束日期",
+                "outline_number": {
+                    "description": "1.1.2、1.2、1.2.1",
                     "type": "string"
                 },
-                "baseline_start_date": {
-                    "description": "基準線起始日期",
+                "predecessor": {
+                    "description": "前任",
                     "type": "string"
                 },
-                "cost": {
-                    "description": "花費時間",
-                    "type": "integer",
-                    "minimum": 0
-                },
-                "duration": {
-                    "description": "期間",
-                    "type": "number",
-                    "minimum": 0
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
+                    "type": "string"
                 },
-                "end_date": {
-                    "description": "結束日期",
+                "task_uuid": {
+                    "description": "表ID",
                     "type": "string"
+                }
+            }
+        },
+        "tasks.ProjectIDs": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "description": "搜尋欄位",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/tasks.Filter"
+                        }
+                    ]
                 },
-                "indicators": {
-                    "description": "任務標示",
+                "projects": {
+                    "description": "多筆",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/tasks.Indicators"
+                        "type": "string"
                     }
+                }
+            }
+        },
+        "tasks.Recurrence": {
+            "type": "object",
+            "required": [
+                "frequency"
+            ],
+            "properties": {
+                "count": {
+                    "description": "次數 (與結束日期擇一必填，最多999次)",
+                    "type": "integer",
+                    "maximum": 999,
+                    "minimum": 1
                 },
-                "is_subtask": {
-                    "description": "是否為任務",
-                    "type": "boolean"
-                },
-                "notes": {
-                    "description": "備註",
-                    "type": "string"
+                "day_of_month": {
+                    "description": "每月的日期 (monthly，預設為起始日期的日期，超過月底時為月底)",
+                    "type": "integer",
+                    "maximum": 31,
+                    "minimum": 1
                 },
-                "outline_number": {
-                    "description": "1.1.2、1.2、1.2.1",
-                    "type": "string"
+                "frequency": {
+                    "description": "頻率 (daily: 每N個工作天、weekly: 每N週、monthly: 每N個月)",
+                    "type": "string",
+                    "enum": [
+                        "daily",
+                        "weekly",
+                        "monthly"
+                    ]
                 },
-                "parent_uuid": {
-                    "description": "父層級UUID",
-                    "type": "string"
+                "interval": {
+                    "description": "間隔 (預設1)",
+                    "type": "integer",
+                    "maximum": 99,
+                    "minimum": 1
                 },
-                "predecessor": {
-                    "description": "前任",
+                "until": {
+                    "description": "結束日期 (包含當天)",
                     "type": "string"
                 },
-                "progress": {
-                    "description": "完成百分比",
+                "week_of_month": {
+                    "description": "每月的第幾週 (monthly，1~4，-1表示最後一週)",
                     "type": "integer",
-                    "minimum": 0
-                },
-                "project_uuid": {
-                    "description": "專案UUID",
-                    "type": "string"
+                    "maximum": 4,
+                    "minimum": -1
                 },
-                "resources": {
-                    "description": "人力資源",
+                "weekdays": {
+                    "description": "星期 (weekly: 每週的星期，預設為起始日期的星期；monthly: 搭配week_of_month的星期，例如monday)",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/resources.TaskSingle"
+                        "type": "string"
                     }
+                }
+            }
+        },
+        "tasks.RecurringTask": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "description": "結束日期",
+                    "type": "string"
                 },
-                "segments": {
-                    "description": "任務分段",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/tasks.Segments"
-                    }
+                "outline_number": {
+                    "description": "1.1.2、1.2、1.2.1",
+                    "type": "string"
                 },
                 "start_date": {
                     "description": "起始日期",
                     "type": "string"
                 },
-                "subtasks": {
-                    "description": "子任務",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/tasks.Create"
-                    }
-                },
-                "task_color": {
-                    "description": "紀錄標的顏色",
-                    "type": "string"
-                },
                 "task_id": {
                     "description": "前端編號 (非表ID)",
                     "type": "string"
@@ -9100,130 +17861,110 @@ const docTemplate = `{
                     "description": "任務名稱",
                     "type": "string"
                 },
-                "web_link": {
-                    "description": "預留：外部連結",
-                    "type": "string"
-                }
-            }
-        },
-        "tasks.Filter": {
-            "type": "object",
-            "properties": {
-                "is_milestone": {
-                    "description": "是否有里程被",
-                    "type": "boolean"
-                }
-            }
-        },
-        "tasks.Import": {
-            "type": "object",
-            "required": [
-                "base64",
-                "file_type",
-                "project_uuid"
-            ],
-            "properties": {
-                "base64": {
-                    "description": "Base64",
-                    "type": "string"
-                },
-                "file_type": {
-                    "description": "檔案類型 1:gantt project 2:saas pmi",
-                    "type": "integer"
-                },
-                "project_uuid": {
-                    "description": "專案UUID",
+                "task_uuid": {
+                    "description": "表ID",
                     "type": "string"
                 }
             }
         },
-        "tasks.Indicators": {
+        "tasks.ResourceWarning": {
             "type": "object",
             "properties": {
-                "date": {
-                    "description": "日期",
+                "resource_name": {
+                    "description": "資源名字",
                     "type": "string"
                 },
-                "iconClass": {
+                "resource_uuid": {
+                    "description": "資源UUID",
                     "type": "string"
                 },
-                "name": {
-                    "description": "名稱",
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
                     "type": "string"
                 },
-                "tooltip": {
-                    "description": "工具提示",
+                "task_uuid": {
+                    "description": "任務UUID",
                     "type": "string"
+                },
+                "unavailable_dates": {
+                    "description": "資源不上班的工作日",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "tasks.List": {
+        "tasks.Result": {
             "type": "object",
-            "required": [
-                "limit",
-                "page"
-            ],
             "properties": {
-                "event_marks": {
-                    "description": "event_marks",
+                "duplicated_tasks": {
+                    "description": "複製產生的任務",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/event_marks.Single"
+                        "$ref": "#/definitions/tasks.DuplicatedTask"
                     }
                 },
-                "is_editable": {
-                    "description": "是否可編輯專案任務",
-                    "type": "boolean"
+                "moved_tasks": {
+                    "description": "連動移動的任務",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.MovedTask"
+                    }
                 },
-                "limit": {
-                    "description": "筆數(請從1開始帶入,最高上限20)",
-                    "type": "integer"
+                "outline_tasks": {
+                    "description": "大綱變更的任務",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.OutlineTask"
+                    }
                 },
-                "page": {
-                    "description": "頁數(請從1開始帶入)",
-                    "type": "integer"
+                "overallocations": {
+                    "description": "資源過度配置的警告",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.Overallocation"
+                    }
                 },
-                "pages": {
-                    "description": "總頁數",
-                    "type": "integer"
+                "recurring_tasks": {
+                    "description": "週期產生的任務",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.RecurringTask"
+                    }
                 },
-                "project_start_date": {
-                    "description": "專案開始日期",
-                    "type": "string"
+                "summary_tasks": {
+                    "description": "重新彙總的摘要任務",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.SummaryTask"
+                    }
                 },
-                "project_status": {
-                    "description": "專案狀態",
+                "task_uuid": {
+                    "description": "表ID",
                     "type": "string"
                 },
-                "tasks": {
-                    "description": "多筆",
+                "warnings": {
+                    "description": "資源不上班的警告",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/tasks.Single"
+                        "$ref": "#/definitions/tasks.ResourceWarning"
                     }
-                },
-                "total": {
-                    "description": "總筆數",
-                    "type": "integer"
                 }
             }
         },
-        "tasks.ProjectIDs": {
+        "tasks.RollUpResult": {
             "type": "object",
             "properties": {
-                "filter": {
-                    "description": "搜尋欄位",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/tasks.Filter"
-                        }
-                    ]
+                "project_uuid": {
+                    "description": "專案UUID",
+                    "type": "string"
                 },
-                "projects": {
-                    "description": "多筆",
+                "summary_tasks": {
+                    "description": "重新彙總的摘要任務",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/tasks.SummaryTask"
                     }
                 }
             }
@@ -9244,6 +17985,10 @@ const docTemplate = `{
         "tasks.Single": {
             "type": "object",
             "properties": {
+                "actual_work": {
+                    "description": "實際工時 (核准的工時表加總)",
+                    "type": "number"
+                },
                 "assignments": {
                     "description": "未知",
                     "type": "string"
@@ -9260,6 +18005,14 @@ const docTemplate = `{
                     "description": "基準線起始日期",
                     "type": "string"
                 },
+                "constraint_date": {
+                    "description": "限制日期",
+                    "type": "string"
+                },
+                "constraint_type": {
+                    "description": "限制類型 (ASAP、ALAP、SNET、FNLT、MSO、MFO)",
+                    "type": "string"
+                },
                 "cost": {
                     "description": "花費時間",
                     "type": "integer"
@@ -9272,6 +18025,10 @@ const docTemplate = `{
                     "description": "創建者",
                     "type": "string"
                 },
+                "deadline": {
+                    "description": "期限",
+                    "type": "string"
+                },
                 "deleted_at": {
                     "description": "刪除時間",
                     "type": "string"
@@ -9280,6 +18037,14 @@ const docTemplate = `{
                     "description": "期間",
                     "type": "number"
                 },
+                "early_finish_date": {
+                    "description": "最早完成日期",
+                    "type": "string"
+                },
+                "early_start_date": {
+                    "description": "最早開始日期",
+                    "type": "string"
+                },
                 "end_date": {
                     "description": "結束日期",
                     "type": "string"
@@ -9291,6 +18056,10 @@ const docTemplate = `{
                         "$ref": "#/definitions/s3_files.Single"
                     }
                 },
+                "free_float": {
+                    "description": "自由浮時 (天)",
+                    "type": "number"
+                },
                 "indicators": {
                     "description": "任務標示",
                     "type": "array",
@@ -9310,26 +18079,74 @@ const docTemplate = `{
                     "description": "任務標示工具提示",
                     "type": "string"
                 },
+                "is_constraint_violated": {
+                    "description": "是否違反限制",
+                    "type": "boolean"
+                },
+                "is_critical": {
+                    "description": "是否為要徑任務",
+                    "type": "boolean"
+                },
+                "is_deadline_missed": {
+                    "description": "是否錯過期限",
+                    "type": "boolean"
+                },
                 "is_editable": {
                     "description": "是否可編輯或刪除任務",
                     "type": "boolean"
                 },
+                "is_milestone": {
+                    "description": "是否為里程碑",
+                    "type": "boolean"
+                },
+                "is_overallocated": {
+                    "description": "是否有資源過度配置",
+                    "type": "boolean"
+                },
                 "is_subtask": {
                     "description": "是否為任務",
                     "type": "boolean"
                 },
+                "late_finish_date": {
+                    "description": "最晚完成日期",
+                    "type": "string"
+                },
+                "late_start_date": {
+                    "description": "最晚開始日期",
+                    "type": "string"
+                },
+                "most_likely_duration": {
+                    "description": "最可能期間",
+                    "type": "number"
+                },
                 "notes": {
                     "description": "備註",
                     "type": "string"
                 },
+                "optimistic_duration": {
+                    "description": "樂觀期間",
+                    "type": "number"
+                },
                 "outline_number": {
                     "description": "1.1.2、1.2、1.2.1",
                     "type": "string"
                 },
+                "pessimistic_duration": {
+                    "description": "悲觀期間",
+                    "type": "number"
+                },
+                "planned_work": {
+                    "description": "計畫工時 (核准工時表前的花費時間)",
+                    "type": "number"
+                },
                 "predecessor": {
                     "description": "前任",
                     "type": "string"
                 },
+                "priority": {
+                    "description": "優先順序",
+                    "type": "integer"
+                },
                 "progress": {
                     "description": "完成百分比",
                     "type": "integer"
@@ -9338,6 +18155,14 @@ const docTemplate = `{
                     "description": "專案UUID",
                     "type": "string"
                 },
+                "recurrence": {
+                    "description": "週期規則",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/tasks.Recurrence"
+                        }
+                    ]
+                },
                 "resources": {
                     "description": "人力資源(陣列)",
                     "type": "array",
@@ -9352,10 +18177,26 @@ const docTemplate = `{
                         "$ref": "#/definitions/tasks.Segments"
                     }
                 },
+                "source_task_uuid": {
+                    "description": "來源任務UUID (情境任務所複製的專案任務)",
+                    "type": "string"
+                },
                 "start_date": {
                     "description": "起始日期",
                     "type": "string"
                 },
+                "status": {
+                    "description": "狀態 (not_started、in_progress、blocked、on_hold、done、cancelled)",
+                    "type": "string"
+                },
+                "status_changed_at": {
+                    "description": "狀態變更時間",
+                    "type": "string"
+                },
+                "status_changed_by": {
+                    "description": "狀態變更者",
+                    "type": "string"
+                },
                 "subtasks": {
                     "description": "子任務",
                     "type": "array",
@@ -9383,6 +18224,10 @@ const docTemplate = `{
                     "description": "表ID",
                     "type": "string"
                 },
+                "total_float": {
+                    "description": "總浮時 (天)",
+                    "type": "number"
+                },
                 "updated_at": {
                     "description": "更新時間",
                     "type": "string"
@@ -9391,8 +18236,159 @@ const docTemplate = `{
                     "description": "更新者",
                     "type": "string"
                 },
-                "web_link": {
-                    "description": "預留：外部連結",
+                "web_link": {
+                    "description": "預留：外部連結",
+                    "type": "string"
+                }
+            }
+        },
+        "tasks.SummaryTask": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "description": "花費",
+                    "type": "integer"
+                },
+                "duration": {
+                    "description": "期間",
+                    "type": "number"
+                },
+                "end_date": {
+                    "description": "結束日期",
+                    "type": "string"
+                },
+                "progress": {
+                    "description": "完成百分比 (依子任務期間加權)",
+                    "type": "integer"
+                },
+                "start_date": {
+                    "description": "起始日期",
+                    "type": "string"
+                },
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
+                    "type": "string"
+                },
+                "task_uuid": {
+                    "description": "表ID",
+                    "type": "string"
+                }
+            }
+        },
+        "tasks.TaskEarnedValue": {
+            "type": "object",
+            "properties": {
+                "ac": {
+                    "description": "實際成本 (AC)",
+                    "type": "number"
+                },
+                "bac": {
+                    "description": "完工預算 (BAC)",
+                    "type": "number"
+                },
+                "cpi": {
+                    "description": "成本績效指標 (CPI = EV / AC，AC為0時為空值)",
+                    "type": "number"
+                },
+                "cv": {
+                    "description": "成本差異 (CV = EV - AC)",
+                    "type": "number"
+                },
+                "eac": {
+                    "description": "完工估算 (EAC)",
+                    "type": "number"
+                },
+                "etc": {
+                    "description": "完工尚需估算 (ETC = EAC - AC)",
+                    "type": "number"
+                },
+                "ev": {
+                    "description": "實獲值 (EV)",
+                    "type": "number"
+                },
+                "is_summary": {
+                    "description": "是否為摘要任務 (數值由子任務彙總)",
+                    "type": "boolean"
+                },
+                "outline_number": {
+                    "description": "1.1.2、1.2、1.2.1",
+                    "type": "string"
+                },
+                "progress": {
+                    "description": "完成百分比",
+                    "type": "integer"
+                },
+                "pv": {
+                    "description": "計畫值 (PV)",
+                    "type": "number"
+                },
+                "spi": {
+                    "description": "時程績效指標 (SPI = EV / PV，PV為0時為空值)",
+                    "type": "number"
+                },
+                "sv": {
+                    "description": "時程差異 (SV = EV - PV)",
+                    "type": "number"
+                },
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
+                    "type": "string"
+                },
+                "task_name": {
+                    "description": "任務名稱",
+                    "type": "string"
+                },
+                "task_uuid": {
+                    "description": "任務UUID",
+                    "type": "string"
+                }
+            }
+        },
+        "tasks.TaskForecast": {
+            "type": "object",
+            "properties": {
+                "criticality_index": {
+                    "description": "要徑指數 (模擬中為要徑任務的比例，0~1)",
+                    "type": "number"
+                },
+                "duration": {
+                    "description": "期間",
+                    "type": "number"
+                },
+                "expected_duration": {
+                    "description": "期望期間 ((樂觀 + 4 * 最可能 + 悲觀) / 6)",
+                    "type": "number"
+                },
+                "most_likely_duration": {
+                    "description": "最可能期間",
+                    "type": "number"
+                },
+                "optimistic_duration": {
+                    "description": "樂觀期間",
+                    "type": "number"
+                },
+                "outline_number": {
+                    "description": "1.1.2、1.2、1.2.1",
+                    "type": "string"
+                },
+                "pessimistic_duration": {
+                    "description": "悲觀期間",
+                    "type": "number"
+                },
+                "standard_deviation": {
+                    "description": "標準差 ((悲觀 - 樂觀) / 6)",
+                    "type": "number"
+                },
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
+                    "type": "string"
+                },
+                "task_name": {
+                    "description": "任務名稱",
+                    "type": "string"
+                },
+                "task_uuid": {
+                    "description": "任務UUID",
                     "type": "string"
                 }
             }
@@ -9416,11 +18412,23 @@ const docTemplate = `{
                     "description": "基準線起始日期",
                     "type": "string"
                 },
+                "constraint_date": {
+                    "description": "限制日期 (SNET、FNLT、MSO、MFO必填)",
+                    "type": "string"
+                },
+                "constraint_type": {
+                    "description": "限制類型 (ASAP、ALAP、SNET、FNLT、MSO、MFO)",
+                    "type": "string"
+                },
                 "cost": {
                     "description": "花費時間",
                     "type": "integer",
                     "minimum": 0
                 },
+                "deadline": {
+                    "description": "期限 (傳入0001-01-01T00:00:00Z可移除期限)",
+                    "type": "string"
+                },
                 "duration": {
                     "description": "期間",
                     "type": "number",
@@ -9437,22 +18445,47 @@ const docTemplate = `{
                         "$ref": "#/definitions/tasks.Indicators"
                     }
                 },
+                "is_milestone": {
+                    "description": "是否為里程碑",
+                    "type": "boolean"
+                },
                 "is_subtask": {
                     "description": "是否為任務",
                     "type": "boolean"
                 },
+                "most_likely_duration": {
+                    "description": "最可能期間",
+                    "type": "number",
+                    "minimum": 0
+                },
                 "notes": {
                     "description": "備註",
                     "type": "string"
                 },
+                "optimistic_duration": {
+                    "description": "樂觀期間 (三點估計皆帶入0可移除估計)",
+                    "type": "number",
+                    "minimum": 0
+                },
                 "outline_number": {
                     "description": "1.1.2、1.2、1.2.1",
                     "type": "string"
                 },
+                "pessimistic_duration": {
+                    "description": "悲觀期間",
+                    "type": "number",
+                    "minimum": 0
+                },
                 "predecessor": {
                     "description": "前任",
                     "type": "string"
                 },
+                "priority": {
+                    "description": "優先順序 (0~1000，1000表示不調配資源)",
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 0
+                },
                 "progress": {
                     "description": "完成百分比",
                     "type": "integer",
@@ -9480,6 +18513,18 @@ const docTemplate = `{
                     "description": "起始日期",
                     "type": "string"
                 },
+                "status": {
+                    "description": "狀態 (not_started、in_progress、blocked、on_hold、done、cancelled)",
+                    "type": "string",
+                    "enum": [
+                        "not_started",
+                        "in_progress",
+                        "blocked",
+                        "on_hold",
+                        "done",
+                        "cancelled"
+                    ]
+                },
                 "subtasks": {
                     "description": "子任務",
                     "type": "array",
@@ -9491,6 +18536,10 @@ const docTemplate = `{
                     "description": "紀錄標的顏色",
                     "type": "string"
                 },
+                "task_id": {
+                    "description": "前端編號 (非表ID)",
+                    "type": "string"
+                },
                 "task_name": {
                     "description": "任務名稱",
                     "type": "string"
@@ -9501,6 +18550,292 @@ const docTemplate = `{
                 }
             }
         },
+        "tasks.UpdateRecurrence": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "description": "每次的期間 (未填則沿用最後一次的期間)",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "effective_date": {
+                    "description": "生效日期 (此日期起尚未開始的週期任務依新規則重新產生，預設為今天)",
+                    "type": "string"
+                },
+                "recurrence": {
+                    "description": "週期規則 (未填則沿用原規則)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/tasks.Recurrence"
+                        }
+                    ]
+                },
+                "resources": {
+                    "description": "人力資源 (未填則沿用最後一次的資源)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.TaskSingle"
+                    }
+                },
+                "task_name": {
+                    "description": "任務名稱 (未填則沿用原名稱)",
+                    "type": "string"
+                }
+            }
+        },
+        "timesheet_entries.Create": {
+            "type": "object",
+            "required": [
+                "date",
+                "hours",
+                "task_uuid"
+            ],
+            "properties": {
+                "date": {
+                    "description": "日期",
+                    "type": "string"
+                },
+                "hours": {
+                    "description": "工時",
+                    "type": "number",
+                    "maximum": 24
+                },
+                "notes": {
+                    "description": "備註",
+                    "type": "string"
+                },
+                "task_uuid": {
+                    "description": "任務UUID",
+                    "type": "string"
+                }
+            }
+        },
+        "timesheet_entries.Update": {
+            "type": "object",
+            "properties": {
+                "hours": {
+                    "description": "工時",
+                    "type": "number",
+                    "maximum": 24
+                },
+                "notes": {
+                    "description": "備註",
+                    "type": "string"
+                }
+            }
+        },
+        "timesheets.Entry": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "日期",
+                    "type": "string"
+                },
+                "hours": {
+                    "description": "工時",
+                    "type": "number"
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "備註",
+                    "type": "string"
+                },
+                "task_name": {
+                    "description": "任務名稱",
+                    "type": "string"
+                },
+                "task_uuid": {
+                    "description": "任務UUID",
+                    "type": "string"
+                }
+            }
+        },
+        "timesheets.List": {
+            "type": "object",
+            "required": [
+                "limit",
+                "page"
+            ],
+            "properties": {
+                "limit": {
+                    "description": "筆數(請從1開始帶入,最高上限20)",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "頁數(請從1開始帶入)",
+                    "type": "integer"
+                },
+                "pages": {
+                    "description": "總頁數",
+                    "type": "integer"
+                },
+                "timesheets": {
+                    "description": "多筆",
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "comment": {
+                                "description": "審核意見",
+                                "type": "string"
+                            },
+                            "created_at": {
+                                "description": "創建時間",
+                                "type": "string"
+                            },
+                            "created_by": {
+                                "description": "創建者",
+                                "type": "string"
+                            },
+                            "deleted_at": {
+                                "description": "刪除時間",
+                                "type": "string"
+                            },
+                            "id": {
+                                "description": "表ID",
+                                "type": "string"
+                            },
+                            "resource_uuid": {
+                                "description": "資源UUID",
+                                "type": "string"
+                            },
+                            "reviewed_at": {
+                                "description": "審核時間",
+                                "type": "string"
+                            },
+                            "reviewed_by": {
+                                "description": "審核者",
+                                "type": "string"
+                            },
+                            "status": {
+                                "description": "狀態 (draft、submitted、approved、rejected)",
+                                "type": "string"
+                            },
+                            "submitted_at": {
+                                "description": "送出時間",
+                                "type": "string"
+                            },
+                            "total_hours": {
+                                "description": "總工時",
+                                "type": "number"
+                            },
+                            "updated_at": {
+                                "description": "更新時間",
+                                "type": "string"
+                            },
+                            "updated_by": {
+                                "description": "更新者",
+                                "type": "string"
+                            },
+                            "user_id": {
+                                "description": "使用者ID",
+                                "type": "string"
+                            },
+                            "user_name": {
+                                "description": "使用者名稱",
+                                "type": "string"
+                            },
+                            "week_start": {
+                                "description": "週起始日 (週一)",
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "total": {
+                    "description": "總筆數",
+                    "type": "integer"
+                }
+            }
+        },
+        "timesheets.Review": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "審核意見 (退回時必填)",
+                    "type": "string"
+                }
+            }
+        },
+        "timesheets.Single": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "審核意見",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "創建時間",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "創建者",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "刪除時間",
+                    "type": "string"
+                },
+                "entries": {
+                    "description": "工時明細",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/timesheets.Entry"
+                    }
+                },
+                "id": {
+                    "description": "表ID",
+                    "type": "string"
+                },
+                "resource_uuid": {
+                    "description": "資源UUID",
+                    "type": "string"
+                },
+                "reviewed_at": {
+                    "description": "審核時間",
+                    "type": "string"
+                },
+                "reviewed_by": {
+                    "description": "審核者",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "狀態 (draft、submitted、approved、rejected)",
+                    "type": "string"
+                },
+                "submitted_at": {
+                    "description": "送出時間",
+                    "type": "string"
+                },
+                "total_hours": {
+                    "description": "總工時",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "description": "更新時間",
+                    "type": "string"
+                },
+                "updated_by": {
+                    "description": "更新者",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "使用者ID",
+                    "type": "string"
+                },
+                "user_name": {
+                    "description": "使用者名稱",
+                    "type": "string"
+                },
+                "week_start": {
+                    "description": "週起始日 (週一)",
+                    "type": "string"
+                }
+            }
+        },
         "users.ChangeEmail": {
             "type": "object",
             "required": [
@@ -4,18 +4,25 @@ import (
 	"gantt/internal/interactor/pkg/connect"
 	"gantt/internal/interactor/pkg/util/log"
 	"gantt/internal/router"
+	"gantt/internal/router/calendar"
 	"gantt/internal/router/department"
 	"gantt/internal/router/event_mark"
 	"gantt/internal/router/holiday"
 	"gantt/internal/router/login"
 	"gantt/internal/router/policy"
 	"gantt/internal/router/project"
+	"gantt/internal/router/project_baseline"
 	"gantt/internal/router/project_resource"
+	"gantt/internal/router/project_scenario"
+	"gantt/internal/router/project_template"
 	"gantt/internal/router/project_type"
 	"gantt/internal/router/resource"
+	"gantt/internal/router/resource_exception"
 	"gantt/internal/router/role"
 	"gantt/internal/router/s3_file"
 	"gantt/internal/router/task"
+	"gantt/internal/router/task_checklist"
+	"gantt/internal/router/timesheet"
 	"gantt/internal/router/user"
 	"gantt/internal/router/work_day"
 	"net/http"
@@ -32,8 +39,15 @@ func main() {
 
 	engine := router.Default()
 	engine = resource.GetRouter(engine, db)
+	engine = resource_exception.GetRouter(engine, db)
 	engine = task.GetRouter(engine, db)
+	engine = task_checklist.GetRouter(engine, db)
+	engine = timesheet.GetRouter(engine, db)
 	engine = project.GetRouter(engine, db)
+	engine = project_baseline.GetRouter(engine, db)
+	engine = project_scenario.GetRouter(engine, db)
+	engine = project_template.GetRouter(engine, db)
+	engine = calendar.GetRouter(engine, db)
 	engine = holiday.GetRouter(engine, db)
 	engine = event_mark.GetRouter(engine, db)
 	engine = work_day.GetRouter(engine, db)
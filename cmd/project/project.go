@@ -6,7 +6,10 @@ import (
 	"gantt/internal/router"
 	"gantt/internal/router/event_mark"
 	"gantt/internal/router/project"
+	"gantt/internal/router/project_baseline"
 	"gantt/internal/router/project_resource"
+	"gantt/internal/router/project_scenario"
+	"gantt/internal/router/project_template"
 	"gantt/internal/router/project_type"
 
 	"github.com/apex/gateway"
@@ -21,6 +24,9 @@ func main() {
 
 	engine := router.Default()
 	engine = project.GetRouter(engine, db)
+	engine = project_baseline.GetRouter(engine, db)
+	engine = project_scenario.GetRouter(engine, db)
+	engine = project_template.GetRouter(engine, db)
 	engine = project_type.GetRouter(engine, db)
 	engine = project_resource.GetRouter(engine, db)
 	engine = event_mark.GetRouter(engine, db)
@@ -5,6 +5,7 @@ import (
 	"gantt/internal/interactor/pkg/util/log"
 	"gantt/internal/router"
 	"gantt/internal/router/resource"
+	"gantt/internal/router/resource_exception"
 
 	"github.com/apex/gateway"
 )
@@ -18,6 +19,7 @@ func main() {
 
 	engine := router.Default()
 	engine = resource.GetRouter(engine, db)
+	engine = resource_exception.GetRouter(engine, db)
 
 	log.Fatal(gateway.ListenAndServe(":8080", engine))
 }
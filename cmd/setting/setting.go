@@ -4,6 +4,7 @@ import (
 	"gantt/internal/interactor/pkg/connect"
 	"gantt/internal/interactor/pkg/util/log"
 	"gantt/internal/router"
+	"gantt/internal/router/calendar"
 	"gantt/internal/router/holiday"
 	"gantt/internal/router/work_day"
 
@@ -18,6 +19,7 @@ func main() {
 	}
 
 	engine := router.Default()
+	engine = calendar.GetRouter(engine, db)
 	engine = holiday.GetRouter(engine, db)
 	engine = work_day.GetRouter(engine, db)
 
@@ -6,6 +6,7 @@ import (
 	"gantt/internal/router"
 	"gantt/internal/router/s3_file"
 	"gantt/internal/router/task"
+	"gantt/internal/router/task_checklist"
 
 	"github.com/apex/gateway"
 )
@@ -19,6 +20,7 @@ func main() {
 
 	engine := router.Default()
 	engine = task.GetRouter(engine, db)
+	engine = task_checklist.GetRouter(engine, db)
 	engine = s3_file.GetRouter(engine, db)
 
 	log.Fatal(gateway.ListenAndServe(":8080", engine))
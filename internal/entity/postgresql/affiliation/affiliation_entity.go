@@ -132,6 +132,10 @@ func (s *storage) GetByListNoPagination(input *model.Base) (output []*model.Tabl
 		query.Where("dept_id = ?", input.DeptID)
 	}
 
+	if input.DeptIDs != nil {
+		query.Where("dept_id in (?)", input.DeptIDs)
+	}
+
 	err = query.Order("created_at desc").Find(&output).Error
 	if err != nil {
 		log.Error(err)
@@ -35,6 +35,8 @@ type Base struct {
 	Users users.Base `json:"users,omitempty"`
 	// 部門ID
 	DeptID *string `json:"dept_id,omitempty"`
+	// 部門IDs (後端查詢用)
+	DeptIDs []*string `json:"dept_ids,omitempty"`
 	// 職稱
 	JobTitle *string `json:"job_title,omitempty"`
 	// 是否為主管
@@ -0,0 +1,55 @@
+package calendars
+
+import (
+	"gantt/internal/entity/postgresql/db/holidays"
+	"gantt/internal/entity/postgresql/db/users"
+	"gantt/internal/interactor/models/special"
+)
+
+// Table struct is calendars database table struct
+type Table struct {
+	// 表ID
+	ID string `gorm:"<-:create;column:id;type:uuid;not null;primaryKey;" json:"id"`
+	// 名稱
+	Name string `gorm:"column:name;type:text;not null;" json:"name"`
+	// 工作日(陣列的字串型態)
+	WorkWeek string `gorm:"column:work_week;type:text;" json:"work_week"`
+	// 工作時間(陣列的字串型態)
+	WorkingTime string `gorm:"column:working_time;type:text;" json:"working_time"`
+	// 是否為預設行事曆
+	IsDefault bool `gorm:"column:is_default;type:boolean;not null;default:false;" json:"is_default"`
+	// holidays data
+	Holidays []holidays.Table `gorm:"foreignKey:CalendarUUID;references:ID" json:"holidays,omitempty"`
+	// create_users data
+	CreatedByUsers users.Table `gorm:"foreignKey:ID;references:CreatedBy" json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Table `gorm:"foreignKey:ID;references:UpdatedBy" json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Table
+}
+
+// Base struct is corresponding to calendars table structure file
+type Base struct {
+	// 表ID
+	ID *string `json:"id,omitempty"`
+	// 名稱
+	Name *string `json:"name,omitempty"`
+	// 工作日(陣列的字串型態)
+	WorkWeek *string `json:"work_week,omitempty"`
+	// 工作時間(陣列的字串型態)
+	WorkingTime *string `json:"working_time,omitempty"`
+	// 是否為預設行事曆
+	IsDefault *bool `json:"is_default,omitempty"`
+	// holidays data
+	Holidays []holidays.Base `json:"holidays,omitempty"`
+	// create_users data
+	CreatedByUsers users.Base `json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Base `json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Base
+}
+
+func (t *Table) TableName() string {
+	return "calendars"
+}
@@ -18,6 +18,8 @@ type Table struct {
 	EndDate *time.Time `gorm:"column:end_date;type:timestamp;" json:"to"`
 	// 前端css
 	Css string `gorm:"column:css;type:text;" json:"cssClass"`
+	// 行事曆UUID
+	CalendarUUID *string `gorm:"column:calendar_uuid;type:uuid;" json:"calendar_uuid"`
 	// create_users data
 	CreatedByUsers users.Table `gorm:"foreignKey:ID;references:CreatedBy" json:"created_by_users,omitempty"`
 	// update_users data
@@ -38,6 +40,8 @@ type Base struct {
 	EndDate *time.Time `json:"to,omitempty"`
 	// 前端css
 	Css *string `json:"cssClass,omitempty"`
+	// 行事曆UUID
+	CalendarUUID *string `json:"calendar_uuid,omitempty"`
 	// create_users data
 	CreatedByUsers users.Base `json:"created_by_users,omitempty"`
 	// update_users data
@@ -0,0 +1,72 @@
+package project_baseline_tasks
+
+import (
+	"gantt/internal/interactor/models/special"
+	"time"
+)
+
+// Table struct is project_baseline_tasks database table struct
+type Table struct {
+	// 表ID
+	ID string `gorm:"<-:create;column:id;type:uuid;not null;primaryKey;" json:"id"`
+	// 基準線UUID
+	BaselineUUID string `gorm:"column:baseline_uuid;type:uuid;not null;" json:"baseline_uuid"`
+	// 任務UUID
+	TaskUUID string `gorm:"column:task_uuid;type:uuid;not null;" json:"task_uuid"`
+	// 前端編號 (非表ID)
+	TaskID string `gorm:"column:task_id;type:text;" json:"task_id"`
+	// 任務名稱
+	TaskName string `gorm:"column:task_name;type:text;" json:"task_name"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `gorm:"column:outline_number;type:text;" json:"outline_number"`
+	// 起始日期
+	StartDate *time.Time `gorm:"column:start_date;type:timestamp;" json:"start_date"`
+	// 結束日期
+	EndDate *time.Time `gorm:"column:end_date;type:timestamp;" json:"end_date"`
+	// 期間
+	Duration float64 `gorm:"column:duration;type:numeric;" json:"duration"`
+	// 花費時間
+	Cost int64 `gorm:"column:cost;type:int;" json:"cost"`
+	// 計畫工時 (核准工時表前的花費時間)
+	PlannedWork *float64 `gorm:"column:planned_work;type:numeric;" json:"planned_work"`
+	// 完成百分比
+	Progress int64 `gorm:"column:progress;type:int;" json:"progress"`
+	// 引入後端專用
+	special.Table
+}
+
+// Base struct is corresponding to project_baseline_tasks table structure file
+type Base struct {
+	// 表ID
+	ID *string `json:"id,omitempty"`
+	// 基準線UUID
+	BaselineUUID *string `json:"baseline_uuid,omitempty"`
+	// 基準線UUIDs (後端批量刪除用）
+	BaselineUUIDs []*string `json:"baseline_uuids,omitempty"`
+	// 任務UUID
+	TaskUUID *string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID *string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName *string `json:"task_name,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber *string `json:"outline_number,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// 期間
+	Duration *float64 `json:"duration,omitempty"`
+	// 花費時間
+	Cost *int64 `json:"cost,omitempty"`
+	// 計畫工時 (核准工時表前的花費時間)
+	PlannedWork *float64 `json:"planned_work,omitempty"`
+	// 完成百分比
+	Progress *int64 `json:"progress,omitempty"`
+	// 引入後端專用
+	special.Base
+}
+
+func (t *Table) TableName() string {
+	return "project_baseline_tasks"
+}
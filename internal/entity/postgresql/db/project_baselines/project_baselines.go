@@ -0,0 +1,51 @@
+package project_baselines
+
+import (
+	"gantt/internal/entity/postgresql/db/project_baseline_tasks"
+	"gantt/internal/entity/postgresql/db/users"
+	"gantt/internal/interactor/models/special"
+)
+
+// Table struct is project_baselines database table struct
+type Table struct {
+	// 表ID
+	ID string `gorm:"<-:create;column:id;type:uuid;not null;primaryKey;" json:"id"`
+	// 專案UUID
+	ProjectUUID string `gorm:"column:project_uuid;type:uuid;not null;" json:"project_uuid"`
+	// 名稱
+	Name string `gorm:"column:name;type:text;" json:"name"`
+	// 是否為選用的基準線
+	IsSelected bool `gorm:"column:is_selected;type:boolean;not null;default:false;" json:"is_selected"`
+	// project_baseline_tasks data
+	Tasks []project_baseline_tasks.Table `gorm:"foreignKey:BaselineUUID;references:ID" json:"tasks,omitempty"`
+	// create_users data
+	CreatedByUsers users.Table `gorm:"foreignKey:ID;references:CreatedBy" json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Table `gorm:"foreignKey:ID;references:UpdatedBy" json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Table
+}
+
+// Base struct is corresponding to project_baselines table structure file
+type Base struct {
+	// 表ID
+	ID *string `json:"id,omitempty"`
+	// 專案UUID
+	ProjectUUID *string `json:"project_uuid,omitempty"`
+	// 名稱
+	Name *string `json:"name,omitempty"`
+	// 是否為選用的基準線
+	IsSelected *bool `json:"is_selected,omitempty"`
+	// project_baseline_tasks data
+	Tasks []project_baseline_tasks.Base `json:"tasks,omitempty"`
+	// create_users data
+	CreatedByUsers users.Base `json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Base `json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Base
+}
+
+func (t *Table) TableName() string {
+	return "project_baselines"
+}
@@ -0,0 +1,52 @@
+package project_scenarios
+
+import (
+	"gantt/internal/entity/postgresql/db/users"
+	"gantt/internal/interactor/models/special"
+)
+
+// Table struct is project_scenarios database table struct
+type Table struct {
+	// 表ID
+	ID string `gorm:"<-:create;column:id;type:uuid;not null;primaryKey;" json:"id"`
+	// 專案UUID
+	ProjectUUID string `gorm:"column:project_uuid;type:uuid;not null;" json:"project_uuid"`
+	// 情境專案UUID (存放情境任務的專案)
+	ScenarioProjectUUID string `gorm:"column:scenario_project_uuid;type:uuid;not null;" json:"scenario_project_uuid"`
+	// 名稱
+	Name string `gorm:"column:name;type:text;" json:"name"`
+	// 描述
+	Description string `gorm:"column:description;type:text;" json:"description"`
+	// create_users data
+	CreatedByUsers users.Table `gorm:"foreignKey:ID;references:CreatedBy" json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Table `gorm:"foreignKey:ID;references:UpdatedBy" json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Table
+}
+
+// Base struct is corresponding to project_scenarios table structure file
+type Base struct {
+	// 表ID
+	ID *string `json:"id,omitempty"`
+	// 專案UUID
+	ProjectUUID *string `json:"project_uuid,omitempty"`
+	// 情境專案UUID (存放情境任務的專案)
+	ScenarioProjectUUID *string `json:"scenario_project_uuid,omitempty"`
+	// 情境專案UUIDs (後端查詢用)
+	ScenarioProjectUUIDs []*string `json:"scenario_project_uuids,omitempty"`
+	// 名稱
+	Name *string `json:"name,omitempty"`
+	// 描述
+	Description *string `json:"description,omitempty"`
+	// create_users data
+	CreatedByUsers users.Base `json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Base `json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Base
+}
+
+func (t *Table) TableName() string {
+	return "project_scenarios"
+}
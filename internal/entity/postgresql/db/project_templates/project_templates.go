@@ -0,0 +1,68 @@
+package project_templates
+
+import (
+	"gantt/internal/entity/postgresql/db/project_types"
+	"gantt/internal/entity/postgresql/db/users"
+	"gantt/internal/interactor/models/special"
+	"time"
+)
+
+// Table struct is project_templates database table struct
+type Table struct {
+	// 表ID
+	ID string `gorm:"<-:create;column:id;type:uuid;not null;primaryKey;" json:"id"`
+	// 名稱
+	Name string `gorm:"column:name;type:text;" json:"name"`
+	// 類別ID
+	TypeID *string `gorm:"column:type_id;type:uuid;" json:"type_id"`
+	// project_types data
+	ProjectTypes project_types.Table `gorm:"foreignKey:ID;references:TypeID" json:"project_types,omitempty"`
+	// 描述
+	Description string `gorm:"column:description;type:text;" json:"description"`
+	// 基準日期 (任務日期依此日期平移)
+	StartDate *time.Time `gorm:"<-:create;column:start_date;type:timestamp;" json:"start_date"`
+	// 任務(陣列的字串型態)
+	Tasks string `gorm:"<-:create;column:tasks;type:text;" json:"tasks"`
+	// 專案資源(陣列的字串型態)
+	Resources string `gorm:"<-:create;column:resources;type:text;" json:"resources"`
+	// 事件標記(陣列的字串型態)
+	EventMarks string `gorm:"<-:create;column:event_marks;type:text;" json:"event_marks"`
+	// create_users data
+	CreatedByUsers users.Table `gorm:"foreignKey:ID;references:CreatedBy" json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Table `gorm:"foreignKey:ID;references:UpdatedBy" json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Table
+}
+
+// Base struct is corresponding to project_templates table structure file
+type Base struct {
+	// 表ID
+	ID *string `json:"id,omitempty"`
+	// 名稱
+	Name *string `json:"name,omitempty"`
+	// 類別ID
+	TypeID *string `json:"type_id,omitempty"`
+	// project_types data
+	ProjectTypes project_types.Base `json:"project_types,omitempty"`
+	// 描述
+	Description *string `json:"description,omitempty"`
+	// 基準日期 (任務日期依此日期平移)
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 任務(陣列的字串型態)
+	Tasks *string `json:"tasks,omitempty"`
+	// 專案資源(陣列的字串型態)
+	Resources *string `json:"resources,omitempty"`
+	// 事件標記(陣列的字串型態)
+	EventMarks *string `json:"event_marks,omitempty"`
+	// create_users data
+	CreatedByUsers users.Base `json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Base `json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Base
+}
+
+func (t *Table) TableName() string {
+	return "project_templates"
+}
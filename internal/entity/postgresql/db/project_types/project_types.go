@@ -10,6 +10,8 @@ type Table struct {
 	ID string `gorm:"<-:create;column:id;type:uuid;not null;primaryKey;" json:"id"`
 	// 名稱
 	Name string `gorm:"column:name;type:text;" json:"name"`
+	// 任務狀態轉換 (JSON，空值表示預設轉換)
+	StatusTransitions string `gorm:"column:status_transitions;type:text;" json:"status_transitions"`
 	// 引入後端專用
 	special.Table
 }
@@ -20,6 +22,8 @@ type Base struct {
 	ID *string `json:"id,omitempty"`
 	// 名稱
 	Name *string `json:"name,omitempty"`
+	// 任務狀態轉換 (JSON，空值表示預設轉換)
+	StatusTransitions *string `json:"status_transitions,omitempty"`
 	// 名稱s (後端查詢用）
 	Names []*string `json:"names,omitempty"`
 	// 引入後端專用
@@ -30,6 +30,12 @@ type Table struct {
 	Client string `gorm:"column:client;type:text;" json:"client"`
 	// 狀態
 	Status string `gorm:"column:status;type:text;" json:"status"`
+	// 行事曆UUID
+	CalendarUUID *string `gorm:"column:calendar_uuid;type:uuid;" json:"calendar_uuid"`
+	// 是否為情境的沙盒專案
+	IsScenario bool `gorm:"column:is_scenario;type:boolean;not null;default:false;" json:"is_scenario"`
+	// 是否依檢查清單的完成比例計算任務進度
+	ProgressFromChecklist bool `gorm:"column:progress_from_checklist;type:boolean;not null;default:false;" json:"progress_from_checklist"`
 	// create_users data
 	CreatedByUsers users.Table `gorm:"foreignKey:ID;references:CreatedBy" json:"created_by_users,omitempty"`
 	// update_users data
@@ -62,6 +68,12 @@ type Base struct {
 	Client *string `json:"client,omitempty"`
 	// 狀態
 	Status *string `json:"status,omitempty"`
+	// 行事曆UUID
+	CalendarUUID *string `json:"calendar_uuid,omitempty"`
+	// 是否為情境的沙盒專案
+	IsScenario *bool `json:"is_scenario,omitempty"`
+	// 是否依檢查清單的完成比例計算任務進度
+	ProgressFromChecklist *bool `json:"progress_from_checklist,omitempty"`
 	// create_users data
 	CreatedByUsers users.Base `json:"created_by_users,omitempty"`
 	// update_users data
@@ -0,0 +1,57 @@
+package resource_exceptions
+
+import (
+	"gantt/internal/entity/postgresql/db/users"
+	"gantt/internal/interactor/models/special"
+	"time"
+)
+
+// Table struct is resource_exceptions database table struct
+type Table struct {
+	// 表ID
+	ID string `gorm:"<-:create;column:id;type:uuid;not null;primaryKey;" json:"id"`
+	// 資源UUID
+	ResourceUUID string `gorm:"column:resource_uuid;type:uuid;not null;" json:"resource_uuid"`
+	// 名稱
+	Name string `gorm:"column:name;type:text;" json:"name"`
+	// 起始日期
+	StartDate *time.Time `gorm:"column:start_date;type:timestamp;" json:"start_date"`
+	// 結束日期
+	EndDate *time.Time `gorm:"column:end_date;type:timestamp;" json:"end_date"`
+	// 工作日(陣列的字串型態，空值表示請假)
+	WorkWeek string `gorm:"column:work_week;type:text;" json:"work_week"`
+	// create_users data
+	CreatedByUsers users.Table `gorm:"foreignKey:ID;references:CreatedBy" json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Table `gorm:"foreignKey:ID;references:UpdatedBy" json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Table
+}
+
+// Base struct is corresponding to resource_exceptions table structure file
+type Base struct {
+	// 表ID
+	ID *string `json:"id,omitempty"`
+	// 資源UUID
+	ResourceUUID *string `json:"resource_uuid,omitempty"`
+	// 資源UUIDs (後端查詢用)
+	ResourceUUIDs []string `json:"resource_uuids,omitempty"`
+	// 名稱
+	Name *string `json:"name,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// 工作日(陣列的字串型態，空值表示請假)
+	WorkWeek *string `json:"work_week,omitempty"`
+	// create_users data
+	CreatedByUsers users.Base `json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Base `json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Base
+}
+
+func (t *Table) TableName() string {
+	return "resource_exceptions"
+}
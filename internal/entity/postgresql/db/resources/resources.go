@@ -25,6 +25,8 @@ type Table struct {
 	TotalCost float64 `gorm:"column:total_cost;type:numeric" json:"total_cost"`
 	// 總負載
 	TotalLoad float64 `gorm:"column:total_load;type:numeric" json:"total_load"`
+	// 最大單位 (百分比)
+	MaxUnits float64 `gorm:"column:max_units;type:numeric;default:100" json:"max_units"`
 	// 群組
 	ResourceGroup string `gorm:"column:resource_group;type:text;" json:"resource_group"`
 	//
@@ -57,6 +59,8 @@ type Base struct {
 	TotalCost *float64 `json:"total_cost,omitempty"`
 	// 總負載
 	TotalLoad *float64 `json:"total_load,omitempty"`
+	// 最大單位 (百分比)
+	MaxUnits *float64 `json:"max_units,omitempty"`
 	// 群組
 	ResourceGroup *string `json:"resource_group,omitempty"`
 	//
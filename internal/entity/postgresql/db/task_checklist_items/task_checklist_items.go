@@ -0,0 +1,66 @@
+package task_checklist_items
+
+import (
+	"gantt/internal/entity/postgresql/db/resources"
+	"gantt/internal/entity/postgresql/db/users"
+	"gantt/internal/interactor/models/special"
+	"time"
+)
+
+// Table struct is task_checklist_items database table struct
+type Table struct {
+	// 表ID
+	ID string `gorm:"<-:create;column:id;type:uuid;not null;primaryKey;" json:"id"`
+	// 任務UUID
+	TaskUUID string `gorm:"column:task_uuid;type:uuid;not null;" json:"task_uuid"`
+	// 標題
+	Title string `gorm:"column:title;type:text;not null;" json:"title"`
+	// 是否完成
+	IsDone bool `gorm:"column:is_done;type:boolean;not null;default:false;" json:"is_done"`
+	// 負責資源UUID
+	ResourceUUID *string `gorm:"column:resource_uuid;type:uuid;" json:"resource_uuid"`
+	// resources data
+	Resources resources.Table `gorm:"foreignKey:ResourceUUID;references:ResourceUUID" json:"resources,omitempty"`
+	// 到期日
+	DueDate *time.Time `gorm:"column:due_date;type:date;" json:"due_date"`
+	// 排序
+	SortOrder int64 `gorm:"column:sort_order;type:integer;not null;" json:"sort_order"`
+	// create_users data
+	CreatedByUsers users.Table `gorm:"foreignKey:ID;references:CreatedBy" json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Table `gorm:"foreignKey:ID;references:UpdatedBy" json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Table
+}
+
+// Base struct is corresponding to task_checklist_items table structure file
+type Base struct {
+	// 表ID
+	ID *string `json:"id,omitempty"`
+	// 任務UUID
+	TaskUUID *string `json:"task_uuid,omitempty"`
+	// 任務UUIDs (後端查詢用)
+	TaskUUIDs []*string `json:"task_uuids,omitempty"`
+	// 標題
+	Title *string `json:"title,omitempty"`
+	// 是否完成
+	IsDone *bool `json:"is_done,omitempty"`
+	// 負責資源UUID
+	ResourceUUID *string `json:"resource_uuid,omitempty"`
+	// resources data
+	Resources resources.Base `json:"resources,omitempty"`
+	// 到期日
+	DueDate *time.Time `json:"due_date,omitempty"`
+	// 排序
+	SortOrder *int64 `json:"sort_order,omitempty"`
+	// create_users data
+	CreatedByUsers users.Base `json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Base `json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Base
+}
+
+func (t *Table) TableName() string {
+	return "task_checklist_items"
+}
@@ -0,0 +1,53 @@
+package task_dependencies
+
+import (
+	"gantt/internal/interactor/models/special"
+)
+
+// Table struct is task_dependencies database table struct
+type Table struct {
+	// 表ID
+	ID string `gorm:"<-:create;column:id;type:uuid;not null;primaryKey;" json:"id"`
+	// 專案UUID
+	ProjectUUID string `gorm:"column:project_uuid;type:uuid;not null;" json:"project_uuid"`
+	// 前置任務UUID
+	PredecessorUUID string `gorm:"column:predecessor_uuid;type:uuid;not null;" json:"predecessor_uuid"`
+	// 後續任務UUID
+	SuccessorUUID string `gorm:"column:successor_uuid;type:uuid;not null;" json:"successor_uuid"`
+	// 相依類型 (FS/SS/FF/SF)
+	Type string `gorm:"column:type;type:text;not null;" json:"type"`
+	// 延遲
+	Lag float64 `gorm:"column:lag;type:numeric;not null;" json:"lag"`
+	// 延遲單位 (d/h/m)
+	LagUnit string `gorm:"column:lag_unit;type:text;not null;" json:"lag_unit"`
+	// 引入後端專用
+	special.Table
+}
+
+// Base struct is corresponding to task_dependencies table structure file
+type Base struct {
+	// 表ID
+	ID *string `json:"id,omitempty"`
+	// 專案UUID
+	ProjectUUID *string `json:"project_uuid,omitempty"`
+	// 前置任務UUID
+	PredecessorUUID *string `json:"predecessor_uuid,omitempty"`
+	// 後續任務UUID
+	SuccessorUUID *string `json:"successor_uuid,omitempty"`
+	// 相依類型 (FS/SS/FF/SF)
+	Type *string `json:"type,omitempty"`
+	// 延遲
+	Lag *float64 `json:"lag,omitempty"`
+	// 延遲單位 (d/h/m)
+	LagUnit *string `json:"lag_unit,omitempty"`
+	// 任務UUIDs (後端批量刪除用）
+	TaskUUIDs []*string `json:"task_uuids,omitempty"`
+	// 表IDs (後端批量刪除用）
+	IDs []*string `json:"ids,omitempty"`
+	// 引入後端專用
+	special.Base
+}
+
+func (t *Table) TableName() string {
+	return "task_dependencies"
+}
@@ -32,10 +32,28 @@ type Table struct {
 	BaselineDuration float64 `gorm:"column:baseline_duration;type:numeric;" json:"baseline_duration"`
 	// 期間
 	Duration float64 `gorm:"column:duration;type:numeric;" json:"duration"`
+	// 樂觀期間
+	OptimisticDuration *float64 `gorm:"column:optimistic_duration;type:numeric;" json:"optimistic_duration"`
+	// 最可能期間
+	MostLikelyDuration *float64 `gorm:"column:most_likely_duration;type:numeric;" json:"most_likely_duration"`
+	// 悲觀期間
+	PessimisticDuration *float64 `gorm:"column:pessimistic_duration;type:numeric;" json:"pessimistic_duration"`
 	// 完成百分比
 	Progress int64 `gorm:"column:progress;type:int;" json:"progress"`
+	// 狀態 (not_started、in_progress、blocked、on_hold、done、cancelled)
+	Status string `gorm:"column:status;type:text;not null;default:not_started" json:"status"`
+	// 狀態變更者
+	StatusChangedBy *string `gorm:"column:status_changed_by;type:uuid;" json:"status_changed_by"`
+	// status_changed_users data
+	StatusChangedByUsers users.Table `gorm:"foreignKey:ID;references:StatusChangedBy" json:"status_changed_by_users,omitempty"`
+	// 狀態變更時間
+	StatusChangedAt *time.Time `gorm:"column:status_changed_at;type:timestamp;" json:"status_changed_at"`
 	// 花費時間
 	Cost int64 `gorm:"column:cost;type:int;" json:"cost"`
+	// 實際工時 (核准的工時表加總)
+	ActualWork float64 `gorm:"column:actual_work;type:numeric;default:0" json:"actual_work"`
+	// 計畫工時 (核准工時表前的花費時間)
+	PlannedWork *float64 `gorm:"column:planned_work;type:numeric;" json:"planned_work"`
 	// 前任
 	Predecessor string `gorm:"column:predecessor;type:text;" json:"predecessor"`
 	// 1.1.2、1.2、1.2.1
@@ -48,6 +66,8 @@ type Table struct {
 	WebLink string `gorm:"column:web_link;type:text;" json:"web_link"`
 	// 是否為任務
 	IsSubTask bool `gorm:"column:is_subtask;type:boolean;default:false" json:"is_subtask"`
+	// 是否為里程碑
+	IsMilestone bool `gorm:"column:is_milestone;type:boolean;default:false" json:"is_milestone"`
 	// 專案UUID
 	ProjectUUID *string `gorm:"column:project_uuid;type:uuid;" json:"project_uuid"`
 	// projects data
@@ -58,6 +78,18 @@ type Table struct {
 	Indicator string `gorm:"column:indicator;type:text;" json:"indicator"`
 	// 備註
 	Notes string `gorm:"column:notes;type:text;" json:"notes"`
+	// 限制類型 (ASAP、ALAP、SNET、FNLT、MSO、MFO)
+	ConstraintType string `gorm:"column:constraint_type;type:text;default:ASAP" json:"constraint_type"`
+	// 限制日期
+	ConstraintDate *time.Time `gorm:"column:constraint_date;type:timestamp;" json:"constraint_date"`
+	// 期限
+	Deadline *time.Time `gorm:"column:deadline;type:timestamp;" json:"deadline"`
+	// 優先順序 (0~1000)
+	Priority *int `gorm:"column:priority;type:integer;default:500" json:"priority"`
+	// 週期規則(物件的字串型態)
+	RecurrenceRule string `gorm:"column:recurrence_rule;type:text;" json:"recurrence_rule"`
+	// 來源任務UUID (情境任務所複製的專案任務)
+	SourceTaskUUID *string `gorm:"column:source_task_uuid;type:uuid;" json:"source_task_uuid"`
 	// task_resources data
 	TaskResources []task_resources.Table `gorm:"foreignKey:TaskUUID;" json:"resources,omitempty"`
 	// s3_files data
@@ -92,10 +124,28 @@ type Base struct {
 	BaselineDuration *float64 `json:"baseline_duration,omitempty"`
 	// 期間
 	Duration *float64 `json:"duration,omitempty"`
+	// 樂觀期間
+	OptimisticDuration *float64 `json:"optimistic_duration,omitempty"`
+	// 最可能期間
+	MostLikelyDuration *float64 `json:"most_likely_duration,omitempty"`
+	// 悲觀期間
+	PessimisticDuration *float64 `json:"pessimistic_duration,omitempty"`
 	// 完成百分比
 	Progress *int64 `json:"progress,omitempty"`
+	// 狀態 (not_started、in_progress、blocked、on_hold、done、cancelled)
+	Status *string `json:"status,omitempty"`
+	// 狀態變更者
+	StatusChangedBy *string `json:"status_changed_by,omitempty"`
+	// status_changed_users data
+	StatusChangedByUsers users.Base `json:"status_changed_by_users,omitempty"`
+	// 狀態變更時間
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty"`
 	// 花費時間
 	Cost *int64 `json:"cost,omitempty"`
+	// 實際工時 (核准的工時表加總)
+	ActualWork *float64 `json:"actual_work,omitempty"`
+	// 計畫工時 (核准工時表前的花費時間)
+	PlannedWork *float64 `json:"planned_work,omitempty"`
 	// 前任
 	Predecessor *string `json:"predecessor,omitempty"`
 	// 1.1.2、1.2、1.2.1
@@ -108,16 +158,34 @@ type Base struct {
 	WebLink *string `json:"web_link,omitempty"`
 	// 是否為任務
 	IsSubTask *bool `json:"is_subtask,omitempty"`
+	// 是否為里程碑
+	IsMilestone *bool `json:"is_milestone,omitempty"`
 	// 專案UUID
 	ProjectUUID *string `json:"project_uuid,omitempty"`
 	// projects data
 	Projects projects.Table `json:"projects,omitempty"`
 	// 任務分段(陣列的字串型態)
 	Segment *string `json:"segment,omitempty"`
+	// 是否移除任務分段 (後端更新用)
+	ClearSegment *bool `json:"clear_segment,omitempty"`
 	// 任務標示(陣列的字串型態)
 	Indicator *string `json:"indicator,omitempty"`
+	// 是否移除任務標示 (後端更新用)
+	ClearIndicator *bool `json:"clear_indicator,omitempty"`
 	// 備註
 	Notes *string `json:"notes,omitempty"`
+	// 限制類型 (ASAP、ALAP、SNET、FNLT、MSO、MFO)
+	ConstraintType *string `json:"constraint_type,omitempty"`
+	// 限制日期
+	ConstraintDate *time.Time `json:"constraint_date,omitempty"`
+	// 期限
+	Deadline *time.Time `json:"deadline,omitempty"`
+	// 優先順序 (0~1000)
+	Priority *int `json:"priority,omitempty"`
+	// 週期規則(物件的字串型態)
+	RecurrenceRule *string `json:"recurrence_rule,omitempty"`
+	// 來源任務UUID (情境任務所複製的專案任務)
+	SourceTaskUUID *string `json:"source_task_uuid,omitempty"`
 	// task_resources data
 	TaskResources []task_resources.Base `json:"resources,omitempty"`
 	// s3_files data
@@ -132,6 +200,8 @@ type Base struct {
 	model.Filter `json:"filter"`
 	// 後端刪除任務及更新專案start_date及end_date用
 	DeletedTaskUUIDs []*string `json:"task_uuids,omitempty"`
+	// 任務UUIDs (後端查詢用)
+	TaskUUIDs []*string `json:"task_uuid_list,omitempty"`
 }
 
 func (t *Table) TableName() string {
@@ -0,0 +1,72 @@
+package timesheet_entries
+
+import (
+	"gantt/internal/entity/postgresql/db/tasks"
+	"gantt/internal/entity/postgresql/db/users"
+	"gantt/internal/interactor/models/special"
+	"time"
+)
+
+// Table struct is timesheet_entries database table struct
+type Table struct {
+	// 表ID
+	ID string `gorm:"<-:create;column:id;type:uuid;not null;primaryKey;" json:"id"`
+	// 工時表ID
+	TimesheetID string `gorm:"column:timesheet_id;type:uuid;not null;" json:"timesheet_id"`
+	// 任務UUID
+	TaskUUID string `gorm:"column:task_uuid;type:uuid;not null;" json:"task_uuid"`
+	// tasks data
+	Tasks tasks.Table `gorm:"foreignKey:TaskUUID;references:TaskUUID" json:"tasks,omitempty"`
+	// 資源UUID
+	ResourceUUID string `gorm:"column:resource_uuid;type:uuid;not null;" json:"resource_uuid"`
+	// 日期
+	Date *time.Time `gorm:"column:date;type:date;not null;" json:"date"`
+	// 工時
+	Hours float64 `gorm:"column:hours;type:numeric;not null;" json:"hours"`
+	// 備註
+	Notes string `gorm:"column:notes;type:text;" json:"notes"`
+	// create_users data
+	CreatedByUsers users.Table `gorm:"foreignKey:ID;references:CreatedBy" json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Table `gorm:"foreignKey:ID;references:UpdatedBy" json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Table
+}
+
+// Base struct is corresponding to timesheet_entries table structure file
+type Base struct {
+	// 表ID
+	ID *string `json:"id,omitempty"`
+	// 工時表ID
+	TimesheetID *string `json:"timesheet_id,omitempty"`
+	// 工時表IDs (後端查詢用)
+	TimesheetIDs []*string `json:"timesheet_ids,omitempty"`
+	// 工時表狀態 (後端查詢用)
+	TimesheetStatus *string `json:"timesheet_status,omitempty"`
+	// 使用者ID (後端查詢用)
+	UserID *string `json:"user_id,omitempty"`
+	// 任務UUID
+	TaskUUID *string `json:"task_uuid,omitempty"`
+	// 任務UUIDs (後端查詢用)
+	TaskUUIDs []*string `json:"task_uuids,omitempty"`
+	// tasks data
+	Tasks tasks.Base `json:"tasks,omitempty"`
+	// 資源UUID
+	ResourceUUID *string `json:"resource_uuid,omitempty"`
+	// 日期
+	Date *time.Time `json:"date,omitempty"`
+	// 工時
+	Hours *float64 `json:"hours,omitempty"`
+	// 備註
+	Notes *string `json:"notes,omitempty"`
+	// create_users data
+	CreatedByUsers users.Base `json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Base `json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Base
+}
+
+func (t *Table) TableName() string {
+	return "timesheet_entries"
+}
@@ -0,0 +1,77 @@
+package timesheets
+
+import (
+	"gantt/internal/entity/postgresql/db/users"
+	"gantt/internal/interactor/models/special"
+	"time"
+)
+
+// Table struct is timesheets database table struct
+type Table struct {
+	// 表ID
+	ID string `gorm:"<-:create;column:id;type:uuid;not null;primaryKey;" json:"id"`
+	// 使用者ID
+	UserID string `gorm:"column:user_id;type:uuid;not null;" json:"user_id"`
+	// users data
+	Users users.Table `gorm:"foreignKey:ID;references:UserID" json:"users,omitempty"`
+	// 資源UUID
+	ResourceUUID string `gorm:"column:resource_uuid;type:uuid;not null;" json:"resource_uuid"`
+	// 週起始日 (週一)
+	WeekStart *time.Time `gorm:"column:week_start;type:date;not null;" json:"week_start"`
+	// 狀態 (draft、submitted、approved、rejected)
+	Status string `gorm:"column:status;type:text;not null;default:draft" json:"status"`
+	// 送出時間
+	SubmittedAt *time.Time `gorm:"column:submitted_at;type:timestamp;" json:"submitted_at"`
+	// 審核者
+	ReviewedBy *string `gorm:"column:reviewed_by;type:uuid;" json:"reviewed_by"`
+	// reviewed_users data
+	ReviewedByUsers users.Table `gorm:"foreignKey:ID;references:ReviewedBy" json:"reviewed_by_users,omitempty"`
+	// 審核時間
+	ReviewedAt *time.Time `gorm:"column:reviewed_at;type:timestamp;" json:"reviewed_at"`
+	// 審核意見
+	Comment string `gorm:"column:comment;type:text;" json:"comment"`
+	// create_users data
+	CreatedByUsers users.Table `gorm:"foreignKey:ID;references:CreatedBy" json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Table `gorm:"foreignKey:ID;references:UpdatedBy" json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Table
+}
+
+// Base struct is corresponding to timesheets table structure file
+type Base struct {
+	// 表ID
+	ID *string `json:"id,omitempty"`
+	// 使用者ID
+	UserID *string `json:"user_id,omitempty"`
+	// 使用者IDs (後端查詢用)
+	UserIDs []*string `json:"user_ids,omitempty"`
+	// users data
+	Users users.Base `json:"users,omitempty"`
+	// 資源UUID
+	ResourceUUID *string `json:"resource_uuid,omitempty"`
+	// 週起始日 (週一)
+	WeekStart *time.Time `json:"week_start,omitempty"`
+	// 狀態 (draft、submitted、approved、rejected)
+	Status *string `json:"status,omitempty"`
+	// 送出時間
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	// 審核者
+	ReviewedBy *string `json:"reviewed_by,omitempty"`
+	// reviewed_users data
+	ReviewedByUsers users.Base `json:"reviewed_by_users,omitempty"`
+	// 審核時間
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// 審核意見
+	Comment *string `json:"comment,omitempty"`
+	// create_users data
+	CreatedByUsers users.Base `json:"created_by_users,omitempty"`
+	// update_users data
+	UpdatedByUsers users.Base `json:"updated_by_users,omitempty"`
+	// 引入後端專用
+	special.Base
+}
+
+func (t *Table) TableName() string {
+	return "timesheets"
+}
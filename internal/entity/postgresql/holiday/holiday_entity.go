@@ -67,6 +67,14 @@ func (s *storage) GetByList(input *model.Base) (quantity int64, output []*model.
 		query.Where("id = ?", input.ID)
 	}
 
+	if input.CalendarUUID != nil {
+		if *input.CalendarUUID == "" {
+			query.Where("calendar_uuid is null")
+		} else {
+			query.Where("calendar_uuid = ?", input.CalendarUUID)
+		}
+	}
+
 	err = query.Count(&quantity).Offset(int((input.Page - 1) * input.Limit)).
 		Limit(int(input.Limit)).Order("created_at desc").Find(&output).Error
 	if err != nil {
@@ -84,6 +92,14 @@ func (s *storage) GetByListNoPagination(input *model.Base) (output []*model.Tabl
 		query.Where("id = ?", input.ID)
 	}
 
+	if input.CalendarUUID != nil {
+		if *input.CalendarUUID == "" {
+			query.Where("calendar_uuid is null")
+		} else {
+			query.Where("calendar_uuid = ?", input.CalendarUUID)
+		}
+	}
+
 	err = query.Order("created_at desc").Find(&output).Error
 	if err != nil {
 		log.Error(err)
@@ -143,6 +159,14 @@ func (s *storage) Update(input *model.Base) (err error) {
 		data["css"] = input.Css
 	}
 
+	if input.CalendarUUID != nil {
+		if *input.CalendarUUID == "" {
+			data["calendar_uuid"] = nil
+		} else {
+			data["calendar_uuid"] = input.CalendarUUID
+		}
+	}
+
 	if input.UpdatedBy != nil {
 		data["updated_by"] = input.UpdatedBy
 	}
@@ -166,6 +190,14 @@ func (s *storage) Delete(input *model.Base) (err error) {
 		query.Where("id = ?", input.ID)
 	}
 
+	if input.CalendarUUID != nil {
+		if *input.CalendarUUID == "" {
+			query.Where("calendar_uuid is null")
+		} else {
+			query.Where("calendar_uuid = ?", input.CalendarUUID)
+		}
+	}
+
 	err = query.Delete(&model.Table{}).Error
 	if err != nil {
 		log.Error(err)
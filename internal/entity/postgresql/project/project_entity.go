@@ -63,6 +63,9 @@ func (s *storage) Create(input *model.Base) (err error) {
 func (s *storage) GetByList(input *model.Base) (quantity int64, output []*model.Table, err error) {
 	query := s.db.Model(&model.Table{}).Count(&quantity).Preload(clause.Associations)
 
+	// the sandbox projects of the scenarios are not listed
+	query.Where("is_scenario = ?", false)
+
 	if input.ProjectUUID != nil {
 		query.Where("project_uuid = ?", input.ProjectUUID)
 	}
@@ -150,12 +153,16 @@ func (s *storage) GetByListNoPagination(input *model.Base) (output []*model.Tabl
 		query.Where("project_uuid = ?", input.ProjectUUID)
 	}
 
-	if input.ProjectUUIDs != nil {
-		query.Where("project_uuid in (?)", input.ProjectUUIDs)
+	if input.IsScenario != nil {
+		query.Where("is_scenario = ?", input.IsScenario)
 	}
 
-	if input.CreatedBy != nil {
-		query.Or("created_by = ?", input.CreatedBy)
+	if input.ProjectUUIDs != nil && input.CreatedBy != nil {
+		query.Where("(project_uuid in (?) or created_by = ?)", input.ProjectUUIDs, input.CreatedBy)
+	} else if input.ProjectUUIDs != nil {
+		query.Where("project_uuid in (?)", input.ProjectUUIDs)
+	} else if input.CreatedBy != nil {
+		query.Where("created_by = ?", input.CreatedBy)
 	}
 
 	err = query.Order("created_at desc").Find(&output).Error
@@ -196,6 +203,10 @@ func (s *storage) GetByQuantity(input *model.Base) (quantity int64, err error) {
 		query.Where("created_by = ?", input.CreatedBy)
 	}
 
+	if input.CalendarUUID != nil {
+		query.Where("calendar_uuid = ?", input.CalendarUUID)
+	}
+
 	err = query.Count(&quantity).Select("*").Error
 	if err != nil {
 		log.Error(err)
@@ -241,6 +252,18 @@ func (s *storage) Update(input *model.Base) (err error) {
 		data["status"] = input.Status
 	}
 
+	if input.CalendarUUID != nil {
+		if *input.CalendarUUID == "" {
+			data["calendar_uuid"] = nil
+		} else {
+			data["calendar_uuid"] = input.CalendarUUID
+		}
+	}
+
+	if input.ProgressFromChecklist != nil {
+		data["progress_from_checklist"] = input.ProgressFromChecklist
+	}
+
 	if input.UpdatedBy != nil {
 		data["updated_by"] = input.UpdatedBy
 	}
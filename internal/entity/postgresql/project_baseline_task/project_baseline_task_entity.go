@@ -0,0 +1,103 @@
+package project_baseline_task
+
+import (
+	"github.com/bytedance/sonic"
+
+	model "gantt/internal/entity/postgresql/db/project_baseline_tasks"
+	"gantt/internal/interactor/pkg/util/log"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Entity interface {
+	WithTrx(trx *gorm.DB) Entity
+	CreateAll(input []*model.Base) (err error)
+	GetByListNoPagination(input *model.Base) (output []*model.Table, err error)
+	Delete(input *model.Base) (err error)
+}
+
+type storage struct {
+	db *gorm.DB
+}
+
+func Init(db *gorm.DB) Entity {
+	return &storage{
+		db: db,
+	}
+}
+
+func (s *storage) WithTrx(trx *gorm.DB) Entity {
+	return &storage{
+		db: trx,
+	}
+}
+
+func (s *storage) CreateAll(input []*model.Base) (err error) {
+	marshal, err := sonic.Marshal(input)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	data := &[]model.Table{}
+	err = sonic.Unmarshal(marshal, data)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	err = s.db.Model(&model.Table{}).Omit(clause.Associations).Create(&data).Error
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *storage) GetByListNoPagination(input *model.Base) (output []*model.Table, err error) {
+	query := s.db.Model(&model.Table{})
+	if input.ID != nil {
+		query.Where("id = ?", input.ID)
+	}
+
+	if input.BaselineUUID != nil {
+		query.Where("baseline_uuid = ?", input.BaselineUUID)
+	}
+
+	if input.TaskUUID != nil {
+		query.Where("task_uuid = ?", input.TaskUUID)
+	}
+
+	err = query.Order(`(select array_agg(nullif(part, ''):: int) from unnest(string_to_array(outline_number, '.')) as part) asc`).Find(&output).Error
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *storage) Delete(input *model.Base) (err error) {
+	query := s.db.Model(&model.Table{}).Omit(clause.Associations)
+	if input.ID != nil {
+		query.Where("id = ?", input.ID)
+	}
+
+	if input.BaselineUUID != nil {
+		query.Where("baseline_uuid = ?", input.BaselineUUID)
+	}
+
+	if input.BaselineUUIDs != nil {
+		query.Where("baseline_uuid in (?)", input.BaselineUUIDs)
+	}
+
+	err = query.Delete(&model.Table{}).Error
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -143,6 +143,10 @@ func (s *storage) Update(input *model.Base) (err error) {
 		data["name"] = input.Name
 	}
 
+	if input.StatusTransitions != nil {
+		data["status_transitions"] = input.StatusTransitions
+	}
+
 	if input.UpdatedBy != nil {
 		data["updated_by"] = input.UpdatedBy
 	}
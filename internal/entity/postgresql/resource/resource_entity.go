@@ -126,6 +126,10 @@ func (s *storage) GetByListNoPagination(input *model.Base) (output []*model.Tabl
 		query.Where("resource_name like ?", "%"+*input.ResourceName+"%")
 	}
 
+	if input.FilterResourceGroups != nil {
+		query.Where("exists (select 1 from unnest(?::text[]) as filter where resource_group like '%' || filter || '%')", pq.Array(input.FilterResourceGroups))
+	}
+
 	err = query.Order("resource_id asc").Find(&output).Error
 	if err != nil {
 		log.Error(err)
@@ -217,6 +221,10 @@ func (s *storage) Update(input *model.Base) (err error) {
 		data["total_load"] = input.TotalLoad
 	}
 
+	if input.MaxUnits != nil {
+		data["max_units"] = input.MaxUnits
+	}
+
 	if input.ResourceGroup != nil {
 		data["resource_group"] = input.ResourceGroup
 	}
@@ -122,11 +122,15 @@ func (s *storage) GetByListNoPagination(input *model.Base) (output []*model.Tabl
 		query.Where("project_uuid = ?", input.ProjectUUID)
 	}
 
+	if input.TaskUUIDs != nil {
+		query.Where("task_uuid in (?)", input.TaskUUIDs)
+	}
+
 	// filter
 	//isFiltered := false
 	filter := s.db.Model(&model.Table{})
 	if input.FilterMilestone {
-		filter.Where("duration = 0 and date(start_date) = date(end_date)")
+		filter.Where("is_milestone = ?", true)
 		//isFiltered = true
 	}
 
@@ -151,6 +155,10 @@ func (s *storage) GetByListNoQuantity(input *model.Base) (output []*model.Table,
 		query.Where("project_uuid = ?", input.ProjectUUID)
 	}
 
+	if input.TaskUUIDs != nil {
+		query.Where("task_uuid in (?)", input.TaskUUIDs)
+	}
+
 	err = query.Order("created_at desc").Find(&output).Error
 	if err != nil {
 		log.Error(err)
@@ -272,6 +280,10 @@ func (s *storage) Update(input *model.Base) (err error) {
 		data["task_name"] = input.TaskName
 	}
 
+	if input.TaskID != nil {
+		data["task_id"] = input.TaskID
+	}
+
 	if input.StartDate != nil {
 		data["start_date"] = input.StartDate
 	}
@@ -296,23 +308,56 @@ func (s *storage) Update(input *model.Base) (err error) {
 		data["duration"] = input.Duration
 	}
 
+	if input.OptimisticDuration != nil {
+		data["optimistic_duration"] = input.OptimisticDuration
+	}
+
+	if input.MostLikelyDuration != nil {
+		data["most_likely_duration"] = input.MostLikelyDuration
+	}
+
+	if input.PessimisticDuration != nil {
+		data["pessimistic_duration"] = input.PessimisticDuration
+	}
+
 	if input.Progress != nil {
 		data["progress"] = input.Progress
 	}
 
+	if input.Status != nil {
+		data["status"] = input.Status
+	}
+
+	if input.StatusChangedBy != nil {
+		data["status_changed_by"] = input.StatusChangedBy
+	}
+
+	if input.StatusChangedAt != nil {
+		data["status_changed_at"] = input.StatusChangedAt
+	}
+
 	if input.Cost != nil {
 		data["cost"] = input.Cost
 	}
 
+	if input.ActualWork != nil {
+		data["actual_work"] = input.ActualWork
+	}
+
+	if input.PlannedWork != nil {
+		data["planned_work"] = input.PlannedWork
+	}
+
+	// the segments and indicators are only removed when asked to
 	if input.Segment != nil {
 		data["segment"] = input.Segment
-	} else {
+	} else if input.ClearSegment != nil && *input.ClearSegment {
 		data["segment"] = nil
 	}
 
 	if input.Indicator != nil {
 		data["indicator"] = input.Indicator
-	} else {
+	} else if input.ClearIndicator != nil && *input.ClearIndicator {
 		data["indicator"] = nil
 	}
 
@@ -340,6 +385,10 @@ func (s *storage) Update(input *model.Base) (err error) {
 		data["is_subtask"] = input.IsSubTask
 	}
 
+	if input.IsMilestone != nil {
+		data["is_milestone"] = input.IsMilestone
+	}
+
 	if input.ProjectUUID != nil {
 		data["project_uuid"] = input.ProjectUUID
 	}
@@ -348,6 +397,35 @@ func (s *storage) Update(input *model.Base) (err error) {
 		data["notes"] = input.Notes
 	}
 
+	if input.ConstraintType != nil {
+		data["constraint_type"] = input.ConstraintType
+	}
+
+	// a zero date removes the constraint date or the deadline
+	if input.ConstraintDate != nil {
+		if input.ConstraintDate.IsZero() {
+			data["constraint_date"] = nil
+		} else {
+			data["constraint_date"] = input.ConstraintDate
+		}
+	}
+
+	if input.Deadline != nil {
+		if input.Deadline.IsZero() {
+			data["deadline"] = nil
+		} else {
+			data["deadline"] = input.Deadline
+		}
+	}
+
+	if input.Priority != nil {
+		data["priority"] = input.Priority
+	}
+
+	if input.RecurrenceRule != nil {
+		data["recurrence_rule"] = input.RecurrenceRule
+	}
+
 	if input.UpdatedBy != nil {
 		data["updated_by"] = input.UpdatedBy
 	}
@@ -0,0 +1,207 @@
+package task_checklist_item
+
+import (
+	"github.com/bytedance/sonic"
+
+	model "gantt/internal/entity/postgresql/db/task_checklist_items"
+	"gantt/internal/interactor/pkg/util/log"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Entity interface {
+	WithTrx(trx *gorm.DB) Entity
+	Create(input *model.Base) (err error)
+	GetByListNoPagination(input *model.Base) (output []*model.Table, err error)
+	GetBySingle(input *model.Base) (output *model.Table, err error)
+	GetByQuantity(input *model.Base) (quantity int64, err error)
+	Delete(input *model.Base) (err error)
+	Update(input *model.Base) (err error)
+}
+
+type storage struct {
+	db *gorm.DB
+}
+
+func Init(db *gorm.DB) Entity {
+	return &storage{
+		db: db,
+	}
+}
+
+func (s *storage) WithTrx(trx *gorm.DB) Entity {
+	return &storage{
+		db: trx,
+	}
+}
+
+func (s *storage) Create(input *model.Base) (err error) {
+	marshal, err := sonic.Marshal(input)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	data := &model.Table{}
+	err = sonic.Unmarshal(marshal, data)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	err = s.db.Model(&model.Table{}).Omit(clause.Associations).Create(&data).Error
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *storage) GetByListNoPagination(input *model.Base) (output []*model.Table, err error) {
+	query := s.db.Model(&model.Table{}).Preload(clause.Associations)
+
+	if input.ID != nil {
+		query.Where("id = ?", input.ID)
+	}
+
+	if input.TaskUUID != nil {
+		query.Where("task_uuid = ?", input.TaskUUID)
+	}
+
+	if input.TaskUUIDs != nil {
+		query.Where("task_uuid in (?)", input.TaskUUIDs)
+	}
+
+	if input.ResourceUUID != nil {
+		query.Where("resource_uuid = ?", input.ResourceUUID)
+	}
+
+	if input.IsDone != nil {
+		query.Where("is_done = ?", input.IsDone)
+	}
+
+	err = query.Order("sort_order asc").Order("created_at asc").Find(&output).Error
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *storage) GetBySingle(input *model.Base) (output *model.Table, err error) {
+	query := s.db.Model(&model.Table{}).Preload(clause.Associations)
+	if input.ID != nil {
+		query.Where("id = ?", input.ID)
+	}
+
+	if input.TaskUUID != nil {
+		query.Where("task_uuid = ?", input.TaskUUID)
+	}
+
+	err = query.First(&output).Error
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *storage) GetByQuantity(input *model.Base) (quantity int64, err error) {
+	query := s.db.Model(&model.Table{})
+	if input.ID != nil {
+		query.Where("id = ?", input.ID)
+	}
+
+	if input.TaskUUID != nil {
+		query.Where("task_uuid = ?", input.TaskUUID)
+	}
+
+	if input.IsDone != nil {
+		query.Where("is_done = ?", input.IsDone)
+	}
+
+	err = query.Count(&quantity).Select("*").Error
+	if err != nil {
+		log.Error(err)
+		return 0, err
+	}
+
+	return quantity, nil
+}
+
+func (s *storage) Update(input *model.Base) (err error) {
+	query := s.db.Model(&model.Table{}).Omit(clause.Associations)
+	data := map[string]any{}
+
+	if input.Title != nil {
+		data["title"] = input.Title
+	}
+
+	if input.IsDone != nil {
+		data["is_done"] = input.IsDone
+	}
+
+	// an empty resource UUID or a zero date removes the assignee or the due date
+	if input.ResourceUUID != nil {
+		if *input.ResourceUUID == "" {
+			data["resource_uuid"] = nil
+		} else {
+			data["resource_uuid"] = input.ResourceUUID
+		}
+	}
+
+	if input.DueDate != nil {
+		if input.DueDate.IsZero() {
+			data["due_date"] = nil
+		} else {
+			data["due_date"] = input.DueDate
+		}
+	}
+
+	if input.SortOrder != nil {
+		data["sort_order"] = input.SortOrder
+	}
+
+	if input.UpdatedBy != nil {
+		data["updated_by"] = input.UpdatedBy
+	}
+
+	if input.ID != nil {
+		query.Where("id = ?", input.ID)
+	}
+
+	err = query.Select("*").Updates(data).Error
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *storage) Delete(input *model.Base) (err error) {
+	query := s.db.Model(&model.Table{}).Omit(clause.Associations)
+	if input.ID != nil {
+		query.Where("id = ?", input.ID)
+	}
+
+	if input.TaskUUID != nil {
+		query.Where("task_uuid = ?", input.TaskUUID)
+	}
+
+	if input.TaskUUIDs != nil {
+		query.Where("task_uuid in (?)", input.TaskUUIDs)
+	}
+
+	err = query.Delete(&model.Table{}).Error
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package task_dependency
+
+import (
+	"github.com/bytedance/sonic"
+
+	model "gantt/internal/entity/postgresql/db/task_dependencies"
+	"gantt/internal/interactor/pkg/util/log"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Entity interface {
+	WithTrx(trx *gorm.DB) Entity
+	CreateAll(input []*model.Base) (err error)
+	GetByListNoPagination(input *model.Base) (output []*model.Table, err error)
+	Delete(input *model.Base) (err error)
+}
+
+type storage struct {
+	db *gorm.DB
+}
+
+func Init(db *gorm.DB) Entity {
+	return &storage{
+		db: db,
+	}
+}
+
+func (s *storage) WithTrx(trx *gorm.DB) Entity {
+	return &storage{
+		db: trx,
+	}
+}
+
+func (s *storage) CreateAll(input []*model.Base) (err error) {
+	marshal, err := sonic.Marshal(input)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	data := &[]model.Table{}
+	err = sonic.Unmarshal(marshal, data)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	err = s.db.Model(&model.Table{}).Omit(clause.Associations).Create(&data).Error
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *storage) GetByListNoPagination(input *model.Base) (output []*model.Table, err error) {
+	query := s.db.Model(&model.Table{})
+	if input.ID != nil {
+		query.Where("id = ?", input.ID)
+	}
+
+	if input.ProjectUUID != nil {
+		query.Where("project_uuid = ?", input.ProjectUUID)
+	}
+
+	if input.PredecessorUUID != nil {
+		query.Where("predecessor_uuid = ?", input.PredecessorUUID)
+	}
+
+	if input.SuccessorUUID != nil {
+		query.Where("successor_uuid = ?", input.SuccessorUUID)
+	}
+
+	if input.TaskUUIDs != nil {
+		query.Where("predecessor_uuid in (?) or successor_uuid in (?)", input.TaskUUIDs, input.TaskUUIDs)
+	}
+
+	err = query.Find(&output).Error
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// Delete removes the dependencies for good, they are derived from the predecessors of the tasks and have no history to keep.
+func (s *storage) Delete(input *model.Base) (err error) {
+	query := s.db.Unscoped().Model(&model.Table{}).Omit(clause.Associations)
+	if input.ID != nil {
+		query.Where("id = ?", input.ID)
+	}
+
+	if input.IDs != nil {
+		query.Where("id in (?)", input.IDs)
+	}
+
+	if input.ProjectUUID != nil {
+		query.Where("project_uuid = ?", input.ProjectUUID)
+	}
+
+	if input.TaskUUIDs != nil {
+		query.Where("predecessor_uuid in (?) or successor_uuid in (?)", input.TaskUUIDs, input.TaskUUIDs)
+	}
+
+	err = query.Delete(&model.Table{}).Error
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -112,7 +112,11 @@ func (s *storage) GetByListNoPagination(input *model.Base) (output []*model.Tabl
 	}
 
 	if input.TaskUUIDs != nil {
-		query.Where("id in (?)", input.TaskUUIDs)
+		query.Where("task_uuid in (?)", input.TaskUUIDs)
+	}
+
+	if input.ResourceUUIDs != nil {
+		query.Where("resource_uuid in (?)", input.ResourceUUIDs)
 	}
 
 	err = query.Find(&output).Error
@@ -2,4 +2,6 @@ package constants
 
 const (
 	DefaultLimit = 20
+	// 工作行事曆時區
+	Timezone = "Asia/Taipei"
 )
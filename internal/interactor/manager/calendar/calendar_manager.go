@@ -0,0 +1,324 @@
+package calendar
+
+import (
+	"errors"
+	"gantt/internal/interactor/pkg/util"
+
+	"github.com/bytedance/sonic"
+
+	"gorm.io/gorm"
+
+	calendarModel "gantt/internal/interactor/models/calendars"
+	holidayModel "gantt/internal/interactor/models/holidays"
+	projectModel "gantt/internal/interactor/models/projects"
+	workDayModel "gantt/internal/interactor/models/work_days"
+	calendarService "gantt/internal/interactor/service/calendar"
+	holidayService "gantt/internal/interactor/service/holiday"
+	projectService "gantt/internal/interactor/service/project"
+
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+)
+
+type Manager interface {
+	Create(trx *gorm.DB, input *calendarModel.Create) (int, any)
+	GetByList(input *calendarModel.Fields) (int, any)
+	GetByListNoPagination(input *calendarModel.Field) (int, any)
+	GetBySingle(input *calendarModel.Field) (int, any)
+	Delete(trx *gorm.DB, input *calendarModel.Field) (int, any)
+	Update(trx *gorm.DB, input *calendarModel.Update) (int, any)
+}
+
+type manager struct {
+	CalendarService calendarService.Service
+	HolidayService  holidayService.Service
+	ProjectService  projectService.Service
+}
+
+func Init(db *gorm.DB) Manager {
+	return &manager{
+		CalendarService: calendarService.Init(db),
+		HolidayService:  holidayService.Init(db),
+		ProjectService:  projectService.Init(db),
+	}
+}
+
+func (m *manager) Create(trx *gorm.DB, input *calendarModel.Create) (int, any) {
+	defer trx.Rollback()
+
+	// transform workWeek from struct array to string
+	if len(input.WorkWeeks) > 0 {
+		weekJson, _ := sonic.Marshal(input.WorkWeeks)
+		input.WorkWeek = string(weekJson)
+	}
+
+	// transform workingTime from struct array to string
+	if len(input.WorkingTimes) > 0 {
+		timeJson, _ := sonic.Marshal(input.WorkingTimes)
+		input.WorkingTime = string(timeJson)
+	}
+
+	// only one calendar can be the default calendar
+	if input.IsDefault {
+		err := m.syncResetDefaultCalendar(trx, input.CreatedBy)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	calendarBase, err := m.CalendarService.WithTrx(trx).Create(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, calendarBase.ID)
+}
+
+func (m *manager) GetByList(input *calendarModel.Fields) (int, any) {
+	output := &calendarModel.List{}
+	output.Limit = input.Limit
+	output.Page = input.Page
+	quantity, calendarBase, err := m.CalendarService.GetByList(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	output.Total.Total = quantity
+	output.Pages = util.Pagination(quantity, output.Limit)
+	calendarByte, err := sonic.Marshal(calendarBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(calendarByte, &output.Calendars)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, calendar := range output.Calendars {
+		calendar.CreatedBy = *calendarBase[i].CreatedByUsers.Name
+		calendar.UpdatedBy = *calendarBase[i].UpdatedByUsers.Name
+
+		// transform workWeek and workingTime to array
+		calendar.WorkWeeks, calendar.WorkingTimes, err = assembleCalendarSetting(calendarBase[i].WorkWeek, calendarBase[i].WorkingTime)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetByListNoPagination(input *calendarModel.Field) (int, any) {
+	output := &calendarModel.List{}
+	calendarBase, err := m.CalendarService.GetByListNoPagination(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	calendarByte, err := sonic.Marshal(calendarBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(calendarByte, &output.Calendars)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, calendar := range output.Calendars {
+		calendar.CreatedBy = *calendarBase[i].CreatedByUsers.Name
+		calendar.UpdatedBy = *calendarBase[i].UpdatedByUsers.Name
+
+		// transform workWeek and workingTime to array
+		calendar.WorkWeeks, calendar.WorkingTimes, err = assembleCalendarSetting(calendarBase[i].WorkWeek, calendarBase[i].WorkingTime)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetBySingle(input *calendarModel.Field) (int, any) {
+	calendarBase, err := m.CalendarService.GetBySingle(input)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output := &calendarModel.Single{}
+	calendarByte, _ := sonic.Marshal(calendarBase)
+	err = sonic.Unmarshal(calendarByte, &output)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output.CreatedBy = *calendarBase.CreatedByUsers.Name
+	output.UpdatedBy = *calendarBase.UpdatedByUsers.Name
+
+	// transform workWeek and workingTime to array
+	output.WorkWeeks, output.WorkingTimes, err = assembleCalendarSetting(calendarBase.WorkWeek, calendarBase.WorkingTime)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) Delete(trx *gorm.DB, input *calendarModel.Field) (int, any) {
+	defer trx.Rollback()
+
+	_, err := m.CalendarService.GetBySingle(&calendarModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// the calendar cannot be deleted while it is assigned to projects
+	quantity, err := m.ProjectService.GetByQuantity(&projectModel.Field{
+		CalendarUUID: util.PointerString(input.ID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if quantity > 0 {
+		log.Info("The calendar is assigned to projects.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The calendar is assigned to projects.")
+	}
+
+	// delete the holidays of the calendar
+	err = m.HolidayService.WithTrx(trx).Delete(&holidayModel.Field{
+		CalendarUUID: util.PointerString(input.ID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = m.CalendarService.WithTrx(trx).Delete(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, "Delete ok!")
+}
+
+func (m *manager) Update(trx *gorm.DB, input *calendarModel.Update) (int, any) {
+	defer trx.Rollback()
+
+	calendarBase, err := m.CalendarService.GetBySingle(&calendarModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// transform workWeek from struct array to string
+	if len(input.WorkWeeks) > 0 {
+		weekJson, _ := sonic.Marshal(input.WorkWeeks)
+		input.WorkWeek = util.PointerString(string(weekJson))
+	}
+
+	// transform workingTime from struct array to string
+	if len(input.WorkingTimes) > 0 {
+		timeJson, _ := sonic.Marshal(input.WorkingTimes)
+		input.WorkingTime = util.PointerString(string(timeJson))
+	}
+
+	// only one calendar can be the default calendar
+	if input.IsDefault != nil && *input.IsDefault && (calendarBase.IsDefault == nil || !*calendarBase.IsDefault) {
+		err = m.syncResetDefaultCalendar(trx, *input.UpdatedBy)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	err = m.CalendarService.WithTrx(trx).Update(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, calendarBase.ID)
+}
+
+// syncResetDefaultCalendar is a helper function to unset the current default calendar.
+func (m *manager) syncResetDefaultCalendar(trx *gorm.DB, updatedBy string) error {
+	calendarBase, err := m.CalendarService.WithTrx(trx).GetByListNoPagination(&calendarModel.Field{
+		IsDefault: util.PointerBool(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, calendar := range calendarBase {
+		err = m.CalendarService.WithTrx(trx).Update(&calendarModel.Update{
+			ID:        *calendar.ID,
+			IsDefault: util.PointerBool(false),
+			UpdatedBy: util.PointerString(updatedBy),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assembleCalendarSetting is a helper function to transform the work week and working time of the calendar to arrays.
+func assembleCalendarSetting(workWeek, workingTime *string) ([]string, []workDayModel.WorkingTimes, error) {
+	var (
+		workWeeks    []string
+		workingTimes []workDayModel.WorkingTimes
+	)
+
+	if workWeek != nil && *workWeek != "" {
+		err := sonic.Unmarshal([]byte(*workWeek), &workWeeks)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if workingTime != nil && *workingTime != "" {
+		err := sonic.Unmarshal([]byte(*workingTime), &workingTimes)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return workWeeks, workingTimes, nil
+}
@@ -8,7 +8,9 @@ import (
 
 	"gorm.io/gorm"
 
+	calendarModel "gantt/internal/interactor/models/calendars"
 	holidayModel "gantt/internal/interactor/models/holidays"
+	calendarService "gantt/internal/interactor/service/calendar"
 	holidayService "gantt/internal/interactor/service/holiday"
 
 	"gantt/internal/interactor/pkg/util/code"
@@ -25,18 +27,35 @@ type Manager interface {
 }
 
 type manager struct {
-	HolidayService holidayService.Service
+	HolidayService  holidayService.Service
+	CalendarService calendarService.Service
 }
 
 func Init(db *gorm.DB) Manager {
 	return &manager{
-		HolidayService: holidayService.Init(db),
+		HolidayService:  holidayService.Init(db),
+		CalendarService: calendarService.Init(db),
 	}
 }
 
 func (m *manager) Create(trx *gorm.DB, input *holidayModel.Create) (int, any) {
 	defer trx.Rollback()
 
+	// check if the calendar exists
+	if input.CalendarUUID != "" {
+		_, err := m.CalendarService.GetBySingle(&calendarModel.Field{
+			ID: input.CalendarUUID,
+		})
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
 	holidayBase, err := m.HolidayService.WithTrx(trx).Create(input)
 	if err != nil {
 		log.Error(err)
@@ -165,6 +184,21 @@ func (m *manager) Update(input *holidayModel.Update) (int, any) {
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
+	// check if the calendar exists
+	if input.CalendarUUID != nil && *input.CalendarUUID != "" {
+		_, err = m.CalendarService.GetBySingle(&calendarModel.Field{
+			ID: *input.CalendarUUID,
+		})
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
 	err = m.HolidayService.Update(input)
 	if err != nil {
 		log.Error(err)
@@ -2,23 +2,40 @@ package project
 
 import (
 	"errors"
+	projectDB "gantt/internal/entity/postgresql/db/projects"
+	"gantt/internal/interactor/constants"
+	projectBaselineManager "gantt/internal/interactor/manager/project_baseline"
+	projectScenarioManager "gantt/internal/interactor/manager/project_scenario"
+	projectTemplateManager "gantt/internal/interactor/manager/project_template"
+	taskManager "gantt/internal/interactor/manager/task"
+	calendarModel "gantt/internal/interactor/models/calendars"
 	eventMarkModel "gantt/internal/interactor/models/event_marks"
+	projectBaselineTaskModel "gantt/internal/interactor/models/project_baseline_tasks"
+	projectBaselineModel "gantt/internal/interactor/models/project_baselines"
 	projectResourceModel "gantt/internal/interactor/models/project_resources"
+	projectTemplateModel "gantt/internal/interactor/models/project_templates"
 	projectTypeModel "gantt/internal/interactor/models/project_types"
 	resourceModel "gantt/internal/interactor/models/resources"
 	roleModel "gantt/internal/interactor/models/roles"
+	taskDependencyModel "gantt/internal/interactor/models/task_dependencies"
 	taskResourceModel "gantt/internal/interactor/models/task_resources"
 	taskModel "gantt/internal/interactor/models/tasks"
 	userModel "gantt/internal/interactor/models/users"
+	"gantt/internal/interactor/pkg/schedule"
 	"gantt/internal/interactor/pkg/util"
+	calendarService "gantt/internal/interactor/service/calendar"
 	eventMarkService "gantt/internal/interactor/service/event_mark"
+	projectBaselineService "gantt/internal/interactor/service/project_baseline"
+	projectBaselineTaskService "gantt/internal/interactor/service/project_baseline_task"
 	projectResourceService "gantt/internal/interactor/service/project_resource"
 	projectTypeService "gantt/internal/interactor/service/project_type"
 	resourceService "gantt/internal/interactor/service/resource"
 	roleService "gantt/internal/interactor/service/role"
 	taskService "gantt/internal/interactor/service/task"
+	taskDependencyService "gantt/internal/interactor/service/task_dependency"
 	taskResourceService "gantt/internal/interactor/service/task_resource"
 	userService "gantt/internal/interactor/service/user"
+	"sort"
 	"time"
 
 	"github.com/bytedance/sonic"
@@ -39,37 +56,144 @@ type Manager interface {
 	GetBySingle(input *projectModel.Field) (int, any)
 	Delete(trx *gorm.DB, input *projectModel.Update) (int, any)
 	Update(trx *gorm.DB, input *projectModel.Update) (int, any)
+	GetCriticalPath(input *projectModel.Field) (int, any)
+	GetVariance(input *projectBaselineModel.Variance) (int, any)
+	GetEarnedValue(input *taskModel.EarnedValueField) (int, any)
+	GetForecast(input *taskModel.ForecastField) (int, any)
+	GetMilestones(input *taskModel.MilestoneField) (int, any)
+	LevelResources(trx *gorm.DB, input *taskModel.Level) (int, any)
+	RollUpTasks(trx *gorm.DB, input *taskModel.RollUp) (int, any)
+	Clone(trx *gorm.DB, input *projectModel.Clone) (int, any)
 }
 
 type manager struct {
-	ProjectService         projectService.Service
-	TaskService            taskService.Service
-	ResourceService        resourceService.Service
-	ProjectTypeService     projectTypeService.Service
-	ProjectResourceService projectResourceService.Service
-	EventMarkService       eventMarkService.Service
-	RoleService            roleService.Service
-	TaskResourceService    taskResourceService.Service
-	UserService            userService.Service
+	ProjectService             projectService.Service
+	TaskService                taskService.Service
+	ResourceService            resourceService.Service
+	ProjectTypeService         projectTypeService.Service
+	ProjectResourceService     projectResourceService.Service
+	EventMarkService           eventMarkService.Service
+	RoleService                roleService.Service
+	TaskResourceService        taskResourceService.Service
+	UserService                userService.Service
+	TaskDependencyService      taskDependencyService.Service
+	CalendarService            calendarService.Service
+	ProjectBaselineService     projectBaselineService.Service
+	ProjectBaselineTaskService projectBaselineTaskService.Service
+	TaskManager                taskManager.Manager
+	ProjectBaselineManager     projectBaselineManager.Manager
+	ProjectScenarioManager     projectScenarioManager.Manager
+	ProjectTemplateManager     projectTemplateManager.Manager
 }
 
 func Init(db *gorm.DB) Manager {
 	return &manager{
-		ProjectService:         projectService.Init(db),
-		TaskService:            taskService.Init(db),
-		ResourceService:        resourceService.Init(db),
-		ProjectTypeService:     projectTypeService.Init(db),
-		ProjectResourceService: projectResourceService.Init(db),
-		EventMarkService:       eventMarkService.Init(db),
-		RoleService:            roleService.Init(db),
-		TaskResourceService:    taskResourceService.Init(db),
-		UserService:            userService.Init(db),
+		ProjectService:             projectService.Init(db),
+		TaskService:                taskService.Init(db),
+		ResourceService:            resourceService.Init(db),
+		ProjectTypeService:         projectTypeService.Init(db),
+		ProjectResourceService:     projectResourceService.Init(db),
+		EventMarkService:           eventMarkService.Init(db),
+		RoleService:                roleService.Init(db),
+		TaskResourceService:        taskResourceService.Init(db),
+		UserService:                userService.Init(db),
+		TaskDependencyService:      taskDependencyService.Init(db),
+		CalendarService:            calendarService.Init(db),
+		ProjectBaselineService:     projectBaselineService.Init(db),
+		ProjectBaselineTaskService: projectBaselineTaskService.Init(db),
+		TaskManager:                taskManager.Init(db),
+		ProjectBaselineManager:     projectBaselineManager.Init(db),
+		ProjectScenarioManager:     projectScenarioManager.Init(db),
+		ProjectTemplateManager:     projectTemplateManager.Init(db),
 	}
 }
 
+// getAccessibleProject is a helper function to get the project which the user is allowed to view.
+func (m *manager) getAccessibleProject(input *projectModel.Field) (*projectDB.Base, error) {
+	projectBase, err := m.ProjectService.GetBySingle(&projectModel.Field{
+		ProjectUUID: input.ProjectUUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// if the user is user, the user must be the project's creator or member
+	if *input.Role == "user" && *projectBase.CreatedBy != *input.UserID {
+		_, err = m.ProjectResourceService.GetBySingle(&projectResourceModel.Field{
+			ResourceUUID: input.ResUUID,
+			ProjectUUID:  util.PointerString(input.ProjectUUID),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return projectBase, nil
+}
+
+// getAccessibleProjects is a helper function to get the projects which the user can access,
+// the projects created by the user or the user is the project's member if the user is user.
+func (m *manager) getAccessibleProjects(userID, resUUID, role *string) ([]*projectDB.Base, error) {
+	field := &projectModel.Field{
+		IsScenario: util.PointerBool(false),
+	}
+	if *role == "user" {
+		field.CreatedBy = userID
+		proResBase, err := m.ProjectResourceService.GetByListNoPagination(&projectResourceModel.Field{
+			ResourceUUID: resUUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, proRes := range proResBase {
+			field.ProjectUUIDs = append(field.ProjectUUIDs, proRes.ProjectUUID)
+		}
+	}
+
+	return m.ProjectService.GetByListNoPagination(field)
+}
+
 func (m *manager) Create(trx *gorm.DB, input *projectModel.Create) (int, any) {
 	defer trx.Rollback()
 
+	// the sandbox projects are created by the scenarios only
+	input.IsScenario = false
+
+	// check if the calendar exists
+	if input.CalendarUUID != "" {
+		_, err := m.CalendarService.GetBySingle(&calendarModel.Field{
+			ID: input.CalendarUUID,
+		})
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	// get the template which the project is created from
+	var template *projectTemplateModel.Single
+	if input.TemplateUUID != "" {
+		var err error
+		template, err = m.ProjectTemplateManager.GetTemplate(input.TemplateUUID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		if input.TypeID == "" {
+			input.TypeID = template.TypeID
+		}
+	}
+
 	projectBase, err := m.ProjectService.WithTrx(trx).Create(input)
 	if err != nil {
 		log.Error(err)
@@ -97,6 +221,27 @@ func (m *manager) Create(trx *gorm.DB, input *projectModel.Create) (int, any) {
 		}
 	}
 
+	// create the tasks, resources and event marks of the template, shifted to the start date of the project
+	if template != nil {
+		startDate := time.Time{}
+		if input.StartDate != nil {
+			startDate = *input.StartDate
+		} else if template.StartDate != nil {
+			startDate = *template.StartDate
+		}
+
+		err = m.ProjectTemplateManager.Instantiate(trx, *projectBase.ProjectUUID, util.PointerString(input.CalendarUUID), startDate, &template.Content, input.CreatedBy)
+		if err != nil {
+			if schedule.IsInvalid(err) {
+				log.Info(err.Error())
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
 	trx.Commit()
 	return code.Successful, code.GetCodeMessage(code.Successful, projectBase.ProjectUUID)
 }
@@ -230,6 +375,8 @@ func (m *manager) GetByList(input *projectModel.Fields) (int, any) {
 
 func (m *manager) GetByListNoPagination(input *projectModel.Field) (int, any) {
 	output := &projectModel.ListNoPagination{}
+	// the sandbox projects of the scenarios are not listed
+	input.IsScenario = util.PointerBool(false)
 
 	// if the user is user, search the project which is created by the user or the user is the project's member
 	if *input.Role == "user" {
@@ -380,6 +527,12 @@ func (m *manager) Delete(trx *gorm.DB,
 		}
 	}
 
+	// the sandbox project is deleted with its scenario
+	if projectBase.IsScenario != nil && *projectBase.IsScenario {
+		log.Info("The sandbox project of the scenario cannot be deleted.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The sandbox project of the scenario cannot be deleted.")
+	}
+
 	err = m.ProjectService.WithTrx(trx).Delete(input)
 	if err != nil {
 		log.Error(err)
@@ -395,6 +548,54 @@ func (m *manager) Delete(trx *gorm.DB,
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
+	// sync delete task_dependency
+	err = m.TaskDependencyService.WithTrx(trx).Delete(&taskDependencyModel.Field{
+		ProjectUUID: util.PointerString(input.ProjectUUID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync delete project_baseline and its snapshot
+	baselineBase, err := m.ProjectBaselineService.WithTrx(trx).GetByListNoPagination(&projectBaselineModel.Field{
+		ProjectUUID: util.PointerString(input.ProjectUUID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if len(baselineBase) > 0 {
+		var baselineUUIDs []*string
+		for _, baseline := range baselineBase {
+			baselineUUIDs = append(baselineUUIDs, baseline.ID)
+		}
+
+		err = m.ProjectBaselineTaskService.WithTrx(trx).Delete(&projectBaselineTaskModel.Field{
+			BaselineUUIDs: baselineUUIDs,
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		err = m.ProjectBaselineService.WithTrx(trx).Delete(&projectBaselineModel.Field{
+			ProjectUUID: util.PointerString(input.ProjectUUID),
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	// sync delete project_scenario and its sandbox
+	err = m.ProjectScenarioManager.DeleteByProject(trx, input.ProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
 	// sync delete project_resource
 	err = m.ProjectResourceService.WithTrx(trx).Delete(&projectResourceModel.Field{
 		ProjectUUID: util.PointerString(input.ProjectUUID),
@@ -460,12 +661,42 @@ func (m *manager) Update(trx *gorm.DB,
 		}
 	}
 
-	err = m.ProjectService.Update(input)
+	// check if the calendar exists
+	if input.CalendarUUID != nil && *input.CalendarUUID != "" {
+		_, err = m.CalendarService.GetBySingle(&calendarModel.Field{
+			ID: *input.CalendarUUID,
+		})
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	err = m.ProjectService.WithTrx(trx).Update(input)
 	if err != nil {
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
+	// the tasks derive their progresses from their checklists once the project opts in
+	if input.ProgressFromChecklist != nil && *input.ProgressFromChecklist &&
+		(projectBase.ProgressFromChecklist == nil || !*projectBase.ProgressFromChecklist) {
+		err = m.TaskManager.SyncChecklistProgress(trx, projectBase.ProjectUUID, nil, *input.UpdatedBy)
+		if err != nil {
+			if errors.Is(err, schedule.ErrInvalidStatus) {
+				log.Info(err.Error())
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
 	// update resource
 	var (
 		resourceList  []*projectResourceModel.Create
@@ -572,3 +803,267 @@ func (m *manager) Update(trx *gorm.DB,
 	trx.Commit()
 	return code.Successful, code.GetCodeMessage(code.Successful, projectBase.ProjectUUID)
 }
+
+func (m *manager) GetCriticalPath(input *projectModel.Field) (int, any) {
+	_, err := m.getAccessibleProject(input)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return m.TaskManager.GetByCriticalPath(&taskModel.Field{
+		ProjectUUID: util.PointerString(input.ProjectUUID),
+	})
+}
+
+func (m *manager) GetVariance(input *projectBaselineModel.Variance) (int, any) {
+	_, err := m.getAccessibleProject(&projectModel.Field{
+		ProjectUUID: input.ProjectUUID,
+		UserID:      input.UserID,
+		ResUUID:     input.ResUUID,
+		Role:        input.Role,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return m.ProjectBaselineManager.GetByVariance(input)
+}
+
+func (m *manager) GetEarnedValue(input *taskModel.EarnedValueField) (int, any) {
+	_, err := m.getAccessibleProject(&projectModel.Field{
+		ProjectUUID: input.ProjectUUID,
+		UserID:      input.UserID,
+		ResUUID:     input.ResUUID,
+		Role:        input.Role,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return m.TaskManager.GetByEarnedValue(input)
+}
+
+func (m *manager) GetForecast(input *taskModel.ForecastField) (int, any) {
+	_, err := m.getAccessibleProject(&projectModel.Field{
+		ProjectUUID: input.ProjectUUID,
+		UserID:      input.UserID,
+		ResUUID:     input.ResUUID,
+		Role:        input.Role,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return m.TaskManager.GetByForecast(input)
+}
+
+func (m *manager) GetMilestones(input *taskModel.MilestoneField) (int, any) {
+	projectBase, err := m.getAccessibleProjects(input.UserID, input.ResUUID, input.Role)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	selected := make(map[string]bool, len(input.ProjectUUIDs))
+	for _, projectUUID := range input.ProjectUUIDs {
+		selected[projectUUID] = true
+	}
+
+	// the days of the milestones are compared in the local timezone
+	location := util.LoadLocation(constants.Timezone)
+	today := util.NowToUTC().In(location)
+	output := &taskModel.MilestoneList{
+		Milestones: make([]*taskModel.Milestone, 0),
+	}
+	for _, project := range projectBase {
+		if len(selected) > 0 && !selected[*project.ProjectUUID] {
+			continue
+		}
+
+		taskBase, err := m.TaskService.GetByListNoPagination(&taskModel.Field{
+			ProjectUUID: project.ProjectUUID,
+			Filter: taskModel.Filter{
+				FilterMilestone: true,
+			},
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		for _, task := range taskBase {
+			if task.EndDate == nil {
+				continue
+			}
+
+			day := task.EndDate.In(location).Format(time.DateOnly)
+			if input.StartDate != nil && day < input.StartDate.Format(time.DateOnly) {
+				continue
+			}
+			if input.EndDate != nil && day > input.EndDate.Format(time.DateOnly) {
+				continue
+			}
+
+			status := schedule.MilestoneStatus(*task.EndDate, *task.Progress, task.Deadline, today)
+			if input.Status != nil && *input.Status != status {
+				continue
+			}
+
+			switch status {
+			case schedule.MilestoneDue:
+				output.Due++
+			case schedule.MilestoneAchieved:
+				output.Achieved++
+			case schedule.MilestoneLate:
+				output.Late++
+			}
+
+			output.Milestones = append(output.Milestones, &taskModel.Milestone{
+				TaskUUID:      *task.TaskUUID,
+				TaskID:        *task.TaskID,
+				TaskName:      *task.TaskName,
+				OutlineNumber: *task.OutlineNumber,
+				ProjectUUID:   *project.ProjectUUID,
+				ProjectName:   *project.ProjectName,
+				Date:          task.EndDate,
+				BaselineDate:  task.BaselineEndDate,
+				Deadline:      task.Deadline,
+				Progress:      *task.Progress,
+				Status:        status,
+			})
+		}
+	}
+
+	sort.SliceStable(output.Milestones, func(i, j int) bool {
+		return output.Milestones[i].Date.Before(*output.Milestones[j].Date)
+	})
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) LevelResources(trx *gorm.DB, input *taskModel.Level) (int, any) {
+	defer trx.Rollback()
+
+	_, err := m.getAccessibleProject(&projectModel.Field{
+		ProjectUUID: input.ProjectUUID,
+		UserID:      input.UpdatedBy,
+		ResUUID:     input.ResUUID,
+		Role:        input.Role,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return m.TaskManager.Level(trx, input)
+}
+
+func (m *manager) RollUpTasks(trx *gorm.DB, input *taskModel.RollUp) (int, any) {
+	defer trx.Rollback()
+
+	_, err := m.getAccessibleProject(&projectModel.Field{
+		ProjectUUID: input.ProjectUUID,
+		UserID:      input.UpdatedBy,
+		ResUUID:     input.ResUUID,
+		Role:        input.Role,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return m.TaskManager.RollUp(trx, input)
+}
+
+func (m *manager) Clone(trx *gorm.DB, input *projectModel.Clone) (int, any) {
+	defer trx.Rollback()
+
+	sourceBase, err := m.getAccessibleProject(&projectModel.Field{
+		ProjectUUID: input.ProjectUUID,
+		UserID:      util.PointerString(input.CreatedBy),
+		ResUUID:     input.ResUUID,
+		Role:        input.Role,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// keep the tasks, resources and event marks of the source project
+	content, err := m.ProjectTemplateManager.Snapshot(trx, input.ProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	create := &projectModel.Create{
+		ProjectName: input.ProjectName,
+		TypeID:      *sourceBase.TypeID,
+		Code:        input.Code,
+		StartDate:   input.StartDate,
+		Client:      *sourceBase.Client,
+		Status:      "建檔中",
+		CreatedBy:   input.CreatedBy,
+	}
+	if input.Client != nil {
+		create.Client = *input.Client
+	}
+	if sourceBase.CalendarUUID != nil {
+		create.CalendarUUID = *sourceBase.CalendarUUID
+	}
+	if sourceBase.ProgressFromChecklist != nil {
+		create.ProgressFromChecklist = *sourceBase.ProgressFromChecklist
+	}
+
+	projectBase, err := m.ProjectService.WithTrx(trx).Create(create)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = m.ProjectTemplateManager.Instantiate(trx, *projectBase.ProjectUUID, sourceBase.CalendarUUID, *input.StartDate, content, input.CreatedBy)
+	if err != nil {
+		if schedule.IsInvalid(err) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, projectBase.ProjectUUID)
+}
@@ -0,0 +1,659 @@
+package project_baseline
+
+import (
+	"errors"
+	"gantt/internal/interactor/pkg/schedule"
+	"gantt/internal/interactor/pkg/util"
+	"math"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"gorm.io/gorm"
+
+	taskManager "gantt/internal/interactor/manager/task"
+	projectBaselineTaskModel "gantt/internal/interactor/models/project_baseline_tasks"
+	projectBaselineModel "gantt/internal/interactor/models/project_baselines"
+	projectModel "gantt/internal/interactor/models/projects"
+	taskModel "gantt/internal/interactor/models/tasks"
+	projectService "gantt/internal/interactor/service/project"
+	projectBaselineService "gantt/internal/interactor/service/project_baseline"
+	projectBaselineTaskService "gantt/internal/interactor/service/project_baseline_task"
+	taskService "gantt/internal/interactor/service/task"
+
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+)
+
+type Manager interface {
+	Create(trx *gorm.DB, input *projectBaselineModel.Create) (int, any)
+	GetByList(input *projectBaselineModel.Fields) (int, any)
+	GetByListNoPagination(input *projectBaselineModel.Field) (int, any)
+	GetBySingle(input *projectBaselineModel.Field) (int, any)
+	Delete(trx *gorm.DB, input *projectBaselineModel.Field) (int, any)
+	Update(trx *gorm.DB, input *projectBaselineModel.Update) (int, any)
+	Compare(input *projectBaselineModel.Compare) (int, any)
+	GetByVariance(input *projectBaselineModel.Variance) (int, any)
+}
+
+type manager struct {
+	ProjectBaselineService     projectBaselineService.Service
+	ProjectBaselineTaskService projectBaselineTaskService.Service
+	ProjectService             projectService.Service
+	TaskService                taskService.Service
+	TaskManager                taskManager.Manager
+}
+
+func Init(db *gorm.DB) Manager {
+	return &manager{
+		ProjectBaselineService:     projectBaselineService.Init(db),
+		ProjectBaselineTaskService: projectBaselineTaskService.Init(db),
+		ProjectService:             projectService.Init(db),
+		TaskService:                taskService.Init(db),
+		TaskManager:                taskManager.Init(db),
+	}
+}
+
+func (m *manager) Create(trx *gorm.DB, input *projectBaselineModel.Create) (int, any) {
+	defer trx.Rollback()
+
+	// check if the project exists
+	_, err := m.ProjectService.GetBySingle(&projectModel.Field{
+		ProjectUUID: input.ProjectUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// only one baseline of the project can be selected
+	if input.IsSelected {
+		err = m.syncResetSelectedBaseline(trx, input.ProjectUUID, input.CreatedBy)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	baselineBase, err := m.ProjectBaselineService.WithTrx(trx).Create(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// snapshot the tasks of the project
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoQuantity(&taskModel.Field{
+		ProjectUUID: util.PointerString(input.ProjectUUID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if len(taskBase) > 0 {
+		var createList []*projectBaselineTaskModel.Create
+		taskByte, err := sonic.Marshal(taskBase)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		err = sonic.Unmarshal(taskByte, &createList)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		for _, task := range createList {
+			task.BaselineUUID = *baselineBase.ID
+			task.CreatedBy = input.CreatedBy
+		}
+
+		_, err = m.ProjectBaselineTaskService.WithTrx(trx).CreateAll(createList)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, baselineBase.ID)
+}
+
+func (m *manager) GetByList(input *projectBaselineModel.Fields) (int, any) {
+	output := &projectBaselineModel.List{}
+	output.Limit = input.Limit
+	output.Page = input.Page
+	quantity, baselineBase, err := m.ProjectBaselineService.GetByList(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	output.Total.Total = quantity
+	output.Pages = util.Pagination(quantity, output.Limit)
+	baselineByte, err := sonic.Marshal(baselineBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(baselineByte, &output.ProjectBaselines)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, baseline := range output.ProjectBaselines {
+		baseline.CreatedBy = *baselineBase[i].CreatedByUsers.Name
+		baseline.UpdatedBy = *baselineBase[i].UpdatedByUsers.Name
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetByListNoPagination(input *projectBaselineModel.Field) (int, any) {
+	output := &projectBaselineModel.List{}
+	baselineBase, err := m.ProjectBaselineService.GetByListNoPagination(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	baselineByte, err := sonic.Marshal(baselineBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(baselineByte, &output.ProjectBaselines)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, baseline := range output.ProjectBaselines {
+		baseline.CreatedBy = *baselineBase[i].CreatedByUsers.Name
+		baseline.UpdatedBy = *baselineBase[i].UpdatedByUsers.Name
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetBySingle(input *projectBaselineModel.Field) (int, any) {
+	baselineBase, err := m.ProjectBaselineService.GetBySingle(input)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output := &projectBaselineModel.Single{}
+	baselineByte, _ := sonic.Marshal(baselineBase)
+	err = sonic.Unmarshal(baselineByte, &output)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output.CreatedBy = *baselineBase.CreatedByUsers.Name
+	output.UpdatedBy = *baselineBase.UpdatedByUsers.Name
+
+	// list the snapshot in outline order
+	output.Tasks, err = m.getBaselineTasks(*baselineBase.ID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) Delete(trx *gorm.DB, input *projectBaselineModel.Field) (int, any) {
+	defer trx.Rollback()
+
+	_, err := m.ProjectBaselineService.GetBySingle(&projectBaselineModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// delete the snapshot of the baseline
+	err = m.ProjectBaselineTaskService.WithTrx(trx).Delete(&projectBaselineTaskModel.Field{
+		BaselineUUID: util.PointerString(input.ID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = m.ProjectBaselineService.WithTrx(trx).Delete(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, "Delete ok!")
+}
+
+func (m *manager) Update(trx *gorm.DB, input *projectBaselineModel.Update) (int, any) {
+	defer trx.Rollback()
+
+	baselineBase, err := m.ProjectBaselineService.GetBySingle(&projectBaselineModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// only one baseline of the project can be selected
+	if input.IsSelected != nil && *input.IsSelected && (baselineBase.IsSelected == nil || !*baselineBase.IsSelected) {
+		err = m.syncResetSelectedBaseline(trx, *baselineBase.ProjectUUID, *input.UpdatedBy)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	err = m.ProjectBaselineService.WithTrx(trx).Update(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, baselineBase.ID)
+}
+
+func (m *manager) Compare(input *projectBaselineModel.Compare) (int, any) {
+	sourceBase, err := m.ProjectBaselineService.GetBySingle(&projectBaselineModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output := &projectBaselineModel.Comparison{
+		Source: &projectBaselineModel.Summary{
+			ID:        *sourceBase.ID,
+			Name:      *sourceBase.Name,
+			CreatedAt: sourceBase.CreatedAt,
+		},
+	}
+
+	sourceTasks, err := m.getBaselineTasks(*sourceBase.ID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// compare with another baseline of the project, or with the current schedule
+	var targetTasks []*projectBaselineTaskModel.Single
+	if input.TargetUUID != nil {
+		targetBase, err := m.ProjectBaselineService.GetBySingle(&projectBaselineModel.Field{
+			ID:          *input.TargetUUID,
+			ProjectUUID: sourceBase.ProjectUUID,
+		})
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		output.Target = &projectBaselineModel.Summary{
+			ID:        *targetBase.ID,
+			Name:      *targetBase.Name,
+			CreatedAt: targetBase.CreatedAt,
+		}
+
+		targetTasks, err = m.getBaselineTasks(*targetBase.ID)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	} else {
+		taskBase, err := m.TaskService.GetByListNoPagination(&taskModel.Field{
+			ProjectUUID: sourceBase.ProjectUUID,
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		taskByte, err := sonic.Marshal(taskBase)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		err = sonic.Unmarshal(taskByte, &targetTasks)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	// the variances of the dates are counted in working days of the project
+	calendar, err := m.TaskManager.GetWorkCalendar(sourceBase.ProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output.Tasks = assembleComparison(sourceTasks, targetTasks, calendar)
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+// syncResetSelectedBaseline is a helper function to unselect the current selected baseline of the project.
+func (m *manager) GetByVariance(input *projectBaselineModel.Variance) (int, any) {
+	// get the requested baseline, or the selected one of the project
+	baselineField := &projectBaselineModel.Field{
+		ProjectUUID: util.PointerString(input.ProjectUUID),
+		IsSelected:  util.PointerBool(true),
+	}
+	if input.BaselineUUID != nil {
+		baselineField.ID = *input.BaselineUUID
+		baselineField.IsSelected = nil
+	}
+
+	baselineBase, err := m.ProjectBaselineService.GetBySingle(baselineField)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if input.BaselineUUID == nil {
+				log.Info("No baseline is selected for the project.")
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "No baseline is selected for the project.")
+			}
+
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	baselineTasks, err := m.getBaselineTasks(*baselineBase.ID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	taskBase, err := m.TaskService.GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: util.PointerString(input.ProjectUUID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	var tasks []*projectBaselineTaskModel.Single
+	taskByte, err := sonic.Marshal(taskBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(taskByte, &tasks)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// the variances of the dates are counted in working days of the project
+	calendar, err := m.TaskManager.GetWorkCalendar(util.PointerString(input.ProjectUUID))
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	variances := assembleTaskVariances(baselineTasks, tasks, calendar)
+	if input.Sort != nil && *input.Sort == "slippage" {
+		schedule.SortBySlippage(variances)
+	}
+
+	output := &projectBaselineModel.VarianceReport{
+		ProjectUUID: input.ProjectUUID,
+		Baseline: &projectBaselineModel.Summary{
+			ID:        *baselineBase.ID,
+			Name:      *baselineBase.Name,
+			CreatedAt: baselineBase.CreatedAt,
+		},
+		Tasks: make([]*projectBaselineModel.TaskVariance, 0, len(variances)),
+	}
+
+	taskMap := make(map[string]*projectBaselineTaskModel.Single, len(tasks))
+	for _, task := range tasks {
+		taskMap[task.TaskUUID] = task
+	}
+
+	for _, variance := range variances {
+		task := taskMap[variance.UUID]
+		taskVariance := &projectBaselineModel.TaskVariance{
+			TaskUUID:          task.TaskUUID,
+			TaskID:            task.TaskID,
+			TaskName:          task.TaskName,
+			OutlineNumber:     task.OutlineNumber,
+			IsSummary:         variance.IsSummary,
+			InBaseline:        variance.HasBaseline,
+			BaselineStartDate: assembleVarianceDate(variance.BaselineStart),
+			BaselineEndDate:   assembleVarianceDate(variance.BaselineFinish),
+			BaselineDuration:  variance.BaselineDuration,
+			BaselineCost:      int64(variance.BaselineCost),
+			StartDate:         assembleVarianceDate(variance.Start),
+			EndDate:           assembleVarianceDate(variance.Finish),
+			Duration:          variance.Duration,
+			Cost:              int64(variance.Cost),
+			StartVariance:     variance.StartVariance,
+			FinishVariance:    variance.FinishVariance,
+			DurationVariance:  variance.DurationVariance,
+		}
+		if variance.CostVariance != nil {
+			taskVariance.CostVariance = util.PointerInt64(int64(*variance.CostVariance))
+		}
+
+		output.Tasks = append(output.Tasks, taskVariance)
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) syncResetSelectedBaseline(trx *gorm.DB, projectUUID, updatedBy string) error {
+	baselineBase, err := m.ProjectBaselineService.WithTrx(trx).GetByListNoPagination(&projectBaselineModel.Field{
+		ProjectUUID: util.PointerString(projectUUID),
+		IsSelected:  util.PointerBool(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, baseline := range baselineBase {
+		err = m.ProjectBaselineService.WithTrx(trx).Update(&projectBaselineModel.Update{
+			ID:         *baseline.ID,
+			IsSelected: util.PointerBool(false),
+			UpdatedBy:  util.PointerString(updatedBy),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getBaselineTasks is a helper function to get the snapshot of the baseline in outline order.
+func (m *manager) getBaselineTasks(baselineUUID string) ([]*projectBaselineTaskModel.Single, error) {
+	taskBase, err := m.ProjectBaselineTaskService.GetByListNoPagination(&projectBaselineTaskModel.Field{
+		BaselineUUID: util.PointerString(baselineUUID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var output []*projectBaselineTaskModel.Single
+	taskByte, err := sonic.Marshal(taskBase)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sonic.Unmarshal(taskByte, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// assembleComparison is a helper function to compare the tasks of the baseline with the target ones, in the outline order of the target.
+func assembleComparison(source, target []*projectBaselineTaskModel.Single, calendar schedule.Calendar) []*projectBaselineModel.TaskComparison {
+	output := []*projectBaselineModel.TaskComparison{}
+	sourceMap := make(map[string]*projectBaselineTaskModel.Single)
+	for _, task := range source {
+		sourceMap[task.TaskUUID] = task
+	}
+
+	targetMap := make(map[string]bool)
+	for _, task := range target {
+		targetMap[task.TaskUUID] = true
+		comparison := &projectBaselineModel.TaskComparison{
+			TaskUUID:        task.TaskUUID,
+			TaskID:          task.TaskID,
+			TaskName:        task.TaskName,
+			OutlineNumber:   task.OutlineNumber,
+			Status:          "added",
+			TargetStartDate: task.StartDate,
+			TargetEndDate:   task.EndDate,
+			TargetDuration:  task.Duration,
+			TargetCost:      task.Cost,
+			TargetProgress:  task.Progress,
+		}
+
+		if snapshot, ok := sourceMap[task.TaskUUID]; ok {
+			comparison.SourceStartDate = snapshot.StartDate
+			comparison.SourceEndDate = snapshot.EndDate
+			comparison.SourceDuration = snapshot.Duration
+			comparison.SourceCost = snapshot.Cost
+			comparison.SourceProgress = snapshot.Progress
+			comparison.StartVariance = assembleVariance(calendar, snapshot.StartDate, task.StartDate)
+			comparison.FinishVariance = assembleVariance(calendar, snapshot.EndDate, task.EndDate)
+			comparison.DurationVariance = math.Round((task.Duration-snapshot.Duration)*100) / 100
+			comparison.CostVariance = task.Cost - snapshot.Cost
+			comparison.Status = "unchanged"
+			if (comparison.StartVariance != nil && *comparison.StartVariance != 0) ||
+				(comparison.FinishVariance != nil && *comparison.FinishVariance != 0) ||
+				comparison.DurationVariance != 0 || comparison.CostVariance != 0 || task.Progress != snapshot.Progress {
+				comparison.Status = "changed"
+			}
+		}
+
+		output = append(output, comparison)
+	}
+
+	// the tasks which have been deleted since the baseline, in the outline order of the baseline
+	var removed []*projectBaselineModel.TaskComparison
+	for _, snapshot := range source {
+		if targetMap[snapshot.TaskUUID] {
+			continue
+		}
+
+		removed = append(removed, &projectBaselineModel.TaskComparison{
+			TaskUUID:        snapshot.TaskUUID,
+			TaskID:          snapshot.TaskID,
+			TaskName:        snapshot.TaskName,
+			OutlineNumber:   snapshot.OutlineNumber,
+			Status:          "removed",
+			SourceStartDate: snapshot.StartDate,
+			SourceEndDate:   snapshot.EndDate,
+			SourceDuration:  snapshot.Duration,
+			SourceCost:      snapshot.Cost,
+			SourceProgress:  snapshot.Progress,
+		})
+	}
+
+	return append(output, removed...)
+}
+
+// assembleVariance is a helper function to get the working days from the baseline date to the target date, positive when the target is later.
+func assembleVariance(calendar schedule.Calendar, baseline, target *time.Time) *float64 {
+	if baseline == nil || target == nil {
+		return nil
+	}
+
+	return util.PointerFloat64(math.Round(calendar.Days(*baseline, *target)*100) / 100)
+}
+
+// assembleTaskVariances is a helper function to pair the current tasks with their baseline snapshots and roll the variances up through the outline.
+func assembleTaskVariances(baseline, tasks []*projectBaselineTaskModel.Single, calendar schedule.Calendar) []*schedule.Variance {
+	baselineMap := make(map[string]*projectBaselineTaskModel.Single, len(baseline))
+	for _, snapshot := range baseline {
+		baselineMap[snapshot.TaskUUID] = snapshot
+	}
+
+	variances := make([]*schedule.Variance, 0, len(tasks))
+	for _, task := range tasks {
+		variance := &schedule.Variance{
+			UUID:          task.TaskUUID,
+			OutlineNumber: task.OutlineNumber,
+			Duration:      task.Duration,
+			Cost:          float64(task.Cost),
+		}
+		if task.StartDate != nil {
+			variance.Start = *task.StartDate
+		}
+
+		if task.EndDate != nil {
+			variance.Finish = *task.EndDate
+		}
+
+		if snapshot, ok := baselineMap[task.TaskUUID]; ok {
+			variance.HasBaseline = true
+			variance.BaselineDuration = snapshot.Duration
+			variance.BaselineCost = float64(snapshot.Cost)
+			if snapshot.StartDate != nil {
+				variance.BaselineStart = *snapshot.StartDate
+			}
+
+			if snapshot.EndDate != nil {
+				variance.BaselineFinish = *snapshot.EndDate
+			}
+		}
+
+		variances = append(variances, variance)
+	}
+
+	schedule.RollUpVariances(variances, calendar)
+	return variances
+}
+
+// assembleVarianceDate is a helper function to get the pointer of the date, or nil when it is not set.
+func assembleVarianceDate(date time.Time) *time.Time {
+	if date.IsZero() {
+		return nil
+	}
+
+	return util.PointerTime(date)
+}
@@ -0,0 +1,1175 @@
+package project_scenario
+
+import (
+	"errors"
+	"fmt"
+	"gantt/internal/interactor/pkg/schedule"
+	"gantt/internal/interactor/pkg/util"
+	"math"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"gorm.io/gorm"
+
+	taskDB "gantt/internal/entity/postgresql/db/tasks"
+	taskManager "gantt/internal/interactor/manager/task"
+	projectResourceModel "gantt/internal/interactor/models/project_resources"
+	projectScenarioModel "gantt/internal/interactor/models/project_scenarios"
+	projectModel "gantt/internal/interactor/models/projects"
+	resourceModel "gantt/internal/interactor/models/resources"
+	taskDependencyModel "gantt/internal/interactor/models/task_dependencies"
+	taskResourceModel "gantt/internal/interactor/models/task_resources"
+	taskModel "gantt/internal/interactor/models/tasks"
+	projectService "gantt/internal/interactor/service/project"
+	projectResourceService "gantt/internal/interactor/service/project_resource"
+	projectScenarioService "gantt/internal/interactor/service/project_scenario"
+	resourceService "gantt/internal/interactor/service/resource"
+	taskService "gantt/internal/interactor/service/task"
+	taskDependencyService "gantt/internal/interactor/service/task_dependency"
+	taskResourceService "gantt/internal/interactor/service/task_resource"
+
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+)
+
+type Manager interface {
+	Create(trx *gorm.DB, input *projectScenarioModel.Create) (int, any)
+	GetByList(input *projectScenarioModel.Fields) (int, any)
+	GetByListNoPagination(input *projectScenarioModel.Field) (int, any)
+	GetBySingle(input *projectScenarioModel.Field) (int, any)
+	Delete(trx *gorm.DB, input *projectScenarioModel.Field) (int, any)
+	Update(trx *gorm.DB, input *projectScenarioModel.Update) (int, any)
+	Compare(input *projectScenarioModel.Field) (int, any)
+	Promote(trx *gorm.DB, input *projectScenarioModel.Promote) (int, any)
+	DeleteByProject(trx *gorm.DB, projectUUID string) error
+}
+
+type manager struct {
+	ProjectScenarioService projectScenarioService.Service
+	ProjectService         projectService.Service
+	ProjectResourceService projectResourceService.Service
+	ResourceService        resourceService.Service
+	TaskService            taskService.Service
+	TaskResourceService    taskResourceService.Service
+	TaskDependencyService  taskDependencyService.Service
+	TaskManager            taskManager.Manager
+}
+
+func Init(db *gorm.DB) Manager {
+	return &manager{
+		ProjectScenarioService: projectScenarioService.Init(db),
+		ProjectService:         projectService.Init(db),
+		ProjectResourceService: projectResourceService.Init(db),
+		ResourceService:        resourceService.Init(db),
+		TaskService:            taskService.Init(db),
+		TaskResourceService:    taskResourceService.Init(db),
+		TaskDependencyService:  taskDependencyService.Init(db),
+		TaskManager:            taskManager.Init(db),
+	}
+}
+
+func (m *manager) Create(trx *gorm.DB, input *projectScenarioModel.Create) (int, any) {
+	defer trx.Rollback()
+
+	// check if the project exists
+	projectBase, err := m.ProjectService.GetBySingle(&projectModel.Field{
+		ProjectUUID: input.ProjectUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if projectBase.IsScenario != nil && *projectBase.IsScenario {
+		log.Info("The scenario cannot be branched.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The scenario cannot be branched.")
+	}
+
+	// the tasks of the scenario are kept in a sandbox project, hidden from the project list
+	create := &projectModel.Create{
+		ProjectName: fmt.Sprintf("%s (%s)", *projectBase.ProjectName, input.Name),
+		TypeID:      *projectBase.TypeID,
+		Code:        *projectBase.Code,
+		StartDate:   projectBase.StartDate,
+		EndDate:     projectBase.EndDate,
+		Client:      *projectBase.Client,
+		Status:      *projectBase.Status,
+		IsScenario:  true,
+		CreatedBy:   input.CreatedBy,
+	}
+	if projectBase.CalendarUUID != nil {
+		create.CalendarUUID = *projectBase.CalendarUUID
+	}
+	if projectBase.ProgressFromChecklist != nil {
+		create.ProgressFromChecklist = *projectBase.ProgressFromChecklist
+	}
+
+	sandboxBase, err := m.ProjectService.WithTrx(trx).Create(create)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	input.ScenarioProjectUUID = *sandboxBase.ProjectUUID
+	scenarioBase, err := m.ProjectScenarioService.WithTrx(trx).Create(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// copy the tasks and the assignments of the project into the sandbox
+	err = m.branch(trx, input.ProjectUUID, input.ScenarioProjectUUID, input.CreatedBy)
+	if err != nil {
+		if schedule.IsInvalid(err) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, scenarioBase.ID)
+}
+
+func (m *manager) GetByList(input *projectScenarioModel.Fields) (int, any) {
+	output := &projectScenarioModel.List{}
+	output.Limit = input.Limit
+	output.Page = input.Page
+	quantity, scenarioBase, err := m.ProjectScenarioService.GetByList(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	output.Total.Total = quantity
+	output.Pages = util.Pagination(quantity, output.Limit)
+	scenarioByte, err := sonic.Marshal(scenarioBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(scenarioByte, &output.ProjectScenarios)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, scenario := range output.ProjectScenarios {
+		scenario.CreatedBy = *scenarioBase[i].CreatedByUsers.Name
+		scenario.UpdatedBy = *scenarioBase[i].UpdatedByUsers.Name
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetByListNoPagination(input *projectScenarioModel.Field) (int, any) {
+	output := &projectScenarioModel.List{}
+	scenarioBase, err := m.ProjectScenarioService.GetByListNoPagination(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	scenarioByte, err := sonic.Marshal(scenarioBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(scenarioByte, &output.ProjectScenarios)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, scenario := range output.ProjectScenarios {
+		scenario.CreatedBy = *scenarioBase[i].CreatedByUsers.Name
+		scenario.UpdatedBy = *scenarioBase[i].UpdatedByUsers.Name
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetBySingle(input *projectScenarioModel.Field) (int, any) {
+	scenarioBase, err := m.ProjectScenarioService.GetBySingle(input)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output := &projectScenarioModel.Single{}
+	scenarioByte, _ := sonic.Marshal(scenarioBase)
+	err = sonic.Unmarshal(scenarioByte, &output)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output.CreatedBy = *scenarioBase.CreatedByUsers.Name
+	output.UpdatedBy = *scenarioBase.UpdatedByUsers.Name
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) Delete(trx *gorm.DB, input *projectScenarioModel.Field) (int, any) {
+	defer trx.Rollback()
+
+	scenarioBase, err := m.ProjectScenarioService.GetBySingle(&projectScenarioModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// discard the sandbox with its tasks
+	err = m.syncDeleteSandbox(trx, *scenarioBase.ScenarioProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = m.ProjectScenarioService.WithTrx(trx).Delete(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, "Delete ok!")
+}
+
+func (m *manager) Update(trx *gorm.DB, input *projectScenarioModel.Update) (int, any) {
+	defer trx.Rollback()
+
+	scenarioBase, err := m.ProjectScenarioService.GetBySingle(&projectScenarioModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = m.ProjectScenarioService.WithTrx(trx).Update(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, scenarioBase.ID)
+}
+
+func (m *manager) Compare(input *projectScenarioModel.Field) (int, any) {
+	scenarioBase, err := m.ProjectScenarioService.GetBySingle(&projectScenarioModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	projectBase, err := m.ProjectService.GetBySingle(&projectModel.Field{
+		ProjectUUID: *scenarioBase.ProjectUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	projectTasks, err := m.getTasks(*scenarioBase.ProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	scenarioTasks, err := m.getTasks(*scenarioBase.ScenarioProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// the variances of the dates are counted in working days of the project
+	calendar, err := m.TaskManager.GetWorkCalendar(scenarioBase.ProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output := &projectScenarioModel.Comparison{
+		Project:  assembleSummary(*scenarioBase.ProjectUUID, *projectBase.ProjectName, projectTasks, calendar),
+		Scenario: assembleSummary(*scenarioBase.ScenarioProjectUUID, *scenarioBase.Name, scenarioTasks, calendar),
+		Tasks:    assembleComparison(projectTasks, scenarioTasks, scenarioBase.CreatedAt, calendar),
+	}
+	output.Conflicts = assembleConflicts(projectTasks, scenarioBase.CreatedAt)
+	output.EndDateVariance = assembleVariance(calendar, output.Project.EndDate, output.Scenario.EndDate)
+	output.DurationVariance = math.Round((output.Scenario.Duration-output.Project.Duration)*100) / 100
+	output.CostVariance = output.Scenario.Cost - output.Project.Cost
+
+	output.Resources, err = m.getResourceComparison(*scenarioBase.ProjectUUID, *scenarioBase.ScenarioProjectUUID, projectTasks, scenarioTasks, calendar.HoursPerDay())
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) Promote(trx *gorm.DB, input *projectScenarioModel.Promote) (int, any) {
+	defer trx.Rollback()
+
+	scenarioBase, err := m.ProjectScenarioService.GetBySingle(&projectScenarioModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = m.ProjectScenarioService.WithTrx(trx).Delete(&projectScenarioModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// the tasks of the sandbox are moved by the merge, the sandbox itself is removed
+	err = m.ProjectResourceService.WithTrx(trx).Delete(&projectResourceModel.Field{
+		ProjectUUID: scenarioBase.ScenarioProjectUUID,
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = m.ProjectService.WithTrx(trx).Delete(&projectModel.Update{
+		ProjectUUID: *scenarioBase.ScenarioProjectUUID,
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// replace the schedule of the project with the scenario through the validation of the tasks
+	return m.merge(trx, &taskModel.Merge{
+		ProjectUUID:         *scenarioBase.ProjectUUID,
+		ScenarioProjectUUID: *scenarioBase.ScenarioProjectUUID,
+		BranchedAt:          scenarioBase.CreatedAt,
+		UpdatedBy:           input.UpdatedBy,
+		ResUUID:             input.ResUUID,
+		Role:                input.Role,
+	})
+}
+
+// DeleteByProject discards the scenarios of the project with their sandboxes.
+func (m *manager) DeleteByProject(trx *gorm.DB, projectUUID string) error {
+	scenarioBase, err := m.ProjectScenarioService.WithTrx(trx).GetByListNoPagination(&projectScenarioModel.Field{
+		ProjectUUID: util.PointerString(projectUUID),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, scenario := range scenarioBase {
+		err = m.syncDeleteSandbox(trx, *scenario.ScenarioProjectUUID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(scenarioBase) == 0 {
+		return nil
+	}
+
+	return m.ProjectScenarioService.WithTrx(trx).Delete(&projectScenarioModel.Field{
+		ProjectUUID: util.PointerString(projectUUID),
+	})
+}
+
+// syncDeleteSandbox is a helper function to delete the sandbox project of a scenario with its tasks, assignments, dependencies and members.
+func (m *manager) syncDeleteSandbox(trx *gorm.DB, sandboxUUID string) error {
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoQuantity(&taskModel.Field{
+		ProjectUUID: util.PointerString(sandboxUUID),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(taskBase) > 0 {
+		var taskUUIDs []*string
+		for _, task := range taskBase {
+			taskUUIDs = append(taskUUIDs, task.TaskUUID)
+		}
+
+		err = m.TaskResourceService.WithTrx(trx).Delete(&taskResourceModel.Field{
+			TaskUUIDs: taskUUIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = m.TaskService.WithTrx(trx).Delete(&taskModel.Field{
+			ProjectUUID: util.PointerString(sandboxUUID),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = m.TaskDependencyService.WithTrx(trx).Delete(&taskDependencyModel.Field{
+		ProjectUUID: util.PointerString(sandboxUUID),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = m.ProjectResourceService.WithTrx(trx).Delete(&projectResourceModel.Field{
+		ProjectUUID: util.PointerString(sandboxUUID),
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.ProjectService.WithTrx(trx).Delete(&projectModel.Update{
+		ProjectUUID: sandboxUUID,
+	})
+}
+
+// getTasks is a helper function to get the tasks of the project in outline order.
+func (m *manager) getTasks(projectUUID string) ([]*taskModel.Single, error) {
+	taskBase, err := m.TaskService.GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: util.PointerString(projectUUID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var output []*taskModel.Single
+	taskByte, err := sonic.Marshal(taskBase)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sonic.Unmarshal(taskByte, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// getResourceComparison is a helper function to compare the work of the resources assigned in the project and in the scenario,
+// and whether they are overallocated in each schedule.
+func (m *manager) getResourceComparison(projectUUID, scenarioProjectUUID string, projectTasks, scenarioTasks []*taskModel.Single, hoursPerDay float64) ([]*projectScenarioModel.ResourceComparison, error) {
+	output := []*projectScenarioModel.ResourceComparison{}
+	projectHours := assembleWork(projectTasks, hoursPerDay)
+	scenarioHours := assembleWork(scenarioTasks, hoursPerDay)
+
+	var resourceUUIDs []*string
+	for resourceUUID := range projectHours {
+		resourceUUIDs = append(resourceUUIDs, util.PointerString(resourceUUID))
+	}
+	for resourceUUID := range scenarioHours {
+		if _, ok := projectHours[resourceUUID]; !ok {
+			resourceUUIDs = append(resourceUUIDs, util.PointerString(resourceUUID))
+		}
+	}
+
+	if len(resourceUUIDs) == 0 {
+		return output, nil
+	}
+
+	projectOverallocated, err := m.getOverallocatedResources(projectUUID, resourceUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	scenarioOverallocated, err := m.getOverallocatedResources(scenarioProjectUUID, resourceUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceBase, err := m.ResourceService.GetByListNoPagination(&resourceModel.Field{
+		ResourceUUIDs: resourceUUIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, res := range resourceBase {
+		comparison := &projectScenarioModel.ResourceComparison{
+			ResourceUUID:          *res.ResourceUUID,
+			ProjectHours:          math.Round(projectHours[*res.ResourceUUID]*100) / 100,
+			ScenarioHours:         math.Round(scenarioHours[*res.ResourceUUID]*100) / 100,
+			ProjectOverallocated:  projectOverallocated[*res.ResourceUUID],
+			ScenarioOverallocated: scenarioOverallocated[*res.ResourceUUID],
+		}
+		if res.ResourceName != nil {
+			comparison.ResourceName = *res.ResourceName
+		}
+		comparison.HoursVariance = math.Round((comparison.ScenarioHours-comparison.ProjectHours)*100) / 100
+
+		output = append(output, comparison)
+	}
+
+	return output, nil
+}
+
+// getOverallocatedResources is a helper function to find the resources which are overallocated on the tasks of the project,
+// counting the assignments of the other projects as seen from the project.
+func (m *manager) getOverallocatedResources(projectUUID string, resourceUUIDs []*string) (map[string]bool, error) {
+	overallocations, err := m.TaskManager.GetOverallocations(util.PointerString(projectUUID), resourceUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]bool)
+	for _, overallocation := range overallocations {
+		for _, task := range overallocation.Tasks {
+			if task.ProjectUUID == projectUUID {
+				output[overallocation.ResourceUUID] = true
+				break
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// assembleLeafTasks is a helper function to get the tasks without subtasks, whose values are not rolled up from other tasks.
+func assembleLeafTasks(tasks []*taskModel.Single) []*taskModel.Single {
+	parentMap := make(map[string]bool)
+	for _, task := range tasks {
+		if task.IsSubTask {
+			parentMap[taskManager.GetParentOutlineNumber(task.OutlineNumber)] = true
+		}
+	}
+
+	var output []*taskModel.Single
+	for _, task := range tasks {
+		if !parentMap[task.OutlineNumber] {
+			output = append(output, task)
+		}
+	}
+
+	return output
+}
+
+// assembleSummary is a helper function to summarize the dates, the working days and the cost of the schedule.
+func assembleSummary(projectUUID, name string, tasks []*taskModel.Single, calendar schedule.Calendar) *projectScenarioModel.Summary {
+	output := &projectScenarioModel.Summary{
+		ProjectUUID: projectUUID,
+		Name:        name,
+	}
+
+	for _, task := range tasks {
+		if task.StartDate != nil && (output.StartDate == nil || task.StartDate.Before(*output.StartDate)) {
+			output.StartDate = task.StartDate
+		}
+		if task.EndDate != nil && (output.EndDate == nil || task.EndDate.After(*output.EndDate)) {
+			output.EndDate = task.EndDate
+		}
+	}
+
+	for _, task := range assembleLeafTasks(tasks) {
+		output.Cost += task.Cost
+	}
+
+	if output.StartDate != nil && output.EndDate != nil {
+		output.Duration = math.Round(calendar.Days(*output.StartDate, *output.EndDate)*100) / 100
+	}
+
+	return output
+}
+
+// assembleWork is a helper function to get the working hours of the resources assigned to the tasks without subtasks.
+func assembleWork(tasks []*taskModel.Single, hoursPerDay float64) map[string]float64 {
+	output := make(map[string]float64)
+	for _, task := range assembleLeafTasks(tasks) {
+		for _, res := range task.Resources {
+			output[res.ResourceUUID] += task.Duration * hoursPerDay * res.Unit / 100
+		}
+	}
+
+	return output
+}
+
+// assembleComparison is a helper function to pair the tasks of the scenario with the tasks of the project which they are copied from,
+// listing the tasks added, changed and removed in the scenario.
+func assembleComparison(projectTasks, scenarioTasks []*taskModel.Single, branchedAt *time.Time, calendar schedule.Calendar) []*projectScenarioModel.TaskComparison {
+	output := []*projectScenarioModel.TaskComparison{}
+	projectMap := make(map[string]*taskModel.Single)
+	for _, task := range projectTasks {
+		projectMap[task.TaskUUID] = task
+	}
+
+	keptMap := make(map[string]bool)
+	for _, task := range scenarioTasks {
+		comparison := &projectScenarioModel.TaskComparison{
+			ScenarioTaskUUID:  task.TaskUUID,
+			TaskID:            task.TaskID,
+			TaskName:          task.TaskName,
+			OutlineNumber:     task.OutlineNumber,
+			Status:            "added",
+			ScenarioStartDate: task.StartDate,
+			ScenarioEndDate:   task.EndDate,
+			ScenarioDuration:  task.Duration,
+			ScenarioCost:      task.Cost,
+		}
+
+		if task.SourceTaskUUID != nil && projectMap[*task.SourceTaskUUID] != nil {
+			original := projectMap[*task.SourceTaskUUID]
+			keptMap[original.TaskUUID] = true
+			if taskManager.EqualTime(original.StartDate, task.StartDate) && taskManager.EqualTime(original.EndDate, task.EndDate) &&
+				original.Duration == task.Duration && original.Cost == task.Cost && original.TaskName == task.TaskName &&
+				original.OutlineNumber == task.OutlineNumber && original.Predecessor == task.Predecessor {
+				continue
+			}
+
+			comparison.TaskUUID = original.TaskUUID
+			comparison.Status = "changed"
+			comparison.ProjectStartDate = original.StartDate
+			comparison.ProjectEndDate = original.EndDate
+			comparison.ProjectDuration = original.Duration
+			comparison.ProjectCost = original.Cost
+			comparison.FinishVariance = assembleVariance(calendar, original.EndDate, task.EndDate)
+		}
+
+		output = append(output, comparison)
+	}
+
+	// the tasks which have been deleted in the scenario, in the outline order of the project
+	for _, task := range projectTasks {
+		if keptMap[task.TaskUUID] || isAddedAfterBranch(task, branchedAt) {
+			continue
+		}
+
+		output = append(output, &projectScenarioModel.TaskComparison{
+			TaskUUID:         task.TaskUUID,
+			TaskID:           task.TaskID,
+			TaskName:         task.TaskName,
+			OutlineNumber:    task.OutlineNumber,
+			Status:           "removed",
+			ProjectStartDate: task.StartDate,
+			ProjectEndDate:   task.EndDate,
+			ProjectDuration:  task.Duration,
+			ProjectCost:      task.Cost,
+		})
+	}
+
+	return output
+}
+
+// assembleConflicts is a helper function to list the tasks added to the project after the branch, which the scenario does not know of
+// and which are kept after the tasks of the scenario when it is promoted.
+func assembleConflicts(projectTasks []*taskModel.Single, branchedAt *time.Time) []*projectScenarioModel.TaskComparison {
+	output := []*projectScenarioModel.TaskComparison{}
+	for _, task := range projectTasks {
+		if !isAddedAfterBranch(task, branchedAt) {
+			continue
+		}
+
+		output = append(output, &projectScenarioModel.TaskComparison{
+			TaskUUID:         task.TaskUUID,
+			TaskID:           task.TaskID,
+			TaskName:         task.TaskName,
+			OutlineNumber:    task.OutlineNumber,
+			Status:           "conflict",
+			ProjectStartDate: task.StartDate,
+			ProjectEndDate:   task.EndDate,
+			ProjectDuration:  task.Duration,
+			ProjectCost:      task.Cost,
+		})
+	}
+
+	return output
+}
+
+// assembleVariance is a helper function to get the working days from the date of the project to the date of the scenario, positive when the scenario is later.
+func assembleVariance(calendar schedule.Calendar, project, scenario *time.Time) *float64 {
+	if project == nil || scenario == nil {
+		return nil
+	}
+
+	return util.PointerFloat64(math.Round(calendar.Days(*project, *scenario)*100) / 100)
+}
+
+// branch is a helper function to copy the tasks and the assignments of the project into the sandbox project of a scenario as they are,
+// keeping the progress of the tasks and the task of the project which each task is copied from.
+func (m *manager) branch(trx *gorm.DB, projectUUID, scenarioProjectUUID, createdBy string) error {
+	// the members of the project keep their roles in the sandbox
+	proResBase, err := m.ProjectResourceService.WithTrx(trx).GetByListNoPagination(&projectResourceModel.Field{
+		ProjectUUID: util.PointerString(projectUUID),
+	})
+	if err != nil {
+		return err
+	}
+
+	proResMap := make(map[string]*projectResourceModel.Single)
+	var proResList []*projectResourceModel.Create
+	for _, res := range proResBase {
+		proRes := &projectResourceModel.Create{
+			ProjectUUID:  scenarioProjectUUID,
+			ResourceUUID: *res.ResourceUUID,
+			IsEditable:   res.IsEditable != nil && *res.IsEditable,
+			CreatedBy:    createdBy,
+		}
+		if res.Role != nil {
+			proRes.Role = *res.Role
+		}
+
+		proResList = append(proResList, proRes)
+		proResMap[*res.ResourceUUID] = &projectResourceModel.Single{
+			ResourceUUID: *res.ResourceUUID,
+		}
+	}
+
+	if len(proResList) > 0 {
+		_, err = m.ProjectResourceService.WithTrx(trx).CreateAll(proResList)
+		if err != nil {
+			return err
+		}
+	}
+
+	// get tasks for the project in outline order
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: util.PointerString(projectUUID),
+	})
+	if err != nil {
+		return err
+	}
+
+	var tasks []*taskModel.Single
+	taskByte, err := sonic.Marshal(taskBase)
+	if err != nil {
+		return err
+	}
+
+	err = sonic.Unmarshal(taskByte, &tasks)
+	if err != nil {
+		return err
+	}
+
+	var taskResMapList []map[string][]*resourceModel.TaskSingle
+	for i, task := range tasks {
+		create := &taskModel.Create{
+			TaskID:              task.TaskID,
+			TaskName:            task.TaskName,
+			StartDate:           task.StartDate,
+			EndDate:             task.EndDate,
+			BaselineStartDate:   task.BaselineStartDate,
+			BaselineEndDate:     task.BaselineEndDate,
+			BaselineDuration:    task.BaselineDuration,
+			Duration:            task.Duration,
+			OptimisticDuration:  task.OptimisticDuration,
+			MostLikelyDuration:  task.MostLikelyDuration,
+			PessimisticDuration: task.PessimisticDuration,
+			Progress:            task.Progress,
+			Status:              task.Status,
+			Cost:                task.Cost,
+			Predecessor:         task.Predecessor,
+			OutlineNumber:       task.OutlineNumber,
+			Assignments:         task.Assignments,
+			TaskColor:           task.TaskColor,
+			WebLink:             task.WebLink,
+			IsSubTask:           task.IsSubTask,
+			IsMilestone:         task.IsMilestone,
+			ProjectUUID:         scenarioProjectUUID,
+			Notes:               task.Notes,
+			ConstraintType:      task.ConstraintType,
+			ConstraintDate:      task.ConstraintDate,
+			Deadline:            task.Deadline,
+			Priority:            taskBase[i].Priority,
+			SourceTaskUUID:      util.PointerString(task.TaskUUID),
+			CreatedBy:           createdBy,
+		}
+		if taskBase[i].Segment != nil {
+			create.Segment = *taskBase[i].Segment
+		}
+		if taskBase[i].Indicator != nil {
+			create.Indicator = *taskBase[i].Indicator
+		}
+		if taskBase[i].RecurrenceRule != nil {
+			create.RecurrenceRule = *taskBase[i].RecurrenceRule
+		}
+
+		scenarioTaskBase, err := m.TaskService.WithTrx(trx).Create(create)
+		if err != nil {
+			return err
+		}
+
+		var resources []*resourceModel.TaskSingle
+		for _, res := range task.Resources {
+			resources = append(resources, &resourceModel.TaskSingle{
+				ResourceUUID: res.ResourceUUID,
+				Unit:         res.Unit,
+			})
+		}
+
+		if len(resources) > 0 {
+			taskResMapList = append(taskResMapList, map[string][]*resourceModel.TaskSingle{
+				*scenarioTaskBase.TaskUUID: resources,
+			})
+		}
+	}
+
+	// sync create task_resource
+	if len(taskResMapList) > 0 {
+		err = m.TaskManager.SyncCreateTaskResources(trx, taskResMapList, createdBy, scenarioProjectUUID, proResMap)
+		if err != nil {
+			return err
+		}
+	}
+
+	// sync task_dependencies
+	return m.TaskManager.SyncTaskDependencies(trx, util.PointerString(scenarioProjectUUID), createdBy)
+}
+
+// merge is a helper function to replace the schedule of the project with the schedule of its scenario and remove the tasks of the scenario:
+// the tasks copied from the project are updated, the tasks added in the scenario are created and the tasks deleted in the
+// scenario are deleted. The tasks added to the project after the branch are kept after the tasks of the scenario.
+// The schedule goes through the validation of UpdateAll, which commits the transaction.
+func (m *manager) merge(trx *gorm.DB, input *taskModel.Merge) (int, any) {
+	defer trx.Rollback()
+
+	reason, err := m.TaskManager.CheckEditable(trx, input.ProjectUUID, input.Role, input.ResUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if reason != "" {
+		log.Info(reason)
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
+	}
+
+	// get tasks for the scenario in outline order
+	scenarioTaskBase, err := m.TaskService.WithTrx(trx).GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: util.PointerString(input.ScenarioProjectUUID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	var scenarioTasks []*taskModel.Single
+	taskByte, err := sonic.Marshal(scenarioTaskBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(taskByte, &scenarioTasks)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// get tasks for the project in outline order
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: util.PointerString(input.ProjectUUID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	var tasks []*taskModel.Single
+	taskByte, err = sonic.Marshal(taskBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(taskByte, &tasks)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// map the tasks of the scenario to the tasks of the project which they are copied from
+	taskUUIDMap := make(map[string]string)
+	keptMap := make(map[string]bool)
+	projectTaskMap := make(map[string]bool)
+	for _, task := range taskBase {
+		projectTaskMap[*task.TaskUUID] = true
+	}
+
+	// the tasks added to the project after the branch have no copies in the scenario and are kept
+	addedMap := make(map[string]bool)
+	for _, task := range tasks {
+		if isAddedAfterBranch(task, input.BranchedAt) {
+			addedMap[task.TaskUUID] = true
+		}
+	}
+
+	for _, task := range scenarioTasks {
+		if task.SourceTaskUUID != nil && projectTaskMap[*task.SourceTaskUUID] && !keptMap[*task.SourceTaskUUID] {
+			taskUUIDMap[task.TaskUUID] = *task.SourceTaskUUID
+			keptMap[*task.SourceTaskUUID] = true
+		}
+	}
+
+	// delete the tasks of the project which are deleted in the scenario
+	var removedUUIDs []*string
+	for _, task := range taskBase {
+		if !keptMap[*task.TaskUUID] && !addedMap[*task.TaskUUID] {
+			removedUUIDs = append(removedUUIDs, task.TaskUUID)
+		}
+	}
+
+	if len(removedUUIDs) > 0 {
+		err = m.TaskManager.SyncDeleteTaskDependencies(trx, util.PointerString(input.ProjectUUID), removedUUIDs, input.UpdatedBy)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		err = m.TaskService.WithTrx(trx).Delete(&taskModel.Field{
+			DeletedTaskUUIDs: removedUUIDs,
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		err = m.TaskManager.SyncDeleteTaskResources(trx, nil, removedUUIDs, true)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	// create the tasks added in the scenario, their fields are filled in by the update below
+	for _, task := range scenarioTasks {
+		if _, ok := taskUUIDMap[task.TaskUUID]; ok {
+			continue
+		}
+
+		createdBase, err := m.TaskService.WithTrx(trx).Create(&taskModel.Create{
+			TaskID:        task.TaskID,
+			TaskName:      task.TaskName,
+			OutlineNumber: task.OutlineNumber,
+			IsSubTask:     task.IsSubTask,
+			ProjectUUID:   input.ProjectUUID,
+			CreatedBy:     input.UpdatedBy,
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		taskUUIDMap[task.TaskUUID] = *createdBase.TaskUUID
+	}
+
+	// delete the tasks of the scenario
+	if len(scenarioTaskBase) > 0 {
+		var scenarioTaskUUIDs []*string
+		for _, task := range scenarioTaskBase {
+			scenarioTaskUUIDs = append(scenarioTaskUUIDs, task.TaskUUID)
+		}
+
+		err = m.TaskManager.SyncDeleteTaskDependencies(trx, util.PointerString(input.ScenarioProjectUUID), scenarioTaskUUIDs, input.UpdatedBy)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		err = m.TaskService.WithTrx(trx).Delete(&taskModel.Field{
+			DeletedTaskUUIDs: scenarioTaskUUIDs,
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		err = m.TaskManager.SyncDeleteTaskResources(trx, nil, scenarioTaskUUIDs, true)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	// assemble the tree of the tasks of the scenario with the tasks of the project
+	var (
+		tree          []*taskModel.Update
+		updateMap     = make(map[string]*taskModel.Update)
+		taskUpdateMap = make(map[string]*taskModel.Update)
+	)
+	for i, task := range scenarioTasks {
+		update, err := assembleMergedTask(task, scenarioTaskBase[i], taskUUIDMap[task.TaskUUID], input)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		updateMap[task.OutlineNumber] = update
+		taskUpdateMap[update.TaskUUID] = update
+		parent := updateMap[taskManager.GetParentOutlineNumber(task.OutlineNumber)]
+		if task.IsSubTask && parent != nil {
+			parent.Subtask = append(parent.Subtask, update)
+		} else {
+			tree = append(tree, update)
+		}
+	}
+
+	// the kept tasks stay under their parents when the parents are merged, otherwise they follow the tasks of the scenario
+	outlineMap := make(map[string]*taskModel.Single)
+	for _, task := range tasks {
+		outlineMap[task.OutlineNumber] = task
+	}
+
+	for i, task := range tasks {
+		if !addedMap[task.TaskUUID] {
+			continue
+		}
+
+		update, err := assembleMergedTask(task, taskBase[i], task.TaskUUID, input)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		taskUpdateMap[task.TaskUUID] = update
+		var parent *taskModel.Update
+		if parentTask := outlineMap[taskManager.GetParentOutlineNumber(task.OutlineNumber)]; task.IsSubTask && parentTask != nil {
+			parent = taskUpdateMap[parentTask.TaskUUID]
+		}
+
+		if parent != nil {
+			parent.Subtask = append(parent.Subtask, update)
+		} else {
+			tree = append(tree, update)
+		}
+	}
+
+	// the scenario without tasks leaves the project without tasks
+	if len(tree) == 0 {
+		err = m.TaskManager.SyncUpdateProjectStartEndDate(trx, util.PointerString(input.ProjectUUID), removedUUIDs, nil, nil)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		trx.Commit()
+		return code.Successful, code.GetCodeMessage(code.Successful, &taskModel.Result{
+			MovedTasks:      []*taskModel.MovedTask{},
+			Warnings:        []*taskModel.ResourceWarning{},
+			Overallocations: []*resourceModel.Overallocation{},
+			SummaryTasks:    []*taskModel.SummaryTask{},
+		})
+	}
+
+	return m.TaskManager.UpdateAll(trx, tree)
+}
+
+// isAddedAfterBranch is a helper function to report whether the task of the project is created after the scenario is branched at the time,
+// which has no copy in the scenario. All the tasks are branched when the time is nil.
+func isAddedAfterBranch(task *taskModel.Single, branchedAt *time.Time) bool {
+	return branchedAt != nil && task.CreatedAt != nil && task.CreatedAt.After(*branchedAt)
+}
+
+// assembleMergedTask is a helper function to transform the task of the scenario into the update of the task of the project.
+func assembleMergedTask(task *taskModel.Single, base *taskDB.Base, taskUUID string, input *taskModel.Merge) (*taskModel.Update, error) {
+	update := &taskModel.Update{
+		TaskUUID:            taskUUID,
+		TaskID:              util.PointerString(task.TaskID),
+		TaskName:            util.PointerString(task.TaskName),
+		StartDate:           task.StartDate,
+		EndDate:             task.EndDate,
+		BaselineStartDate:   task.BaselineStartDate,
+		BaselineEndDate:     task.BaselineEndDate,
+		BaselineDuration:    util.PointerFloat64(task.BaselineDuration),
+		Duration:            util.PointerFloat64(task.Duration),
+		OptimisticDuration:  task.OptimisticDuration,
+		MostLikelyDuration:  task.MostLikelyDuration,
+		PessimisticDuration: task.PessimisticDuration,
+		Progress:            util.PointerInt64(task.Progress),
+		Status:              util.PointerString(task.Status),
+		Cost:                util.PointerInt64(task.Cost),
+		Predecessor:         util.PointerString(task.Predecessor),
+		Assignments:         util.PointerString(task.Assignments),
+		TaskColor:           util.PointerString(task.TaskColor),
+		WebLink:             util.PointerString(task.WebLink),
+		IsMilestone:         util.PointerBool(task.IsMilestone),
+		ProjectUUID:         util.PointerString(input.ProjectUUID),
+		Notes:               util.PointerString(task.Notes),
+		ConstraintType:      util.PointerString(task.ConstraintType),
+		ConstraintDate:      task.ConstraintDate,
+		Deadline:            task.Deadline,
+		Priority:            base.Priority,
+		RecurrenceRule:      base.RecurrenceRule,
+		UpdatedBy:           util.PointerString(input.UpdatedBy),
+		ResUUID:             input.ResUUID,
+		Role:                input.Role,
+	}
+
+	// the estimate removed in the scenario is removed from the project
+	if update.OptimisticDuration == nil || update.MostLikelyDuration == nil || update.PessimisticDuration == nil {
+		update.OptimisticDuration, update.MostLikelyDuration, update.PessimisticDuration = util.PointerFloat64(0), util.PointerFloat64(0), util.PointerFloat64(0)
+	}
+
+	// the deadline removed in the scenario is removed from the project
+	if update.Deadline == nil {
+		update.Deadline = util.PointerTime(time.Time{})
+	}
+
+	if base.Segment != nil && *base.Segment != "" {
+		err := sonic.Unmarshal([]byte(*base.Segment), &update.Segments)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if base.Indicator != nil && *base.Indicator != "" {
+		err := sonic.Unmarshal([]byte(*base.Indicator), &update.Indicators)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, res := range task.Resources {
+		update.Resources = append(update.Resources, &resourceModel.TaskSingle{
+			ResourceUUID: res.ResourceUUID,
+			Unit:         res.Unit,
+		})
+	}
+
+	return update, nil
+}
@@ -0,0 +1,594 @@
+package project_template
+
+import (
+	"errors"
+	"gantt/internal/interactor/pkg/util"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"gorm.io/gorm"
+
+	projectTemplateDB "gantt/internal/entity/postgresql/db/project_templates"
+	taskManager "gantt/internal/interactor/manager/task"
+	eventMarkModel "gantt/internal/interactor/models/event_marks"
+	projectResourceModel "gantt/internal/interactor/models/project_resources"
+	projectTemplateModel "gantt/internal/interactor/models/project_templates"
+	projectTypeModel "gantt/internal/interactor/models/project_types"
+	projectModel "gantt/internal/interactor/models/projects"
+	resourceModel "gantt/internal/interactor/models/resources"
+	taskModel "gantt/internal/interactor/models/tasks"
+	eventMarkService "gantt/internal/interactor/service/event_mark"
+	projectService "gantt/internal/interactor/service/project"
+	projectResourceService "gantt/internal/interactor/service/project_resource"
+	projectTemplateService "gantt/internal/interactor/service/project_template"
+	projectTypeService "gantt/internal/interactor/service/project_type"
+	taskService "gantt/internal/interactor/service/task"
+
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+)
+
+type Manager interface {
+	Create(trx *gorm.DB, input *projectTemplateModel.Create) (int, any)
+	GetByList(input *projectTemplateModel.Fields) (int, any)
+	GetByListNoPagination(input *projectTemplateModel.Field) (int, any)
+	GetBySingle(input *projectTemplateModel.Field) (int, any)
+	Delete(trx *gorm.DB, input *projectTemplateModel.Field) (int, any)
+	Update(trx *gorm.DB, input *projectTemplateModel.Update) (int, any)
+	GetTemplate(id string) (*projectTemplateModel.Single, error)
+	Snapshot(trx *gorm.DB, projectUUID string) (*projectTemplateModel.Content, error)
+	Instantiate(trx *gorm.DB, projectUUID string, calendarUUID *string, startDate time.Time, content *projectTemplateModel.Content, createdBy string) error
+}
+
+type manager struct {
+	ProjectTemplateService projectTemplateService.Service
+	ProjectService         projectService.Service
+	ProjectTypeService     projectTypeService.Service
+	ProjectResourceService projectResourceService.Service
+	TaskService            taskService.Service
+	EventMarkService       eventMarkService.Service
+	TaskManager            taskManager.Manager
+}
+
+func Init(db *gorm.DB) Manager {
+	return &manager{
+		ProjectTemplateService: projectTemplateService.Init(db),
+		ProjectService:         projectService.Init(db),
+		ProjectTypeService:     projectTypeService.Init(db),
+		ProjectResourceService: projectResourceService.Init(db),
+		TaskService:            taskService.Init(db),
+		EventMarkService:       eventMarkService.Init(db),
+		TaskManager:            taskManager.Init(db),
+	}
+}
+
+func (m *manager) Create(trx *gorm.DB, input *projectTemplateModel.Create) (int, any) {
+	defer trx.Rollback()
+
+	// check if the project exists
+	_, err := m.ProjectService.GetBySingle(&projectModel.Field{
+		ProjectUUID: input.ProjectUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check if the project type exists
+	if input.TypeID != nil {
+		_, err = m.ProjectTypeService.GetBySingle(&projectTypeModel.Field{
+			ID: *input.TypeID,
+		})
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	// keep the tasks, resources and event marks of the project
+	content, err := m.Snapshot(trx, input.ProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	taskJson, _ := sonic.Marshal(content.Tasks)
+	resourceJson, _ := sonic.Marshal(content.Resources)
+	eventMarkJson, _ := sonic.Marshal(content.EventMarks)
+	input.StartDate = content.StartDate
+	input.Tasks = string(taskJson)
+	input.Resources = string(resourceJson)
+	input.EventMarks = string(eventMarkJson)
+
+	templateBase, err := m.ProjectTemplateService.WithTrx(trx).Create(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, templateBase.ID)
+}
+
+func (m *manager) GetByList(input *projectTemplateModel.Fields) (int, any) {
+	output := &projectTemplateModel.List{}
+	output.Limit = input.Limit
+	output.Page = input.Page
+	quantity, templateBase, err := m.ProjectTemplateService.GetByList(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	output.Total.Total = quantity
+	output.Pages = util.Pagination(quantity, output.Limit)
+	templateByte, err := sonic.Marshal(templateBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(templateByte, &output.ProjectTemplates)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, template := range output.ProjectTemplates {
+		if templateBase[i].ProjectTypes.Name != nil {
+			template.TypeName = *templateBase[i].ProjectTypes.Name
+		}
+		template.CreatedBy = *templateBase[i].CreatedByUsers.Name
+		template.UpdatedBy = *templateBase[i].UpdatedByUsers.Name
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetByListNoPagination(input *projectTemplateModel.Field) (int, any) {
+	output := &projectTemplateModel.List{}
+	templateBase, err := m.ProjectTemplateService.GetByListNoPagination(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	templateByte, err := sonic.Marshal(templateBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(templateByte, &output.ProjectTemplates)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, template := range output.ProjectTemplates {
+		if templateBase[i].ProjectTypes.Name != nil {
+			template.TypeName = *templateBase[i].ProjectTypes.Name
+		}
+		template.CreatedBy = *templateBase[i].CreatedByUsers.Name
+		template.UpdatedBy = *templateBase[i].UpdatedByUsers.Name
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetBySingle(input *projectTemplateModel.Field) (int, any) {
+	output, err := m.GetTemplate(input.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) Delete(trx *gorm.DB, input *projectTemplateModel.Field) (int, any) {
+	defer trx.Rollback()
+
+	_, err := m.ProjectTemplateService.GetBySingle(&projectTemplateModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = m.ProjectTemplateService.WithTrx(trx).Delete(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, "Delete ok!")
+}
+
+func (m *manager) Update(trx *gorm.DB, input *projectTemplateModel.Update) (int, any) {
+	defer trx.Rollback()
+
+	templateBase, err := m.ProjectTemplateService.GetBySingle(&projectTemplateModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check if the project type exists
+	if input.TypeID != nil {
+		_, err = m.ProjectTypeService.GetBySingle(&projectTypeModel.Field{
+			ID: *input.TypeID,
+		})
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	err = m.ProjectTemplateService.WithTrx(trx).Update(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, templateBase.ID)
+}
+
+// GetTemplate gets the template with its tasks, resources and event marks.
+func (m *manager) GetTemplate(id string) (*projectTemplateModel.Single, error) {
+	templateBase, err := m.ProjectTemplateService.GetBySingle(&projectTemplateModel.Field{
+		ID: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &projectTemplateModel.Single{
+		ID:          *templateBase.ID,
+		Name:        *templateBase.Name,
+		Description: *templateBase.Description,
+	}
+	if templateBase.TypeID != nil {
+		output.TypeID = *templateBase.TypeID
+	}
+	output.StartDate = templateBase.StartDate
+	output.CreatedAt = templateBase.CreatedAt
+	output.UpdatedAt = templateBase.UpdatedAt
+
+	// the content is kept in the strings of the arrays
+	err = assembleContent(templateBase, &output.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	if templateBase.ProjectTypes.Name != nil {
+		output.TypeName = *templateBase.ProjectTypes.Name
+	}
+	output.CreatedBy = *templateBase.CreatedByUsers.Name
+	output.UpdatedBy = *templateBase.UpdatedByUsers.Name
+	return output, nil
+}
+
+// assembleContent is a helper function to parse the tasks, resources and event marks kept in the template.
+func assembleContent(templateBase *projectTemplateDB.Base, content *projectTemplateModel.Content) error {
+	content.Tasks = []*projectTemplateModel.Task{}
+	content.Resources = []*projectTemplateModel.Resource{}
+	content.EventMarks = []*projectTemplateModel.EventMark{}
+	if templateBase.Tasks != nil && *templateBase.Tasks != "" {
+		err := sonic.Unmarshal([]byte(*templateBase.Tasks), &content.Tasks)
+		if err != nil {
+			return err
+		}
+	}
+
+	if templateBase.Resources != nil && *templateBase.Resources != "" {
+		err := sonic.Unmarshal([]byte(*templateBase.Resources), &content.Resources)
+		if err != nil {
+			return err
+		}
+	}
+
+	if templateBase.EventMarks != nil && *templateBase.EventMarks != "" {
+		err := sonic.Unmarshal([]byte(*templateBase.EventMarks), &content.EventMarks)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Snapshot gets the tasks, resources and event marks of the project to keep them in a template or to clone them.
+func (m *manager) Snapshot(trx *gorm.DB, projectUUID string) (*projectTemplateModel.Content, error) {
+	projectBase, err := m.ProjectService.WithTrx(trx).GetBySingle(&projectModel.Field{
+		ProjectUUID: projectUUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &projectTemplateModel.Content{
+		StartDate: projectBase.StartDate,
+	}
+
+	// get tasks for the project in outline order
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: util.PointerString(projectUUID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	taskByte, err := sonic.Marshal(taskBase)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sonic.Unmarshal(taskByte, &output.Tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	// the dates are shifted from the earliest task when the project has no start date
+	if output.StartDate == nil {
+		for _, task := range output.Tasks {
+			if task.StartDate != nil && (output.StartDate == nil || task.StartDate.Before(*output.StartDate)) {
+				output.StartDate = task.StartDate
+			}
+		}
+	}
+
+	proResBase, err := m.ProjectResourceService.WithTrx(trx).GetByListNoPagination(&projectResourceModel.Field{
+		ProjectUUID: util.PointerString(projectUUID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proResByte, err := sonic.Marshal(proResBase)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sonic.Unmarshal(proResByte, &output.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	eventMarkBase, err := m.EventMarkService.WithTrx(trx).GetByListNoPagination(&eventMarkModel.Field{
+		ProjectUUID: util.PointerString(projectUUID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eventMarkByte, err := sonic.Marshal(eventMarkBase)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sonic.Unmarshal(eventMarkByte, &output.EventMarks)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// Instantiate creates the tasks, resources and event marks of the template in the project, shifting their dates
+// from the start date of the template to the start date of the project in working days of the project's calendar.
+func (m *manager) Instantiate(trx *gorm.DB, projectUUID string, calendarUUID *string, startDate time.Time, content *projectTemplateModel.Content, createdBy string) error {
+	// the project is not committed yet, so its calendar is got by its UUID
+	calendar, err := m.TaskManager.GetCalendar(calendarUUID)
+	if err != nil {
+		return err
+	}
+
+	// shift is a helper function to move the date from the start date of the template to the start date of the project.
+	shift := func(date *time.Time) *time.Time {
+		if date == nil || content.StartDate == nil {
+			return date
+		}
+
+		return util.PointerTime(calendar.Shift(*date, *content.StartDate, startDate))
+	}
+
+	// sync create project_resource, skipping the members already in the project
+	proResBase, err := m.ProjectResourceService.WithTrx(trx).GetByListNoPagination(&projectResourceModel.Field{
+		ProjectUUID: util.PointerString(projectUUID),
+	})
+	if err != nil {
+		return err
+	}
+
+	proResMap := make(map[string]*projectResourceModel.Single)
+	for _, res := range proResBase {
+		proResMap[*res.ResourceUUID] = &projectResourceModel.Single{
+			ResourceUUID: *res.ResourceUUID,
+		}
+	}
+
+	var proResList []*projectResourceModel.Create
+	for _, res := range content.Resources {
+		if proResMap[res.ResourceUUID] != nil {
+			continue
+		}
+
+		proResList = append(proResList, &projectResourceModel.Create{
+			ProjectUUID:  projectUUID,
+			ResourceUUID: res.ResourceUUID,
+			Role:         res.Role,
+			IsEditable:   res.IsEditable == nil || *res.IsEditable,
+			CreatedBy:    createdBy,
+		})
+		proResMap[res.ResourceUUID] = &projectResourceModel.Single{
+			ResourceUUID: res.ResourceUUID,
+		}
+	}
+
+	if len(proResList) > 0 {
+		_, err = m.ProjectResourceService.WithTrx(trx).CreateAll(proResList)
+		if err != nil {
+			return err
+		}
+	}
+
+	// create the tasks without their progress
+	var (
+		minBaselineStart, maxBaselineEnd *time.Time
+		taskResMapList                   []map[string][]*resourceModel.TaskSingle
+	)
+	for _, task := range content.Tasks {
+		create := &taskModel.Create{
+			TaskID:              task.TaskID,
+			TaskName:            task.TaskName,
+			StartDate:           shift(task.StartDate),
+			EndDate:             shift(task.EndDate),
+			BaselineStartDate:   shift(task.BaselineStartDate),
+			BaselineEndDate:     shift(task.BaselineEndDate),
+			BaselineDuration:    task.BaselineDuration,
+			Duration:            task.Duration,
+			OptimisticDuration:  task.OptimisticDuration,
+			MostLikelyDuration:  task.MostLikelyDuration,
+			PessimisticDuration: task.PessimisticDuration,
+			Cost:                task.Cost,
+			Predecessor:         task.Predecessor,
+			OutlineNumber:       task.OutlineNumber,
+			Assignments:         task.Assignments,
+			TaskColor:           task.TaskColor,
+			WebLink:             task.WebLink,
+			IsSubTask:           task.IsSubTask,
+			IsMilestone:         task.IsMilestone,
+			ProjectUUID:         projectUUID,
+			Segment:             task.Segment,
+			Indicator:           task.Indicator,
+			Notes:               task.Notes,
+			ConstraintType:      task.ConstraintType,
+			ConstraintDate:      shift(task.ConstraintDate),
+			Deadline:            shift(task.Deadline),
+			Priority:            task.Priority,
+			CreatedBy:           createdBy,
+		}
+
+		// shift the dates of the segments and indicators
+		if task.Segment != "" {
+			var segments []*taskModel.Segments
+			err = sonic.Unmarshal([]byte(task.Segment), &segments)
+			if err != nil {
+				return err
+			}
+
+			for _, segment := range segments {
+				if !segment.StartDate.IsZero() {
+					segment.StartDate = *shift(&segment.StartDate)
+				}
+			}
+
+			segJson, _ := sonic.Marshal(segments)
+			create.Segment = string(segJson)
+		}
+
+		if task.Indicator != "" {
+			var indicators []*taskModel.Indicators
+			err = sonic.Unmarshal([]byte(task.Indicator), &indicators)
+			if err != nil {
+				return err
+			}
+
+			for _, indicator := range indicators {
+				if !indicator.Date.IsZero() {
+					indicator.Date = *shift(&indicator.Date)
+				}
+			}
+
+			indJson, _ := sonic.Marshal(indicators)
+			create.Indicator = string(indJson)
+		}
+
+		taskBase, err := m.TaskService.WithTrx(trx).Create(create)
+		if err != nil {
+			return err
+		}
+
+		if create.BaselineStartDate != nil && create.BaselineEndDate != nil {
+			if minBaselineStart == nil || create.BaselineStartDate.Before(*minBaselineStart) {
+				minBaselineStart = create.BaselineStartDate
+			}
+			if maxBaselineEnd == nil || create.BaselineEndDate.After(*maxBaselineEnd) {
+				maxBaselineEnd = create.BaselineEndDate
+			}
+		}
+
+		var resources []*resourceModel.TaskSingle
+		for _, res := range task.Resources {
+			resources = append(resources, &resourceModel.TaskSingle{
+				ResourceUUID: res.ResourceUUID,
+				Unit:         res.Unit,
+			})
+		}
+
+		if len(resources) > 0 {
+			taskResMapList = append(taskResMapList, map[string][]*resourceModel.TaskSingle{
+				*taskBase.TaskUUID: resources,
+			})
+		}
+	}
+
+	// sync create task_resource
+	if len(taskResMapList) > 0 {
+		err = m.TaskManager.SyncCreateTaskResources(trx, taskResMapList, createdBy, projectUUID, proResMap)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, eventMark := range content.EventMarks {
+		_, err = m.EventMarkService.WithTrx(trx).Create(&eventMarkModel.Create{
+			Name:        eventMark.Name,
+			Day:         shift(eventMark.Day),
+			ProjectUUID: projectUUID,
+			CreatedBy:   createdBy,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// sync update project's start and end dates
+	err = m.TaskManager.SyncUpdateProjectStartEndDate(trx, util.PointerString(projectUUID), nil, minBaselineStart, maxBaselineEnd)
+	if err != nil {
+		return err
+	}
+
+	// sync task_dependencies
+	return m.TaskManager.SyncTaskDependencies(trx, util.PointerString(projectUUID), createdBy)
+}
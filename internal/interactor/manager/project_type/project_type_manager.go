@@ -2,6 +2,7 @@ package project_type
 
 import (
 	"errors"
+	"gantt/internal/interactor/pkg/schedule"
 	"gantt/internal/interactor/pkg/util"
 
 	"github.com/bytedance/sonic"
@@ -34,9 +35,51 @@ func Init(db *gorm.DB) Manager {
 	}
 }
 
+// assembleTransitions is a helper function to check the transitions of the task statuses and transform them to the JSON string,
+// which is empty for the default transitions.
+func assembleTransitions(transitions map[string][]string) (string, error) {
+	if len(transitions) == 0 {
+		return "", nil
+	}
+
+	_, err := schedule.NewTransitions(transitions)
+	if err != nil {
+		return "", err
+	}
+
+	transitionsJson, err := sonic.Marshal(transitions)
+	if err != nil {
+		return "", err
+	}
+
+	return string(transitionsJson), nil
+}
+
+// decodeTransitions is a helper function to transform the JSON string to the transitions of the task statuses,
+// which are the default ones when the project type has none.
+func decodeTransitions(statusTransitions *string) (map[string][]string, error) {
+	var transitions map[string][]string
+	if statusTransitions != nil {
+		err := util.DecodeJSONToSlice(*statusTransitions, &transitions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return schedule.NewTransitions(transitions)
+}
+
 func (m *manager) Create(trx *gorm.DB, input *projectTypeModel.Create) (int, any) {
 	defer trx.Rollback()
 
+	// transform the transitions from map to string
+	statusTransitions, err := assembleTransitions(input.Transitions)
+	if err != nil {
+		log.Info(err.Error())
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+	}
+	input.StatusTransitions = statusTransitions
+
 	projectTypeBase, err := m.ProjectTypeService.WithTrx(trx).Create(input)
 	if err != nil {
 		log.Error(err)
@@ -70,6 +113,15 @@ func (m *manager) GetByList(input *projectTypeModel.Fields) (int, any) {
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
+	// transform the transitions to map
+	for i, projectType := range output.ProjectTypes {
+		projectType.Transitions, err = decodeTransitions(projectTypeBase[i].StatusTransitions)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
 	return code.Successful, code.GetCodeMessage(code.Successful, output)
 }
 
@@ -93,6 +145,15 @@ func (m *manager) GetByListNoPagination(input *projectTypeModel.Field) (int, any
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
+	// transform the transitions to map
+	for i, projectType := range output.ProjectTypes {
+		projectType.Transitions, err = decodeTransitions(projectTypeBase[i].StatusTransitions)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
 	return code.Successful, code.GetCodeMessage(code.Successful, output)
 }
 
@@ -115,6 +176,13 @@ func (m *manager) GetBySingle(input *projectTypeModel.Field) (int, any) {
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
+	// transform the transitions to map
+	output.Transitions, err = decodeTransitions(projectTypeBase.StatusTransitions)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
 	return code.Successful, code.GetCodeMessage(code.Successful, output)
 }
 
@@ -153,6 +221,16 @@ func (m *manager) Update(input *projectTypeModel.Update) (int, any) {
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
+	// transform the transitions from map to string, an empty map resets them to the default ones
+	if input.Transitions != nil {
+		statusTransitions, err := assembleTransitions(input.Transitions)
+		if err != nil {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+		input.StatusTransitions = util.PointerString(statusTransitions)
+	}
+
 	err = m.ProjectTypeService.Update(input)
 	if err != nil {
 		log.Error(err)
@@ -2,6 +2,8 @@ package resource
 
 import (
 	"errors"
+	resourceDB "gantt/internal/entity/postgresql/db/resources"
+	taskManager "gantt/internal/interactor/manager/task"
 	userModel "gantt/internal/interactor/models/users"
 	"gantt/internal/interactor/pkg/util"
 	userService "gantt/internal/interactor/service/user"
@@ -26,17 +28,20 @@ type Manager interface {
 	Delete(input *resourceModel.Update) (int, any)
 	Update(input *resourceModel.Update) (int, any)
 	Import(trx *gorm.DB, input *resourceModel.Import) (int, any)
+	GetByLoad(input *resourceModel.Load) (int, any)
 }
 
 type manager struct {
 	ResourceService resourceService.Service
 	UserService     userService.Service
+	TaskManager     taskManager.Manager
 }
 
 func Init(db *gorm.DB) Manager {
 	return &manager{
 		ResourceService: resourceService.Init(db),
 		UserService:     userService.Init(db),
+		TaskManager:     taskManager.Init(db),
 	}
 }
 
@@ -108,9 +113,18 @@ func (m *manager) GetByList(input *resourceModel.Fields) (int, any) {
 			userMap[*user.ResourceUUID] = true
 		}
 	}
+
+	// get the resources assigned beyond their capacity
+	overallocatedMap, err := m.getOverallocatedMap(resourceBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
 	for i, resource := range output.Resources {
 		resource.CreatedBy = *resourceBase[i].CreatedByUsers.Name
 		resource.UpdatedBy = *resourceBase[i].UpdatedByUsers.Name
+		resource.IsOverallocated = overallocatedMap[*resourceBase[i].ResourceUUID]
 
 		// transform resource group from string to string slice
 		var resourceGroup []string
@@ -173,9 +187,17 @@ func (m *manager) GetByListNoPagination(input *resourceModel.Field) (int, any) {
 		}
 	}
 
+	// get the resources assigned beyond their capacity
+	overallocatedMap, err := m.getOverallocatedMap(resourceBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
 	for i, resource := range output.Resources {
 		resource.CreatedBy = *resourceBase[i].CreatedByUsers.Name
 		resource.UpdatedBy = *resourceBase[i].UpdatedByUsers.Name
+		resource.IsOverallocated = overallocatedMap[*resourceBase[i].ResourceUUID]
 
 		// transform resource group from string to string slice
 		var resourceGroup []string
@@ -243,6 +265,15 @@ func (m *manager) GetBySingle(input *resourceModel.Field) (int, any) {
 	}
 	output.ResourceGroups = resourceGroup
 
+	// get the periods in which the resource is assigned beyond its capacity
+	overallocations, err := m.TaskManager.GetOverallocations(nil, []*string{resourceBase.ResourceUUID})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	output.Overallocations = overallocations
+	output.IsOverallocated = len(overallocations) > 0
+
 	return code.Successful, code.GetCodeMessage(code.Successful, output)
 }
 
@@ -406,3 +437,27 @@ func (m *manager) Import(trx *gorm.DB, input *resourceModel.Import) (int, any) {
 	trx.Commit()
 	return code.Successful, code.GetCodeMessage(code.Successful, "Successful import!")
 }
+
+func (m *manager) GetByLoad(input *resourceModel.Load) (int, any) {
+	return m.TaskManager.GetByResourceLoad(input)
+}
+
+// getOverallocatedMap is a helper function to find the resources which are assigned beyond their capacity.
+func (m *manager) getOverallocatedMap(resourceBase []*resourceDB.Base) (map[string]bool, error) {
+	var resourceUUIDs []*string
+	for _, res := range resourceBase {
+		resourceUUIDs = append(resourceUUIDs, res.ResourceUUID)
+	}
+
+	overallocations, err := m.TaskManager.GetOverallocations(nil, resourceUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	overallocatedMap := make(map[string]bool)
+	for _, overallocation := range overallocations {
+		overallocatedMap[overallocation.ResourceUUID] = true
+	}
+
+	return overallocatedMap, nil
+}
@@ -0,0 +1,253 @@
+package resource_exception
+
+import (
+	"errors"
+	"gantt/internal/interactor/pkg/util"
+
+	"github.com/bytedance/sonic"
+
+	"gorm.io/gorm"
+
+	resourceExceptionModel "gantt/internal/interactor/models/resource_exceptions"
+	resourceModel "gantt/internal/interactor/models/resources"
+	resourceService "gantt/internal/interactor/service/resource"
+	resourceExceptionService "gantt/internal/interactor/service/resource_exception"
+
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+)
+
+type Manager interface {
+	Create(trx *gorm.DB, input *resourceExceptionModel.Create) (int, any)
+	GetByList(input *resourceExceptionModel.Fields) (int, any)
+	GetByListNoPagination(input *resourceExceptionModel.Field) (int, any)
+	GetBySingle(input *resourceExceptionModel.Field) (int, any)
+	Delete(input *resourceExceptionModel.Field) (int, any)
+	Update(input *resourceExceptionModel.Update) (int, any)
+}
+
+type manager struct {
+	ResourceExceptionService resourceExceptionService.Service
+	ResourceService          resourceService.Service
+}
+
+func Init(db *gorm.DB) Manager {
+	return &manager{
+		ResourceExceptionService: resourceExceptionService.Init(db),
+		ResourceService:          resourceService.Init(db),
+	}
+}
+
+func (m *manager) Create(trx *gorm.DB, input *resourceExceptionModel.Create) (int, any) {
+	defer trx.Rollback()
+
+	// check if the resource exists
+	_, err := m.ResourceService.GetBySingle(&resourceModel.Field{
+		ResourceUUID: input.ResourceUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if input.EndDate.Before(*input.StartDate) {
+		log.Info("The end date cannot be earlier than the start date.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The end date cannot be earlier than the start date.")
+	}
+
+	// transform workWeek from struct array to string
+	if len(input.WorkWeeks) > 0 {
+		weekJson, _ := sonic.Marshal(input.WorkWeeks)
+		input.WorkWeek = string(weekJson)
+	}
+
+	resourceExceptionBase, err := m.ResourceExceptionService.WithTrx(trx).Create(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, resourceExceptionBase.ID)
+}
+
+func (m *manager) GetByList(input *resourceExceptionModel.Fields) (int, any) {
+	output := &resourceExceptionModel.List{}
+	output.Limit = input.Limit
+	output.Page = input.Page
+	quantity, resourceExceptionBase, err := m.ResourceExceptionService.GetByList(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	output.Total.Total = quantity
+	output.Pages = util.Pagination(quantity, output.Limit)
+	resourceExceptionByte, err := sonic.Marshal(resourceExceptionBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(resourceExceptionByte, &output.ResourceExceptions)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, exception := range output.ResourceExceptions {
+		exception.CreatedBy = *resourceExceptionBase[i].CreatedByUsers.Name
+		exception.UpdatedBy = *resourceExceptionBase[i].UpdatedByUsers.Name
+
+		// transform workWeek to array
+		var workWeeks []string
+		err = util.DecodeJSONToSlice(*resourceExceptionBase[i].WorkWeek, &workWeeks)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+		exception.WorkWeeks = workWeeks
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetByListNoPagination(input *resourceExceptionModel.Field) (int, any) {
+	output := &resourceExceptionModel.List{}
+	resourceExceptionBase, err := m.ResourceExceptionService.GetByListNoPagination(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	resourceExceptionByte, err := sonic.Marshal(resourceExceptionBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(resourceExceptionByte, &output.ResourceExceptions)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, exception := range output.ResourceExceptions {
+		exception.CreatedBy = *resourceExceptionBase[i].CreatedByUsers.Name
+		exception.UpdatedBy = *resourceExceptionBase[i].UpdatedByUsers.Name
+
+		// transform workWeek to array
+		var workWeeks []string
+		err = util.DecodeJSONToSlice(*resourceExceptionBase[i].WorkWeek, &workWeeks)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+		exception.WorkWeeks = workWeeks
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetBySingle(input *resourceExceptionModel.Field) (int, any) {
+	resourceExceptionBase, err := m.ResourceExceptionService.GetBySingle(input)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output := &resourceExceptionModel.Single{}
+	resourceExceptionByte, _ := sonic.Marshal(resourceExceptionBase)
+	err = sonic.Unmarshal(resourceExceptionByte, &output)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output.CreatedBy = *resourceExceptionBase.CreatedByUsers.Name
+	output.UpdatedBy = *resourceExceptionBase.UpdatedByUsers.Name
+
+	// transform workWeek to array
+	var workWeeks []string
+	err = util.DecodeJSONToSlice(*resourceExceptionBase.WorkWeek, &workWeeks)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	output.WorkWeeks = workWeeks
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) Delete(input *resourceExceptionModel.Field) (int, any) {
+	_, err := m.ResourceExceptionService.GetBySingle(&resourceExceptionModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = m.ResourceExceptionService.Delete(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, "Delete ok!")
+}
+
+func (m *manager) Update(input *resourceExceptionModel.Update) (int, any) {
+	resourceExceptionBase, err := m.ResourceExceptionService.GetBySingle(&resourceExceptionModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check the period with the original dates
+	startDate, endDate := resourceExceptionBase.StartDate, resourceExceptionBase.EndDate
+	if input.StartDate != nil {
+		startDate = input.StartDate
+	}
+	if input.EndDate != nil {
+		endDate = input.EndDate
+	}
+	if startDate != nil && endDate != nil && endDate.Before(*startDate) {
+		log.Info("The end date cannot be earlier than the start date.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The end date cannot be earlier than the start date.")
+	}
+
+	// transform workWeek from struct array to string, an empty array turns the exception into a leave
+	if input.WorkWeeks != nil {
+		input.WorkWeek = util.PointerString("")
+		if len(input.WorkWeeks) > 0 {
+			weekJson, _ := sonic.Marshal(input.WorkWeeks)
+			input.WorkWeek = util.PointerString(string(weekJson))
+		}
+	}
+
+	err = m.ResourceExceptionService.Update(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, resourceExceptionBase.ID)
+}
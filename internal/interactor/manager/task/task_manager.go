@@ -3,18 +3,47 @@ package task
 import (
 	"errors"
 	"fmt"
-	resourceManager "gantt/internal/interactor/manager/resource"
+	calendarDB "gantt/internal/entity/postgresql/db/calendars"
+	holidayDB "gantt/internal/entity/postgresql/db/holidays"
+	baselineTaskDB "gantt/internal/entity/postgresql/db/project_baseline_tasks"
+	resourceDB "gantt/internal/entity/postgresql/db/resources"
+	taskDB "gantt/internal/entity/postgresql/db/tasks"
+	"gantt/internal/interactor/constants"
+	calendarModel "gantt/internal/interactor/models/calendars"
 	eventMarkModel "gantt/internal/interactor/models/event_marks"
+	holidayModel "gantt/internal/interactor/models/holidays"
+	projectBaselineModel "gantt/internal/interactor/models/project_baselines"
 	projectResourceModel "gantt/internal/interactor/models/project_resources"
+	projectScenarioModel "gantt/internal/interactor/models/project_scenarios"
 	projectModel "gantt/internal/interactor/models/projects"
+	resourceExceptionModel "gantt/internal/interactor/models/resource_exceptions"
 	resourceModel "gantt/internal/interactor/models/resources"
+	s3FileModel "gantt/internal/interactor/models/s3_files"
+	taskChecklistItemModel "gantt/internal/interactor/models/task_checklist_items"
+	taskDependencyModel "gantt/internal/interactor/models/task_dependencies"
 	taskResourceModel "gantt/internal/interactor/models/task_resources"
+	timesheetEntryModel "gantt/internal/interactor/models/timesheet_entries"
+	timesheetModel "gantt/internal/interactor/models/timesheets"
+	workDayModel "gantt/internal/interactor/models/work_days"
+	"gantt/internal/interactor/pkg/schedule"
 	"gantt/internal/interactor/pkg/util"
+	calendarService "gantt/internal/interactor/service/calendar"
 	eventMarkService "gantt/internal/interactor/service/event_mark"
+	holidayService "gantt/internal/interactor/service/holiday"
 	projectService "gantt/internal/interactor/service/project"
+	projectBaselineService "gantt/internal/interactor/service/project_baseline"
 	projectResourceService "gantt/internal/interactor/service/project_resource"
+	projectScenarioService "gantt/internal/interactor/service/project_scenario"
 	resourceService "gantt/internal/interactor/service/resource"
+	resourceExceptionService "gantt/internal/interactor/service/resource_exception"
+	s3FileService "gantt/internal/interactor/service/s3_file"
+	taskChecklistItemService "gantt/internal/interactor/service/task_checklist_item"
+	taskDependencyService "gantt/internal/interactor/service/task_dependency"
 	taskResourceService "gantt/internal/interactor/service/task_resource"
+	timesheetEntryService "gantt/internal/interactor/service/timesheet_entry"
+	workDayService "gantt/internal/interactor/service/work_day"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -40,27 +69,65 @@ type Manager interface {
 	Update(trx *gorm.DB, input *taskModel.Update) (int, any)
 	UpdateAll(trx *gorm.DB, input []*taskModel.Update) (int, any)
 	Import(trx *gorm.DB, input *taskModel.Import) (int, any)
+	GetByCriticalPath(input *taskModel.Field) (int, any)
+	GetByEarnedValue(input *taskModel.EarnedValueField) (int, any)
+	GetByForecast(input *taskModel.ForecastField) (int, any)
+	GetByResourceLoad(input *resourceModel.Load) (int, any)
+	GetOverallocations(projectUUID *string, resourceUUIDs []*string) ([]*resourceModel.Overallocation, error)
+	Level(trx *gorm.DB, input *taskModel.Level) (int, any)
+	RollUp(trx *gorm.DB, input *taskModel.RollUp) (int, any)
+	Move(trx *gorm.DB, input *taskModel.Move) (int, any)
+	Duplicate(trx *gorm.DB, input *taskModel.Duplicate) (int, any)
+	UpdateRecurrence(trx *gorm.DB, input *taskModel.UpdateRecurrence) (int, any)
+	GetWorkCalendar(projectUUID *string) (*schedule.WorkCalendar, error)
+	GetCalendar(calendarUUID *string) (*schedule.WorkCalendar, error)
+	SyncCreateTaskResources(trx *gorm.DB, taskResources []map[string][]*resourceModel.TaskSingle, createdBy string, projectID string, proResMap map[string]*projectResourceModel.Single) error
+	SyncUpdateProjectStartEndDate(trx *gorm.DB, projectID *string, TaskUUIDs []*string, start, end *time.Time) error
+	SyncTaskDependencies(trx *gorm.DB, projectUUID *string, createdBy string) error
+	SyncDeleteTaskDependencies(trx *gorm.DB, projectUUID *string, TaskUUIDs []*string, updatedBy string) error
+	SyncDeleteTaskResources(trx *gorm.DB, TaskUUID *string, TaskUUIDs []*string, isBatch bool) error
+	CheckEditable(trx *gorm.DB, projectUUID string, role, resUUID *string) (string, error)
+	SyncActualWork(trx *gorm.DB, taskUUIDs []*string, updatedBy string) error
+	SyncChecklistProgress(trx *gorm.DB, projectUUID *string, taskUUIDs []*string, updatedBy string) error
 }
 
 type manager struct {
-	TaskService            taskService.Service
-	ResourceService        resourceService.Service
-	ResourceManager        resourceManager.Manager
-	TaskResourceService    taskResourceService.Service
-	ProjectService         projectService.Service
-	ProjectResourceService projectResourceService.Service
-	EventMarkService       eventMarkService.Service
+	TaskService              taskService.Service
+	ResourceService          resourceService.Service
+	TaskResourceService      taskResourceService.Service
+	ProjectService           projectService.Service
+	ProjectResourceService   projectResourceService.Service
+	EventMarkService         eventMarkService.Service
+	TaskDependencyService    taskDependencyService.Service
+	WorkDayService           workDayService.Service
+	HolidayService           holidayService.Service
+	CalendarService          calendarService.Service
+	ResourceExceptionService resourceExceptionService.Service
+	ProjectBaselineService   projectBaselineService.Service
+	ProjectScenarioService   projectScenarioService.Service
+	S3FileService            s3FileService.Service
+	TimesheetEntryService    timesheetEntryService.Service
+	TaskChecklistItemService taskChecklistItemService.Service
 }
 
 func Init(db *gorm.DB) Manager {
 	return &manager{
-		TaskService:            taskService.Init(db),
-		ResourceService:        resourceService.Init(db),
-		TaskResourceService:    taskResourceService.Init(db),
-		ResourceManager:        resourceManager.Init(db),
-		ProjectService:         projectService.Init(db),
-		ProjectResourceService: projectResourceService.Init(db),
-		EventMarkService:       eventMarkService.Init(db),
+		TaskService:              taskService.Init(db),
+		ResourceService:          resourceService.Init(db),
+		TaskResourceService:      taskResourceService.Init(db),
+		ProjectService:           projectService.Init(db),
+		ProjectResourceService:   projectResourceService.Init(db),
+		EventMarkService:         eventMarkService.Init(db),
+		TaskDependencyService:    taskDependencyService.Init(db),
+		WorkDayService:           workDayService.Init(db),
+		HolidayService:           holidayService.Init(db),
+		CalendarService:          calendarService.Init(db),
+		ResourceExceptionService: resourceExceptionService.Init(db),
+		ProjectBaselineService:   projectBaselineService.Init(db),
+		ProjectScenarioService:   projectScenarioService.Init(db),
+		S3FileService:            s3FileService.Init(db),
+		TimesheetEntryService:    timesheetEntryService.Init(db),
+		TaskChecklistItemService: taskChecklistItemService.Init(db),
 	}
 }
 
@@ -218,6 +285,7 @@ func (m *manager) updateSubtasks(trx *gorm.DB, parentTask *taskModel.Update, sub
 			subBody.Segment = util.PointerString(string(segJson))
 		} else {
 			subBody.Segment = nil
+			subBody.ClearSegment = util.PointerBool(true)
 		}
 
 		// transform indicators from struct array to string
@@ -230,6 +298,7 @@ func (m *manager) updateSubtasks(trx *gorm.DB, parentTask *taskModel.Update, sub
 			subBody.Indicator = util.PointerString(string(indJson))
 		} else {
 			subBody.Indicator = nil
+			subBody.ClearIndicator = util.PointerBool(true)
 		}
 
 		// sync update task_resource
@@ -268,8 +337,8 @@ func (m *manager) updateSubtasks(trx *gorm.DB, parentTask *taskModel.Update, sub
 	return TaskUUIDs, updateList, taskResMapList, minBaselineStart, maxBaselineEnd, nil
 }
 
-// syncCreateTaskResources is a helper function to synchronize the creation of task_resource associations for tasks of a project.
-func (m *manager) syncCreateTaskResources(trx *gorm.DB, taskResources []map[string][]*resourceModel.TaskSingle, createdBy string, projectID string, proResMap map[string]*projectResourceModel.Single) error {
+// SyncCreateTaskResources synchronizes the creation of task_resource associations for tasks of a project.
+func (m *manager) SyncCreateTaskResources(trx *gorm.DB, taskResources []map[string][]*resourceModel.TaskSingle, createdBy string, projectID string, proResMap map[string]*projectResourceModel.Single) error {
 	var (
 		resList    []*taskResourceModel.Create
 		proResList []*projectResourceModel.Create
@@ -292,6 +361,11 @@ func (m *manager) syncCreateTaskResources(trx *gorm.DB, taskResources []map[stri
 						IsEditable:   true,
 						CreatedBy:    createdBy,
 					})
+
+					// the resource assigned to several tasks joins the project once
+					proResMap[res.ResourceUUID] = &projectResourceModel.Single{
+						ResourceUUID: res.ResourceUUID,
+					}
 				}
 
 			}
@@ -314,8 +388,8 @@ func (m *manager) syncCreateTaskResources(trx *gorm.DB, taskResources []map[stri
 	return nil
 }
 
-// syncDeleteTaskResources is a helper function to synchronize the deletion of task_resource associations for a tasks of a project.
-func (m *manager) syncDeleteTaskResources(trx *gorm.DB, TaskUUID *string, TaskUUIDs []*string, isBatch bool) error {
+// SyncDeleteTaskResources synchronizes the deletion of task_resource associations for a tasks of a project.
+func (m *manager) SyncDeleteTaskResources(trx *gorm.DB, TaskUUID *string, TaskUUIDs []*string, isBatch bool) error {
 	if isBatch && len(TaskUUIDs) > 0 {
 		err := m.TaskResourceService.WithTrx(trx).Delete(&taskResourceModel.Field{
 			TaskUUIDs: TaskUUIDs,
@@ -336,8 +410,8 @@ func (m *manager) syncDeleteTaskResources(trx *gorm.DB, TaskUUID *string, TaskUU
 	return nil
 }
 
-// syncUpdateProjectStartEndDate is a helper function to synchronize the update project start and end dates.
-func (m *manager) syncUpdateProjectStartEndDate(trx *gorm.DB, projectID *string, TaskUUIDs []*string, start, end *time.Time) error {
+// SyncUpdateProjectStartEndDate synchronizes the update project start and end dates.
+func (m *manager) SyncUpdateProjectStartEndDate(trx *gorm.DB, projectID *string, TaskUUIDs []*string, start, end *time.Time) error {
 	if projectID == nil {
 		return errors.New("ProjectUUID is null")
 	}
@@ -389,918 +463,4762 @@ func (m *manager) syncUpdateProjectStartEndDate(trx *gorm.DB, projectID *string,
 	return nil
 }
 
-// generateNewOutlineNumber is a helper function used to generate a new outline number within the "getNextOutlineNumber" function.
-func generateNewOutlineNumber(isSubtask bool, lastOutlineNumber string) (string, error) {
-	var newOutlineNumber string
-	outlines := strings.Split(lastOutlineNumber, ".")
+// SyncTaskDependencies syncs the dependency graph of the project with the tasks' predecessors,
+// removing the dependencies which are gone and creating the new ones.
+func (m *manager) SyncTaskDependencies(trx *gorm.DB, projectUUID *string, createdBy string) error {
+	if projectUUID == nil {
+		return errors.New("ProjectUUID is null")
+	}
 
-	if isSubtask {
-		lastTaskOutline, err := strconv.Atoi(outlines[len(outlines)-1])
-		if err != nil {
-			log.Error(err)
-			return "", err
-		}
-		newOutlineNumber = fmt.Sprintf("%s.%d", strings.Join(outlines[:len(outlines)-1], "."), lastTaskOutline+1)
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoQuantity(&taskModel.Field{
+		ProjectUUID: projectUUID,
+	})
+	if err != nil {
+		return err
+	}
 
-	} else {
-		lastTaskOutline, err := strconv.Atoi(outlines[0])
-		if err != nil {
-			log.Error(err)
-			return "", err
-		}
-		newOutlineNumber = strconv.Itoa(lastTaskOutline + 1)
+	activities := make([]*schedule.Activity, 0, len(taskBase))
+	for _, task := range taskBase {
+		activities = append(activities, &schedule.Activity{
+			UUID:        *task.TaskUUID,
+			TaskID:      *task.TaskID,
+			Predecessor: *task.Predecessor,
+		})
 	}
-	return newOutlineNumber, nil
-}
 
-// getParentOutlineNumber is a helper function to extract the parent outline number.
-func getParentOutlineNumber(outlineNumber string) string {
-	parts := strings.Split(outlineNumber, ".")
-	if len(parts) > 1 {
-		return strings.Join(parts[:len(parts)-1], ".")
+	// resolve the predecessors and check if the dependencies form a cycle
+	dependencies, err := schedule.Link(activities)
+	if err != nil {
+		return err
 	}
-	return ""
-}
 
-// findParentTaskByOutlineNumber is a helper function to find the parent task by outline number.
-func findParentTaskByOutlineNumber(tasks []*taskModel.Single, outlineNumber string) *taskModel.Single {
-	for _, task := range tasks {
-		if task.OutlineNumber == outlineNumber {
-			return task
-		}
+	dependencyBase, err := m.TaskDependencyService.WithTrx(trx).GetByListNoPagination(&taskDependencyModel.Field{
+		ProjectUUID: projectUUID,
+	})
+	if err != nil {
+		return err
 	}
-	return nil
-}
 
-// assembleToCreateAll is a helper function to recursively assemble a hierarchical structure of tasks.
-func assembleToCreateAll(tasks []*taskModel.Create, taskRecordIdx map[string]int, task *taskModel.Create, outlineNumber, selectOutlineNumber, projectID string, resUUID, role *string) []*taskModel.Create {
-	task.ProjectUUID = projectID
-	outlineNumberSplit := strings.Split(outlineNumber, ".")
+	// the dependencies are matched by their tasks, type and lag
+	existingMap := make(map[string]*string)
+	for _, dep := range dependencyBase {
+		existingMap[dependencyKey(&schedule.Dependency{
+			PredecessorUUID: *dep.PredecessorUUID,
+			SuccessorUUID:   *dep.SuccessorUUID,
+			Type:            *dep.Type,
+			Lag:             *dep.Lag,
+			LagUnit:         *dep.LagUnit,
+		})] = dep.ID
+	}
 
-	// handle the main task
-	if len(outlineNumberSplit) == 1 {
-		// record the outline_number of the current main task
-		if outlineNumber != "" {
-			selectOutlineNumber += "." + outlineNumber
-		}
-		// map the outline_number of the current main task to the index position of allTask
-		taskRecordIdx[selectOutlineNumber] = len(tasks)
-		tasks = append(tasks, task)
-	} else {
-		// handle the current subtask
-		var newOutlineNumber string
-		for i := 1; i < len(outlineNumberSplit); i++ {
-			newOutlineNumber += outlineNumberSplit[i]
-			// if it is not the last subtask, add a separator to the outline_number
-			if i != len(outlineNumberSplit)-1 {
-				newOutlineNumber += "."
-			}
+	var createList []*taskDependencyModel.Create
+	for _, dep := range dependencies {
+		key := dependencyKey(dep)
+		if _, ok := existingMap[key]; ok {
+			delete(existingMap, key)
+			continue
 		}
 
-		// append the outline_number of the current subtask to the outline_number of its parent task
-		if selectOutlineNumber == "" {
-			selectOutlineNumber = outlineNumberSplit[0]
-		} else {
-			selectOutlineNumber += "." + outlineNumberSplit[0]
+		createList = append(createList, &taskDependencyModel.Create{
+			ProjectUUID:     *projectUUID,
+			PredecessorUUID: dep.PredecessorUUID,
+			SuccessorUUID:   dep.SuccessorUUID,
+			Type:            dep.Type,
+			Lag:             dep.Lag,
+			LagUnit:         dep.LagUnit,
+			CreatedBy:       createdBy,
+		})
+	}
+
+	// the dependencies left over are no longer in the predecessors
+	if len(existingMap) > 0 {
+		var removedIDs []*string
+		for _, id := range existingMap {
+			removedIDs = append(removedIDs, id)
 		}
 
-		// handle possible subtasks
-		subTasks := assembleToCreateAll(tasks[taskRecordIdx[selectOutlineNumber]].Subtask, taskRecordIdx, task, newOutlineNumber, selectOutlineNumber, projectID, resUUID, role)
-		tasks[taskRecordIdx[selectOutlineNumber]].Subtask = subTasks
+		err = m.TaskDependencyService.WithTrx(trx).Delete(&taskDependencyModel.Field{
+			IDs: removedIDs,
+		})
+		if err != nil {
+			return err
+		}
 	}
 
-	for _, task := range tasks {
-		if resUUID != nil {
-			task.ResUUID = resUUID
-		}
-		if role != nil {
-			task.Role = role
+	if len(createList) > 0 {
+		_, err = m.TaskDependencyService.WithTrx(trx).CreateAll(createList)
+		if err != nil {
+			return err
 		}
 	}
 
-	return tasks
+	return nil
 }
 
-func (m *manager) Create(trx *gorm.DB, input *taskModel.Create) (int, any) {
-	defer trx.Rollback()
-
-	// get the project's info
-	projectBase, err := m.ProjectService.GetBySingle(&projectModel.Field{
-		ProjectUUID: input.ProjectUUID,
-	})
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
-		}
+// dependencyKey is a helper function to identify the dependency by its tasks, type and lag.
+func dependencyKey(dep *schedule.Dependency) string {
+	return fmt.Sprintf("%s|%s|%s|%g|%s", dep.PredecessorUUID, dep.SuccessorUUID, dep.Type, dep.Lag, dep.LagUnit)
+}
 
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+// getDependencies is a helper function to load the dependency graph of the project between the activities from task_dependencies.
+// The project whose graph has not been synced yet (created before task_dependencies) is linked from the predecessors.
+func (m *manager) getDependencies(trx *gorm.DB, projectUUID *string, activities []*schedule.Activity) ([]*schedule.Dependency, error) {
+	taskDependencyService := m.TaskDependencyService
+	if trx != nil {
+		taskDependencyService = m.TaskDependencyService.WithTrx(trx)
 	}
 
-	// check if the user is a project member
-	proResBase, err := m.ProjectResourceService.GetBySingle(&projectResourceModel.Field{
-		ProjectUUID:  util.PointerString(input.ProjectUUID),
-		ResourceUUID: input.ResUUID,
+	dependencyBase, err := taskDependencyService.GetByListNoPagination(&taskDependencyModel.Field{
+		ProjectUUID: projectUUID,
 	})
 	if err != nil {
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-		}
+		return nil, err
 	}
 
-	// if the user is a project member, check if the user can edit or delete tasks of the project
-	if proResBase != nil {
-		if !*proResBase.IsEditable {
-			log.Error("You are not allowed to create tasks for this project.")
-			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "You are not allowed to create tasks for this project.")
-		}
-	} else {
-		// if the user is not a project member, check if the user is an admin or the creator of the project
-		if *input.Role != "admin" {
-			if *projectBase.CreatedBy != input.CreatedBy {
-				log.Error("You are not allowed to create tasks for this project.")
-				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "You are not allowed to create tasks for this project.")
+	if len(dependencyBase) == 0 {
+		for _, activity := range activities {
+			if activity.Predecessor != "" {
+				return schedule.Link(activities)
 			}
 		}
 	}
 
-	// determine if the task is a subtask
-	if input.ParentUUID != nil {
-		parentBase, err := m.TaskService.GetBySingle(&taskModel.Field{
-			TaskUUID: *input.ParentUUID,
-		})
-		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
-			}
-
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-		}
-		input.ProjectUUID = *parentBase.ProjectUUID
-
-		// get the last outline_number of the same level
-		input.OutlineNumber, err = m.getNextOutlineNumber(true, parentBase.OutlineNumber, input.ProjectUUID)
-		if err != nil {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-		}
-		input.IsSubTask = true
-
-		// check if it is the most sub-task
-		if parentBase.Segment != nil {
-			if *parentBase.Segment != "" {
-				log.Info("Please remove the task segmentation first.")
-				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "Please remove the task segmentation first.")
-			}
-		}
-
-		// transform segments from struct array to string
-		if len(input.Segments) > 0 {
-			segJson, _ := sonic.Marshal(input.Segments)
-			input.Segment = string(segJson)
-		}
+	activityMap := make(map[string]bool)
+	for _, activity := range activities {
+		activityMap[activity.UUID] = true
+	}
 
-		// transform indicators from struct array to string
-		if len(input.Indicators) > 0 {
-			indJson, _ := sonic.Marshal(input.Indicators)
-			input.Indicator = string(indJson)
+	var dependencies []*schedule.Dependency
+	for _, dep := range dependencyBase {
+		if !activityMap[*dep.PredecessorUUID] || !activityMap[*dep.SuccessorUUID] {
+			continue
 		}
 
-	} else {
-		// the main task with subtasks cannot be segmented
-		if len(input.Segments) > 0 {
-			if len(input.Subtask) > 0 {
-				log.Info("The parent task cannot be segmented.")
-				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The parent task cannot be segmented.")
-			}
-
-			// transform segments from struct array to string
-			segJson, _ := sonic.Marshal(input.Segments)
-			input.Segment = string(segJson)
-		}
+		dependencies = append(dependencies, &schedule.Dependency{
+			PredecessorUUID: *dep.PredecessorUUID,
+			SuccessorUUID:   *dep.SuccessorUUID,
+			Type:            *dep.Type,
+			Lag:             *dep.Lag,
+			LagUnit:         *dep.LagUnit,
+		})
+	}
 
-		// transform indicators from struct array to string
-		if len(input.Indicators) > 0 {
-			indJson, _ := sonic.Marshal(input.Indicators)
-			input.Indicator = string(indJson)
-		}
+	return dependencies, nil
+}
 
-		// get the new outline_number
-		newOutlineNumber, err := m.getNextOutlineNumber(false, nil, input.ProjectUUID)
-		if err != nil {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-		}
-		input.OutlineNumber = newOutlineNumber
+// SyncDeleteTaskDependencies removes the deleted tasks from the predecessors of the remaining tasks.
+func (m *manager) SyncDeleteTaskDependencies(trx *gorm.DB, projectUUID *string, TaskUUIDs []*string, updatedBy string) error {
+	deletedTaskUUIDs := make(map[string]bool)
+	for _, taskUUID := range TaskUUIDs {
+		deletedTaskUUIDs[*taskUUID] = true
 	}
 
-	taskBase, err := m.TaskService.WithTrx(trx).Create(input)
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoQuantity(&taskModel.Field{
+		ProjectUUID: projectUUID,
+	})
 	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		return err
 	}
 
-	if len(input.Resources) > 0 {
-		// get all resources of the project
-		proResBase, err := m.ProjectResourceService.GetByListNoPagination(&projectResourceModel.Field{
-			ProjectUUID: util.PointerString(input.ProjectUUID),
-		})
-		if err != nil {
-			if !errors.Is(err, gorm.ErrRecordNotFound) {
-				log.Error(err)
-				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-			}
+	deletedTaskIDs := make(map[string]bool)
+	for _, task := range taskBase {
+		if deletedTaskUUIDs[*task.TaskUUID] {
+			deletedTaskIDs[*task.TaskID] = true
 		}
+	}
 
-		var proRes []*projectResourceModel.Single
-		proResByte, err := sonic.Marshal(proResBase)
-		if err != nil {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	for _, task := range taskBase {
+		if deletedTaskUUIDs[*task.TaskUUID] || task.Predecessor == nil || *task.Predecessor == "" {
+			continue
 		}
 
-		err = sonic.Unmarshal(proResByte, &proRes)
+		// the predecessors which cannot be parsed are left for the next update to report
+		predecessors, err := schedule.ParsePredecessors(*task.Predecessor)
 		if err != nil {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			continue
 		}
 
-		// create a map of resourceUUID
-		proResMap := make(map[string]*projectResourceModel.Single)
-		if len(proRes) > 0 {
-			for _, res := range proRes {
-				proResMap[res.ResourceUUID] = res
+		var remaining []*schedule.Predecessor
+		for _, predecessor := range predecessors {
+			if !deletedTaskIDs[predecessor.TaskID] {
+				remaining = append(remaining, predecessor)
 			}
 		}
 
-		// sync create task_resource
-		taskResMapList := []map[string][]*resourceModel.TaskSingle{
-			{*taskBase.TaskUUID: input.Resources},
+		if len(remaining) == len(predecessors) {
+			continue
 		}
-		err = m.syncCreateTaskResources(trx, taskResMapList, input.CreatedBy, input.ProjectUUID, proResMap)
+
+		err = m.TaskService.WithTrx(trx).Update(&taskModel.Update{
+			TaskUUID:    *task.TaskUUID,
+			Predecessor: util.PointerString(schedule.FormatPredecessors(remaining)),
+			UpdatedBy:   util.PointerString(updatedBy),
+		})
 		if err != nil {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			return err
 		}
 	}
 
-	// sync update project's start and end dates
-	err = m.syncUpdateProjectStartEndDate(trx, util.PointerString(input.ProjectUUID), nil, input.BaselineStartDate, input.BaselineEndDate)
+	err = m.TaskDependencyService.WithTrx(trx).Delete(&taskDependencyModel.Field{
+		TaskUUIDs: TaskUUIDs,
+	})
 	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		return err
 	}
 
-	trx.Commit()
-	return code.Successful, code.GetCodeMessage(code.Successful, taskBase.TaskUUID)
+	return nil
 }
 
-func (m *manager) CreateAll(trx *gorm.DB, input []*taskModel.Create) (int, any) {
-	defer trx.Rollback()
+// assembleCriticalPath is a helper function to run the critical path method over the tasks of the project and fill in their timings and constraint flags.
+func (m *manager) assembleCriticalPath(projectUUID *string, tasks []*taskModel.Single, calendar schedule.Calendar) (*schedule.Result, error) {
+	activities := make([]*schedule.Activity, 0, len(tasks))
+	for _, task := range tasks {
+		activities = append(activities, assembleActivity(task))
+	}
 
-	var (
-		createList                       []*taskModel.Create
-		taskResMapList                   []map[string][]*resourceModel.TaskSingle
-		minBaselineStart, maxBaselineEnd *time.Time
-	)
+	dependencies, err := m.getDependencies(nil, projectUUID, activities)
+	if err != nil {
+		return nil, err
+	}
 
-	// get all resources of the project
-	proResBase, err := m.ProjectResourceService.GetByListNoPagination(&projectResourceModel.Field{
-		ProjectUUID: util.PointerString(input[0].ProjectUUID),
-	})
+	result, err := schedule.CriticalPath(activities, dependencies, calendar)
 	if err != nil {
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		return nil, err
+	}
+
+	for i, task := range tasks {
+		// flag the broken constraints and the missed deadlines
+		task.IsConstraintViolated = activities[i].ConstraintViolated()
+		task.IsDeadlineMissed = activities[i].DeadlineMissed()
+
+		timing, ok := result.Timings[task.TaskUUID]
+		if !ok {
+			continue
 		}
+
+		task.EarlyStartDate = util.PointerTime(timing.EarlyStart)
+		task.EarlyFinishDate = util.PointerTime(timing.EarlyFinish)
+		task.LateStartDate = util.PointerTime(timing.LateStart)
+		task.LateFinishDate = util.PointerTime(timing.LateFinish)
+		task.TotalFloat = util.PointerFloat64(timing.TotalFloat)
+		task.FreeFloat = util.PointerFloat64(timing.FreeFloat)
+		task.IsCritical = timing.IsCritical
 	}
 
-	var proRes []*projectResourceModel.Single
-	proResByte, err := sonic.Marshal(proResBase)
+	return result, nil
+}
+
+// getSelectedBaseline is a helper function to get the tasks of the selected baseline of the project by their task UUIDs, or nil when no baseline is selected.
+func (m *manager) getSelectedBaseline(projectUUID *string) (map[string]*baselineTaskDB.Base, error) {
+	baselineBase, err := m.ProjectBaselineService.GetByListNoPagination(&projectBaselineModel.Field{
+		ProjectUUID: projectUUID,
+		IsSelected:  util.PointerBool(true),
+	})
 	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		return nil, err
 	}
 
-	err = sonic.Unmarshal(proResByte, &proRes)
-	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	if len(baselineBase) == 0 {
+		return nil, nil
 	}
 
-	// create a map of resourceUUID
-	proResMap := make(map[string]*projectResourceModel.Single)
-	if len(proRes) > 0 {
-		for _, res := range proRes {
-			proResMap[res.ResourceUUID] = res
-		}
+	output := make(map[string]*baselineTaskDB.Base)
+	for i, task := range baselineBase[0].Tasks {
+		output[*task.TaskUUID] = &baselineBase[0].Tasks[i]
 	}
 
-	// get the new outline_number
-	topOutlineNumber, err := m.getNextOutlineNumber(false, nil, input[0].ProjectUUID)
-	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	return output, nil
+}
+
+// assembleBaseline is a helper function to replace the baseline fields of the task by its snapshot, which is missing when the task was created after the baseline.
+func assembleBaseline(task *taskModel.Single, snapshot *baselineTaskDB.Base) {
+	if snapshot == nil {
+		task.BaselineStartDate, task.BaselineEndDate, task.BaselineDuration = nil, nil, 0
+		return
 	}
 
-	newOutlineNumber, err := strconv.Atoi(topOutlineNumber)
-	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	task.BaselineStartDate, task.BaselineEndDate = snapshot.StartDate, snapshot.EndDate
+	task.BaselineDuration = 0
+	if snapshot.Duration != nil {
+		task.BaselineDuration = *snapshot.Duration
 	}
+}
 
-	// get the project's info
-	projectBase, err := m.ProjectService.GetBySingle(&projectModel.Field{
-		ProjectUUID: input[0].ProjectUUID,
-	})
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+// assembleEarnedValue is a helper function to transform the task into an earned value, priced with the standard costs of its resources:
+// the budget comes from the planned hours of the selected baseline (or the baseline fields of the task when no baseline is selected)
+// and the actual cost from the hours of its approved timesheets.
+func assembleEarnedValue(task *taskDB.Base, baselineMap map[string]*baselineTaskDB.Base, hoursPerDay float64) *schedule.EarnedValue {
+	var rates []schedule.ResourceRate
+	for _, res := range task.TaskResources {
+		if res.Unit != nil && res.Resources.Resources.StandardCost != nil {
+			rates = append(rates, schedule.ResourceRate{
+				StandardCost: *res.Resources.Resources.StandardCost,
+				Unit:         *res.Unit,
+			})
+		}
+	}
+	pricing := schedule.NewPricing(rates, hoursPerDay)
+
+	// the planned hours of the task are its cost until the approved timesheets take the cost over
+	budget := func(plannedWork *float64, cost *int64, duration *float64) float64 {
+		var h, d float64
+		if plannedWork != nil {
+			h = *plannedWork
+		} else if cost != nil {
+			h = float64(*cost)
+		}
+		if duration != nil {
+			d = *duration
 		}
 
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		return pricing.Budget(h, d)
 	}
 
-	// if the user is a project member, check if the user can edit or delete tasks of the project
-	if proResMap[*input[0].ResUUID] != nil {
-		if !proResMap[*input[0].ResUUID].IsEditable {
-			log.Error("You are not allowed to create tasks for this project.")
-			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "You are not allowed to create tasks for this project.")
+	value := &schedule.EarnedValue{
+		UUID:          *task.TaskUUID,
+		OutlineNumber: *task.OutlineNumber,
+	}
+	if task.ActualWork != nil {
+		value.ActualCost = pricing.ActualCost(*task.ActualWork)
+	}
+	if task.Progress != nil {
+		value.Progress = float64(*task.Progress)
+	}
+
+	baselineStart, baselineEnd := task.BaselineStartDate, task.BaselineEndDate
+	if baselineMap != nil {
+		// the task created after the baseline has no budget
+		snapshot, ok := baselineMap[*task.TaskUUID]
+		if !ok {
+			return value
 		}
+
+		baselineStart, baselineEnd = snapshot.StartDate, snapshot.EndDate
+		value.Budget = budget(snapshot.PlannedWork, snapshot.Cost, snapshot.Duration)
 	} else {
-		// if the user is not a project member, check if the user is an admin or the creator of the project
-		if *input[0].Role != "admin" {
-			if *projectBase.CreatedBy != input[0].CreatedBy {
-				log.Error("You are not allowed to create tasks for this project.")
-				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "You are not allowed to create tasks for this project.")
-			}
+		value.Budget = budget(task.PlannedWork, task.Cost, task.Duration)
+
+		if baselineStart == nil || baselineEnd == nil {
+			baselineStart, baselineEnd = task.StartDate, task.EndDate
 		}
 	}
 
-	// create the main task
-	for i, inputBody := range input {
-		if inputBody.BaselineStartDate != nil && inputBody.BaselineEndDate != nil {
-			// get the minimum baseline_start_date
-			if minBaselineStart == nil || inputBody.BaselineStartDate.Before(*minBaselineStart) {
-				minBaselineStart = inputBody.BaselineStartDate
-			}
+	if baselineStart != nil {
+		value.BaselineStart = *baselineStart
+	}
 
-			// get the maximum baseline_end_date
-			if maxBaselineEnd == nil || inputBody.BaselineEndDate.After(*maxBaselineEnd) {
-				maxBaselineEnd = inputBody.BaselineEndDate
-			}
-		}
+	if baselineEnd != nil {
+		value.BaselineFinish = *baselineEnd
+	}
 
-		// the main task with subtasks cannot be segmented
-		if len(inputBody.Segments) > 0 {
-			if len(inputBody.Subtask) > 0 {
-				log.Info("The parent task cannot be segmented.")
-				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The parent task cannot be segmented.")
-			}
+	return value
+}
 
-			// transform segments from struct array to string
-			segJson, _ := sonic.Marshal(inputBody.Segments)
-			inputBody.Segment = string(segJson)
-		}
+// assembleEarnedValueMetrics is a helper function to transform the earned value into its metrics.
+func assembleEarnedValueMetrics(value *schedule.EarnedValue) taskModel.EarnedValueMetrics {
+	return taskModel.EarnedValueMetrics{
+		BAC: value.BAC,
+		PV:  value.PV,
+		EV:  value.EV,
+		AC:  value.AC,
+		SV:  value.SV,
+		CV:  value.CV,
+		SPI: value.SPI,
+		CPI: value.CPI,
+		EAC: value.EAC,
+		ETC: value.ETC,
+	}
+}
 
-		// transform indicators from struct array to string
-		if len(inputBody.Indicators) > 0 {
-			indJson, _ := sonic.Marshal(inputBody.Indicators)
-			inputBody.Indicator = string(indJson)
-		}
+// assembleForecastDates is a helper function to get the P50, P80 and P95 of the simulated finish dates.
+func assembleForecastDates(finishes []time.Time) taskModel.ForecastDates {
+	output := taskModel.ForecastDates{}
+	if len(finishes) == 0 || schedule.Percentile(finishes, 50).IsZero() {
+		return output
+	}
 
-		// get the new outline_number
-		inputBody.OutlineNumber = strconv.Itoa(newOutlineNumber + i)
-		createList = append(createList, inputBody)
+	output.P50 = util.PointerTime(schedule.Percentile(finishes, 50))
+	output.P80 = util.PointerTime(schedule.Percentile(finishes, 80))
+	output.P95 = util.PointerTime(schedule.Percentile(finishes, 95))
+	return output
+}
 
-		// create subtasks
-		if len(inputBody.Subtask) > 0 {
-			subSubtasks, subMinBaselineStart, subMaxBaselineEnd, err := m.createSubtasks(trx, inputBody, inputBody.Subtask, projectBase.StartDate, projectBase.EndDate, minBaselineStart, maxBaselineEnd)
-			if err != nil {
-				if err.Error() == "the parent task cannot be segmented" {
-					return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The parent task cannot be segmented.")
-				}
+// assembleActivity is a helper function to transform the task into an activity of the schedule network.
+func assembleActivity(task *taskModel.Single) *schedule.Activity {
+	activity := &schedule.Activity{
+		UUID:           task.TaskUUID,
+		TaskID:         task.TaskID,
+		OutlineNumber:  task.OutlineNumber,
+		Predecessor:    task.Predecessor,
+		Duration:       task.Duration,
+		ConstraintType: task.ConstraintType,
+		Priority:       task.Priority,
+	}
+	if task.StartDate != nil {
+		activity.Start = *task.StartDate
+	}
+	if task.EndDate != nil {
+		activity.End = *task.EndDate
+	}
+	if task.ConstraintDate != nil {
+		activity.ConstraintDate = *task.ConstraintDate
+	}
+	if task.Deadline != nil {
+		activity.Deadline = *task.Deadline
+	}
 
-				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-			}
-			createList = append(createList, subSubtasks...)
+	return activity
+}
 
-			// compare the minimum baseline_start_date and maximum baseline_end_date of the subtasks
-			if inputBody.BaselineStartDate != nil && inputBody.BaselineEndDate != nil {
-				if minBaselineStart == nil || inputBody.BaselineStartDate.Before(*subMinBaselineStart) {
-					minBaselineStart = inputBody.BaselineStartDate
-				}
-				if maxBaselineEnd == nil || inputBody.BaselineEndDate.After(*subMaxBaselineEnd) {
-					maxBaselineEnd = inputBody.BaselineEndDate
-				}
-			}
-		}
+// assembleTaskActivity is a helper function to transform the stored task into an activity of the schedule network.
+func assembleTaskActivity(task *taskDB.Base) *schedule.Activity {
+	activity := &schedule.Activity{
+		UUID:          *task.TaskUUID,
+		TaskID:        *task.TaskID,
+		OutlineNumber: *task.OutlineNumber,
+		Predecessor:   *task.Predecessor,
+		Priority:      schedule.DefaultPriority,
+	}
+	if task.Duration != nil {
+		activity.Duration = *task.Duration
+	}
+	if task.StartDate != nil {
+		activity.Start = *task.StartDate
+	}
+	if task.EndDate != nil {
+		activity.End = *task.EndDate
+	}
+	if task.ConstraintType != nil {
+		activity.ConstraintType = *task.ConstraintType
+	}
+	if task.ConstraintDate != nil {
+		activity.ConstraintDate = *task.ConstraintDate
+	}
+	if task.Deadline != nil {
+		activity.Deadline = *task.Deadline
+	}
+	if task.Priority != nil {
+		activity.Priority = *task.Priority
 	}
 
-	tasksBase, err := m.TaskService.WithTrx(trx).CreateAll(createList)
+	return activity
+}
+
+// syncRescheduleSuccessors is a helper function to move the successors of the changed tasks and their parent tasks.
+func (m *manager) syncRescheduleSuccessors(trx *gorm.DB, projectUUID *string, changed []string, updatedBy string) ([]*taskModel.MovedTask, error) {
+	movedTasks := []*taskModel.MovedTask{}
+	if len(changed) == 0 {
+		return movedTasks, nil
+	}
+
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoQuantity(&taskModel.Field{
+		ProjectUUID: projectUUID,
+	})
 	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		return nil, err
 	}
 
-	// sync create task_resource
-	for i, taskBase := range tasksBase {
-		if len(createList[i].Resources) > 0 {
-			taskResources := make(map[string][]*resourceModel.TaskSingle)
-			taskResources[*taskBase.TaskUUID] = createList[i].Resources
-			taskResMapList = append(taskResMapList, taskResources)
-		}
+	activities := make([]*schedule.Activity, 0, len(taskBase))
+	for _, task := range taskBase {
+		activities = append(activities, assembleTaskActivity(task))
 	}
 
-	// sync create task_resource
-	if len(taskResMapList) > 0 {
-		err = m.syncCreateTaskResources(trx, taskResMapList, createList[0].CreatedBy, createList[0].ProjectUUID, proResMap)
-		if err != nil {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-		}
+	dependencies, err := m.getDependencies(trx, projectUUID, activities)
+	if err != nil {
+		return nil, err
 	}
 
-	// sync update project's start and end dates
-	err = m.syncUpdateProjectStartEndDate(trx, util.PointerString(input[0].ProjectUUID), nil, minBaselineStart, maxBaselineEnd)
+	calendar, err := m.GetWorkCalendar(projectUUID)
 	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		return nil, err
 	}
 
-	trx.Commit()
-	return code.Successful, code.GetCodeMessage(code.Successful, "Successful create!")
-}
+	moved, err := schedule.Reschedule(activities, dependencies, changed, calendar)
+	if err != nil {
+		return nil, err
+	}
 
-func (m *manager) GetByProjectListNoPagination(input *taskModel.ProjectIDs) (int, any) {
-	output := &taskModel.List{}
+	for _, activity := range moved {
+		err = m.TaskService.WithTrx(trx).Update(&taskModel.Update{
+			TaskUUID:  activity.UUID,
+			StartDate: util.PointerTime(activity.Start),
+			EndDate:   util.PointerTime(activity.End),
+			UpdatedBy: util.PointerString(updatedBy),
+		})
+		if err != nil {
+			return nil, err
+		}
 
-	// get projects
-	projectBase, err := m.ProjectService.GetByListNoPagination(&projectModel.Field{
-		ProjectUUIDs: input.Projects,
-	})
-	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		movedTasks = append(movedTasks, &taskModel.MovedTask{
+			TaskUUID:  activity.UUID,
+			TaskID:    activity.TaskID,
+			StartDate: util.PointerTime(activity.Start),
+			EndDate:   util.PointerTime(activity.End),
+		})
 	}
 
-	if len(projectBase) == 0 {
-		log.Error("record not found")
-		return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, "record not found")
+	return movedTasks, nil
+}
+
+// syncRollUpSummaryTasks is a helper function to update the dates, durations, costs and progresses of the summary tasks of the project from their subtasks.
+func (m *manager) syncRollUpSummaryTasks(trx *gorm.DB, projectUUID *string, updatedBy string) ([]*taskModel.SummaryTask, error) {
+	summaryTasks := []*taskModel.SummaryTask{}
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoQuantity(&taskModel.Field{
+		ProjectUUID: projectUUID,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	var projects []*projectModel.Single
-	projectByte, err := sonic.Marshal(projectBase)
+	calendar, err := m.GetWorkCalendar(projectUUID)
 	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		return nil, err
 	}
 
-	err = sonic.Unmarshal(projectByte, &projects)
+	transitions, err := m.getStatusTransitions(projectUUID)
 	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		return nil, err
 	}
 
-	// create a map of projectUUID
-	projectMap := make(map[string]*projectModel.Single)
-	for _, project := range projects {
-		projectMap[project.ProjectUUID] = project
+	items := make([]*schedule.Rollup, 0, len(taskBase))
+	taskMap := make(map[string]*taskDB.Base)
+	for _, task := range taskBase {
+		taskMap[*task.TaskUUID] = task
+		item := &schedule.Rollup{
+			UUID:          *task.TaskUUID,
+			OutlineNumber: *task.OutlineNumber,
+		}
+		if task.StartDate != nil {
+			item.Start = *task.StartDate
+		}
+		if task.EndDate != nil {
+			item.End = *task.EndDate
+		}
+		if task.Duration != nil {
+			item.Duration = *task.Duration
+		}
+		if task.Cost != nil {
+			item.Cost = float64(*task.Cost)
+		}
+		if task.Progress != nil {
+			item.Progress = float64(*task.Progress)
+		}
+		items = append(items, item)
 	}
 
-	// check the user's permission of the project
-	isPM := false
-	isEditable := false
-	if len(input.Projects) == 1 {
-		if *input.Role != "admin" {
-			// check the user can edit or delete tasks of the project
-			proResBase, err := m.ProjectResourceService.GetBySingle(&projectResourceModel.Field{
-				ProjectUUID:  input.Projects[0],
-				ResourceUUID: input.ResUUID,
-			})
-			if err != nil {
-				if !errors.Is(err, gorm.ErrRecordNotFound) {
-					log.Error(err)
-					return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-				}
+	for _, item := range schedule.RollUpSummaries(items, calendar) {
+		task := taskMap[item.UUID]
+		summaryTask := &taskModel.SummaryTask{
+			TaskUUID: item.UUID,
+			TaskID:   *task.TaskID,
+			Duration: item.Duration,
+			Cost:     int64(math.Round(item.Cost)),
+			Progress: int64(math.Round(item.Progress)),
+		}
+		if !item.Start.IsZero() {
+			summaryTask.StartDate = util.PointerTime(item.Start)
+		}
+		if !item.End.IsZero() {
+			summaryTask.EndDate = util.PointerTime(item.End)
+		}
+
+		// the status of the summary task follows its rolled up progress through the transitions of the project type,
+		// and is kept when the project type does not allow the change
+		var (
+			status          string
+			currentProgress int64
+		)
+		if task.Status != nil {
+			status = *task.Status
+		}
+		if task.Progress != nil {
+			currentProgress = *task.Progress
+		}
+		summaryStatus := schedule.ConsistentStatus(status, summaryTask.Progress)
+		if status != "" && summaryStatus != status {
+			summaryStatus, _, err = schedule.ResolveStatus(status, currentProgress, util.PointerString(summaryStatus),
+				util.PointerInt64(summaryTask.Progress), transitions)
+			if errors.Is(err, schedule.ErrInvalidStatus) {
+				summaryStatus = status
+			} else if err != nil {
+				return nil, err
 			}
+		}
 
-			if proResBase != nil {
-				// check if the user has permission to edit or delete the project
-				if *proResBase.IsEditable {
-					isEditable = true
-				}
+		// only the summary tasks out of sync with their subtasks are updated
+		if EqualTime(task.StartDate, summaryTask.StartDate) && EqualTime(task.EndDate, summaryTask.EndDate) &&
+			task.Duration != nil && *task.Duration == summaryTask.Duration &&
+			task.Cost != nil && *task.Cost == summaryTask.Cost &&
+			task.Progress != nil && *task.Progress == summaryTask.Progress &&
+			status == summaryStatus {
+			continue
+		}
 
-				// check if the user is a project manager
-				if *proResBase.Role == "PM" {
-					isPM = true
-				}
-			}
+		update := &taskModel.Update{
+			TaskUUID:  item.UUID,
+			StartDate: summaryTask.StartDate,
+			EndDate:   summaryTask.EndDate,
+			Duration:  util.PointerFloat64(summaryTask.Duration),
+			Cost:      util.PointerInt64(summaryTask.Cost),
+			Progress:  util.PointerInt64(summaryTask.Progress),
+			UpdatedBy: util.PointerString(updatedBy),
+		}
+		if status != summaryStatus {
+			update.Status = util.PointerString(summaryStatus)
+			update.StatusChangedBy = util.PointerString(updatedBy)
+			update.StatusChangedAt = util.PointerTime(util.NowToUTC())
 		}
+
+		err = m.TaskService.WithTrx(trx).Update(update)
+		if err != nil {
+			return nil, err
+		}
+
+		summaryTasks = append(summaryTasks, summaryTask)
 	}
 
-	// get project tasks
-	projectTaskMap := make(map[*string][]*taskModel.Single)
-	var (
-		wg               sync.WaitGroup
-		mu               sync.Mutex
-		projectStartDate *time.Time
-	)
+	return summaryTasks, nil
+}
 
+// SyncActualWork updates the actual work of the tasks to the hours of their approved timesheet entries, and their costs to the rounded hours,
+// then rolls the costs up to the summary tasks of their projects.
+func (m *manager) SyncActualWork(trx *gorm.DB, taskUUIDs []*string, updatedBy string) error {
+	if len(taskUUIDs) == 0 {
+		return nil
+	}
+
+	entryBase, err := m.TimesheetEntryService.WithTrx(trx).GetByListNoPagination(&timesheetEntryModel.Field{
+		TaskUUIDs:       taskUUIDs,
+		TimesheetStatus: util.PointerString(timesheetModel.StatusApproved),
+	})
+	if err != nil {
+		return err
+	}
+
+	hours := make(map[string]float64)
+	for _, entry := range entryBase {
+		hours[*entry.TaskUUID] += *entry.Hours
+	}
+
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoQuantity(&taskModel.Field{
+		TaskUUIDs: taskUUIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	var projectUUIDs []*string
+	projects := make(map[string]bool)
+	for _, task := range taskBase {
+		actualWork := math.Round(hours[*task.TaskUUID]*100) / 100
+
+		update := &taskModel.Update{
+			TaskUUID:   *task.TaskUUID,
+			ActualWork: util.PointerFloat64(actualWork),
+			Cost:       util.PointerInt64(int64(math.Round(actualWork))),
+			UpdatedBy:  util.PointerString(updatedBy),
+		}
+
+		// the hours planned by hand are kept before the approved hours take over the cost
+		if task.PlannedWork == nil {
+			update.PlannedWork = util.PointerFloat64(0)
+			if task.Cost != nil {
+				update.PlannedWork = util.PointerFloat64(float64(*task.Cost))
+			}
+		}
+
+		err = m.TaskService.WithTrx(trx).Update(update)
+		if err != nil {
+			return err
+		}
+
+		if !projects[*task.ProjectUUID] {
+			projects[*task.ProjectUUID] = true
+			projectUUIDs = append(projectUUIDs, task.ProjectUUID)
+		}
+	}
+
+	for _, projectUUID := range projectUUIDs {
+		_, err = m.syncRollUpSummaryTasks(trx, projectUUID, updatedBy)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EqualTime reports whether the two optional dates are the same.
+func EqualTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
+
+// CheckEditable checks the project can be modified and the user has the permission to update its tasks,
+// returning the reason when they cannot be updated.
+func (m *manager) CheckEditable(trx *gorm.DB, projectUUID string, role, resUUID *string) (string, error) {
+	projectBase, err := m.ProjectService.WithTrx(trx).GetBySingle(&projectModel.Field{
+		ProjectUUID: projectUUID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// the completed project cannot be modified
+	if *projectBase.Status != "建檔中" && *projectBase.Status != "執行中" && *projectBase.Status != "暫停中" {
+		return "The project is completed and cannot be modified.", nil
+	}
+
+	if *role == "admin" {
+		return "", nil
+	}
+
+	proResBase, err := m.ProjectResourceService.WithTrx(trx).GetByListNoPagination(&projectResourceModel.Field{
+		ProjectUUID:  util.PointerString(projectUUID),
+		ResourceUUID: resUUID,
+	})
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", err
+	}
+
+	if len(proResBase) == 0 || proResBase[0].IsEditable == nil || !*proResBase[0].IsEditable {
+		return "The user don't have permission to update the project's tasks.", nil
+	}
+
+	return "", nil
+}
+
+// extendDateRange is a helper function to extend the date range with the dates of the moved tasks.
+func extendDateRange(start, end *time.Time, movedTasks []*taskModel.MovedTask) (*time.Time, *time.Time) {
+	for _, task := range movedTasks {
+		if task.StartDate != nil && (start == nil || task.StartDate.Before(*start)) {
+			start = task.StartDate
+		}
+		if task.EndDate != nil && (end == nil || task.EndDate.After(*end)) {
+			end = task.EndDate
+		}
+	}
+
+	return start, end
+}
+
+// GetWorkCalendar builds the working calendar of the project, which falls back to the default calendar and then to the work_days and holidays settings.
+func (m *manager) GetWorkCalendar(projectUUID *string) (*schedule.WorkCalendar, error) {
+	calendarBase, err := m.getProjectCalendar(projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.getWorkCalendar(calendarBase)
+}
+
+// GetCalendar builds the working calendar of the calendar, or of the default calendar if none is given.
+func (m *manager) GetCalendar(calendarUUID *string) (*schedule.WorkCalendar, error) {
+	var calendarBase *calendarDB.Base
+	var err error
+	if calendarUUID != nil && *calendarUUID != "" {
+		calendarBase, err = m.CalendarService.GetBySingle(&calendarModel.Field{
+			ID: *calendarUUID,
+		})
+	} else {
+		calendarBase, err = m.getProjectCalendar(nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return m.getWorkCalendar(calendarBase)
+}
+
+// getWorkCalendar is a helper function to build the working calendar, which falls back to the work_days and holidays settings when the calendar is nil.
+func (m *manager) getWorkCalendar(calendarBase *calendarDB.Base) (*schedule.WorkCalendar, error) {
+	if calendarBase != nil {
+		return assembleWorkCalendar(calendarBase.WorkWeek, calendarBase.WorkingTime, calendarBase.Holidays)
+	}
+
+	workDayBase, err := m.WorkDayService.GetByListNoPagination(&workDayModel.Field{})
+	if err != nil {
+		return nil, err
+	}
+
+	// the latest work_days setting is used
+	var workWeek, workingTime *string
+	if len(workDayBase) > 0 {
+		workWeek = workDayBase[0].WorkWeek
+		workingTime = workDayBase[0].WorkingTime
+	}
+
+	// the holidays not belonging to any calendar are used
+	holidayBase, err := m.HolidayService.GetByListNoPagination(&holidayModel.Field{
+		CalendarUUID: util.PointerString(""),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	holidays := make([]holidayDB.Base, 0, len(holidayBase))
+	for _, holiday := range holidayBase {
+		holidays = append(holidays, *holiday)
+	}
+
+	return assembleWorkCalendar(workWeek, workingTime, holidays)
+}
+
+// getProjectCalendar is a helper function to get the calendar assigned to the project, or the default calendar if none is assigned.
+func (m *manager) getProjectCalendar(projectUUID *string) (*calendarDB.Base, error) {
+	if projectUUID != nil {
+		projectBase, err := m.ProjectService.GetBySingle(&projectModel.Field{
+			ProjectUUID: *projectUUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if projectBase.CalendarUUID != nil && *projectBase.CalendarUUID != "" {
+			return m.CalendarService.GetBySingle(&calendarModel.Field{
+				ID: *projectBase.CalendarUUID,
+			})
+		}
+	}
+
+	calendarBase, err := m.CalendarService.GetBySingle(&calendarModel.Field{
+		IsDefault: util.PointerBool(true),
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return calendarBase, nil
+}
+
+// getStatusTransitions is a helper function to get the transitions of the task statuses configured by the type of the project,
+// or the default transitions if none are configured.
+func (m *manager) getStatusTransitions(projectUUID *string) (schedule.Transitions, error) {
+	var transitions map[string][]string
+	if projectUUID != nil {
+		projectBase, err := m.ProjectService.GetBySingle(&projectModel.Field{
+			ProjectUUID: *projectUUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if projectBase.ProjectTypes.StatusTransitions != nil {
+			err = util.DecodeJSONToSlice(*projectBase.ProjectTypes.StatusTransitions, &transitions)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return schedule.NewTransitions(transitions)
+}
+
+// assembleWorkCalendar is a helper function to build the working calendar from the work week, working time and holidays.
+func assembleWorkCalendar(workWeek, workingTime *string, holidayBase []holidayDB.Base) (*schedule.WorkCalendar, error) {
+	var (
+		workWeeks    []string
+		workingTimes []schedule.WorkingTime
+		holidays     []schedule.Holiday
+	)
+
+	if workWeek != nil && *workWeek != "" {
+		err := sonic.Unmarshal([]byte(*workWeek), &workWeeks)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if workingTime != nil && *workingTime != "" {
+		var times []workDayModel.WorkingTimes
+		err := sonic.Unmarshal([]byte(*workingTime), &times)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, working := range times {
+			workingTimes = append(workingTimes, schedule.WorkingTime{
+				From: float64(working.StartTime),
+				To:   float64(working.EndTime),
+			})
+		}
+	}
+
+	for _, holiday := range holidayBase {
+		if holiday.StartDate == nil {
+			continue
+		}
+
+		item := schedule.Holiday{Start: *holiday.StartDate}
+		if holiday.EndDate != nil {
+			item.End = *holiday.EndDate
+		}
+		holidays = append(holidays, item)
+	}
+
+	return schedule.NewWorkCalendar(workWeeks, workingTimes, holidays, util.LoadLocation(constants.Timezone)), nil
+}
+
+// getResourceCalendars is a helper function to build the working calendars of the resources, with their exceptions layered over the project calendar.
+func (m *manager) getResourceCalendars(calendar *schedule.WorkCalendar, resourceUUIDs []*string) (map[string]*schedule.ResourceCalendar, error) {
+	resourceCalendars := make(map[string]*schedule.ResourceCalendar)
+	if len(resourceUUIDs) == 0 {
+		return resourceCalendars, nil
+	}
+
+	exceptionBase, err := m.ResourceExceptionService.GetByListNoPagination(&resourceExceptionModel.Field{
+		ResourceUUIDs: resourceUUIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exceptionMap := make(map[string][]schedule.Exception)
+	for _, exception := range exceptionBase {
+		var workWeek []string
+		err = util.DecodeJSONToSlice(*exception.WorkWeek, &workWeek)
+		if err != nil {
+			return nil, err
+		}
+
+		item := schedule.Exception{WorkWeek: workWeek}
+		if exception.StartDate != nil {
+			item.Start = *exception.StartDate
+		}
+		if exception.EndDate != nil {
+			item.End = *exception.EndDate
+		}
+		exceptionMap[*exception.ResourceUUID] = append(exceptionMap[*exception.ResourceUUID], item)
+	}
+
+	for _, resourceUUID := range resourceUUIDs {
+		resourceCalendars[*resourceUUID] = schedule.NewResourceCalendar(calendar, exceptionMap[*resourceUUID])
+	}
+
+	return resourceCalendars, nil
+}
+
+// getResourceWarnings is a helper function to find the resources assigned to the tasks on their non-working days.
+func (m *manager) getResourceWarnings(trx *gorm.DB, projectUUID *string, taskUUIDs []*string, calendar *schedule.WorkCalendar) ([]*taskModel.ResourceWarning, error) {
+	warnings := []*taskModel.ResourceWarning{}
+	if len(taskUUIDs) == 0 {
+		return warnings, nil
+	}
+
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: projectUUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// create a map of the checked tasks
+	taskUUIDMap := make(map[string]bool)
+	for _, taskUUID := range taskUUIDs {
+		taskUUIDMap[*taskUUID] = true
+	}
+
+	var (
+		tasks         []*taskDB.Base
+		resourceUUIDs []*string
+	)
+	resourceUUIDMap := make(map[string]bool)
+	for _, task := range taskBase {
+		if !taskUUIDMap[*task.TaskUUID] || task.StartDate == nil || task.EndDate == nil {
+			continue
+		}
+
+		tasks = append(tasks, task)
+		for _, res := range task.TaskResources {
+			if !resourceUUIDMap[*res.ResourceUUID] {
+				resourceUUIDMap[*res.ResourceUUID] = true
+				resourceUUIDs = append(resourceUUIDs, res.ResourceUUID)
+			}
+		}
+	}
+
+	resourceCalendars, err := m.getResourceCalendars(calendar, resourceUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range tasks {
+		for _, res := range task.TaskResources {
+			dates := resourceCalendars[*res.ResourceUUID].Unavailable(*task.StartDate, *task.EndDate)
+			if len(dates) == 0 {
+				continue
+			}
+
+			warning := &taskModel.ResourceWarning{
+				TaskUUID:         *task.TaskUUID,
+				TaskID:           *task.TaskID,
+				ResourceUUID:     *res.ResourceUUID,
+				UnavailableDates: dates,
+			}
+			if res.Resources.Resources.ResourceName != nil {
+				warning.ResourceName = *res.Resources.Resources.ResourceName
+			}
+			warnings = append(warnings, warning)
+		}
+	}
+
+	return warnings, nil
+}
+
+// getOverallocations is a helper function to find the periods in which the resources are assigned beyond their capacity across all the projects,
+// seen from the project (see getExcludedProjects).
+func (m *manager) getOverallocations(trx *gorm.DB, projectUUID *string, resourceUUIDs []*string) ([]*resourceModel.Overallocation, error) {
+	output := []*resourceModel.Overallocation{}
+	allocation, err := m.getAllocation(trx, projectUUID, resourceUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allocation.assignments) == 0 {
+		return output, nil
+	}
+
+	// the overlaps on the non-working days of the project's calendar (the default calendar when the project is nil) are ignored
+	calendar, err := m.GetWorkCalendar(projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range schedule.Overallocations(allocation.assignments, allocation.capacities, calendar) {
+		output = append(output, allocation.assembleOverallocation(item))
+	}
+
+	return output, nil
+}
+
+// allocation is the assignments of the resources across all the projects.
+type allocation struct {
+	assignments []*schedule.Assignment
+	capacities  map[string]float64
+	resourceMap map[string]*resourceDB.Base
+	taskMap     map[string]*taskDB.Base
+}
+
+// getAllocation is a helper function to get the assignments of the resources to the dated tasks and the capacities of the resources,
+// seen from the project (see getExcludedProjects).
+func (m *manager) getAllocation(trx *gorm.DB, projectUUID *string, resourceUUIDs []*string) (*allocation, error) {
+	output := &allocation{
+		capacities:  make(map[string]float64),
+		resourceMap: make(map[string]*resourceDB.Base),
+		taskMap:     make(map[string]*taskDB.Base),
+	}
+	if len(resourceUUIDs) == 0 {
+		return output, nil
+	}
+
+	resourceService, taskResourceService, taskService := m.ResourceService, m.TaskResourceService, m.TaskService
+	if trx != nil {
+		resourceService, taskResourceService, taskService = m.ResourceService.WithTrx(trx), m.TaskResourceService.WithTrx(trx), m.TaskService.WithTrx(trx)
+	}
+
+	resourceBase, err := resourceService.GetByListNoPagination(&resourceModel.Field{
+		ResourceUUIDs: resourceUUIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, res := range resourceBase {
+		output.resourceMap[*res.ResourceUUID] = res
+		if res.MaxUnits != nil && *res.MaxUnits > 0 {
+			output.capacities[*res.ResourceUUID] = *res.MaxUnits
+		}
+	}
+
+	taskResourceBase, err := taskResourceService.GetByListNoPagination(&taskResourceModel.Field{
+		ResourceUUIDs: resourceUUIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(taskResourceBase) == 0 {
+		return output, nil
+	}
+
+	var taskUUIDs []*string
+	for _, res := range taskResourceBase {
+		taskUUIDs = append(taskUUIDs, res.TaskUUID)
+	}
+
+	taskBase, err := taskService.GetByListNoQuantity(&taskModel.Field{
+		TaskUUIDs: taskUUIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	excludedProjects, err := m.getExcludedProjects(trx, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range taskBase {
+		if task.ProjectUUID != nil && excludedProjects[*task.ProjectUUID] {
+			continue
+		}
+
+		output.taskMap[*task.TaskUUID] = task
+	}
+
+	for _, res := range taskResourceBase {
+		task := output.taskMap[*res.TaskUUID]
+		if task == nil || task.StartDate == nil || task.EndDate == nil || res.Unit == nil {
+			continue
+		}
+
+		output.assignments = append(output.assignments, &schedule.Assignment{
+			TaskUUID:     *task.TaskUUID,
+			ResourceUUID: *res.ResourceUUID,
+			Start:        *task.StartDate,
+			End:          *task.EndDate,
+			Units:        *res.Unit,
+		})
+	}
+
+	return output, nil
+}
+
+// assembleOverallocation is a helper function to transform the over-allocation with the names of its resource and tasks.
+func (a *allocation) assembleOverallocation(item *schedule.Overallocation) *resourceModel.Overallocation {
+	overallocation := &resourceModel.Overallocation{
+		ResourceUUID: item.ResourceUUID,
+		StartDate:    item.Start,
+		EndDate:      item.End,
+		Units:        item.Units,
+		MaxUnits:     item.Capacity,
+		Tasks:        make([]*resourceModel.OverallocatedTask, 0, len(item.TaskUUIDs)),
+	}
+	if res := a.resourceMap[item.ResourceUUID]; res != nil && res.ResourceName != nil {
+		overallocation.ResourceName = *res.ResourceName
+	}
+
+	for _, taskUUID := range item.TaskUUIDs {
+		task := a.taskMap[taskUUID]
+		overallocatedTask := &resourceModel.OverallocatedTask{
+			TaskUUID: taskUUID,
+			TaskID:   *task.TaskID,
+			TaskName: *task.TaskName,
+		}
+		if task.ProjectUUID != nil {
+			overallocatedTask.ProjectUUID = *task.ProjectUUID
+		}
+		overallocation.Tasks = append(overallocation.Tasks, overallocatedTask)
+	}
+
+	return overallocation
+}
+
+// GetOverallocations returns the periods in which the resources are assigned beyond their capacity across all the projects,
+// seen from the project (the live projects only when the project is nil).
+func (m *manager) GetOverallocations(projectUUID *string, resourceUUIDs []*string) ([]*resourceModel.Overallocation, error) {
+	return m.getOverallocations(nil, projectUUID, resourceUUIDs)
+}
+
+// getExcludedProjects is a helper function to get the projects whose assignments are not counted when the project is viewed:
+// the sandbox projects of the scenarios other than the project, and the live project of the scenario when the project is a sandbox.
+func (m *manager) getExcludedProjects(trx *gorm.DB, projectUUID *string) (map[string]bool, error) {
+	scenarioService := m.ProjectScenarioService
+	if trx != nil {
+		scenarioService = m.ProjectScenarioService.WithTrx(trx)
+	}
+
+	scenarioBase, err := scenarioService.GetByListNoPagination(&projectScenarioModel.Field{})
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]bool)
+	for _, scenario := range scenarioBase {
+		if projectUUID != nil && *scenario.ScenarioProjectUUID == *projectUUID {
+			output[*scenario.ProjectUUID] = true
+			continue
+		}
+
+		output[*scenario.ScenarioProjectUUID] = true
+	}
+
+	return output, nil
+}
+
+// getTaskOverallocations is a helper function to find the over-allocations which involve the tasks of the project.
+func (m *manager) getTaskOverallocations(trx *gorm.DB, projectUUID *string, taskUUIDs []*string) ([]*resourceModel.Overallocation, error) {
+	output := []*resourceModel.Overallocation{}
+	if len(taskUUIDs) == 0 {
+		return output, nil
+	}
+
+	taskResourceBase, err := m.TaskResourceService.WithTrx(trx).GetByListNoPagination(&taskResourceModel.Field{
+		TaskUUIDs: taskUUIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resourceUUIDs []*string
+	resourceUUIDMap := make(map[string]bool)
+	for _, res := range taskResourceBase {
+		if !resourceUUIDMap[*res.ResourceUUID] {
+			resourceUUIDMap[*res.ResourceUUID] = true
+			resourceUUIDs = append(resourceUUIDs, res.ResourceUUID)
+		}
+	}
+
+	overallocations, err := m.getOverallocations(trx, projectUUID, resourceUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	taskUUIDMap := make(map[string]bool)
+	for _, taskUUID := range taskUUIDs {
+		taskUUIDMap[*taskUUID] = true
+	}
+
+	for _, overallocation := range overallocations {
+		for _, task := range overallocation.Tasks {
+			if taskUUIDMap[task.TaskUUID] {
+				output = append(output, overallocation)
+				break
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// assembleOverallocated is a helper function to mark the tasks and their resources which are in the over-allocations.
+func assembleOverallocated(tasks []*taskModel.Single, overallocations []*resourceModel.Overallocation) {
+	overallocatedMap := make(map[string]bool)
+	for _, overallocation := range overallocations {
+		for _, task := range overallocation.Tasks {
+			overallocatedMap[task.TaskUUID+overallocation.ResourceUUID] = true
+		}
+	}
+
+	for _, task := range tasks {
+		for j, res := range task.Resources {
+			if overallocatedMap[task.TaskUUID+res.ResourceUUID] {
+				task.Resources[j].IsOverallocated = true
+				task.IsOverallocated = true
+			}
+		}
+	}
+}
+
+// assembleDuration is a helper function to derive the working duration from the dates, or the end date from the duration.
+func assembleDuration(calendar schedule.Calendar, start, end *time.Time, duration float64) (*time.Time, float64) {
+	if start == nil {
+		return end, duration
+	}
+
+	if end != nil {
+		if !end.Before(*start) {
+			duration = math.Round(calendar.Days(*start, *end)*100) / 100
+		}
+		return end, duration
+	}
+
+	if duration > 0 {
+		end = util.PointerTime(calendar.Add(*start, duration))
+	}
+
+	return end, duration
+}
+
+// assembleCreateDuration is a helper function to align the durations of the task to create with the working calendar.
+func assembleCreateDuration(calendar schedule.Calendar, task *taskModel.Create) {
+	if len(task.Segments) == 0 {
+		task.EndDate, task.Duration = assembleDuration(calendar, task.StartDate, task.EndDate, task.Duration)
+	}
+	task.BaselineEndDate, task.BaselineDuration = assembleDuration(calendar, task.BaselineStartDate, task.BaselineEndDate, task.BaselineDuration)
+
+	for _, subtask := range task.Subtask {
+		assembleCreateDuration(calendar, subtask)
+	}
+}
+
+// assembleUpdateDuration is a helper function to align the durations of the task to update with the working calendar.
+func assembleUpdateDuration(calendar schedule.Calendar, task *taskModel.Update, original *taskModel.Single) {
+	if len(task.Segments) > 0 {
+		return
+	}
+
+	var originalStart, originalEnd, originalBaselineStart, originalBaselineEnd *time.Time
+	if original != nil {
+		originalStart, originalEnd = original.StartDate, original.EndDate
+		originalBaselineStart, originalBaselineEnd = original.BaselineStartDate, original.BaselineEndDate
+	}
+
+	task.EndDate, task.Duration = assembleUpdatedDuration(calendar, task.StartDate, task.EndDate, task.Duration, originalStart, originalEnd)
+	task.BaselineEndDate, task.BaselineDuration = assembleUpdatedDuration(calendar, task.BaselineStartDate, task.BaselineEndDate, task.BaselineDuration, originalBaselineStart, originalBaselineEnd)
+}
+
+// assembleSplit is a helper function to check and normalize the segments against the dates and the duration of the task.
+func assembleSplit(calendar schedule.Calendar, segments []*taskModel.Segments, start, end *time.Time, duration *float64) ([]*taskModel.Segments, *schedule.Split, error) {
+	input := make([]schedule.Segment, len(segments))
+	for i, segment := range segments {
+		if segment != nil {
+			input[i] = schedule.Segment{Start: segment.StartDate, Duration: segment.Duration}
+		}
+	}
+
+	split, err := schedule.SplitSegments(input, start, end, duration, calendar)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	output := make([]*taskModel.Segments, len(split.Segments))
+	for i, segment := range split.Segments {
+		output[i] = &taskModel.Segments{
+			StartDate: segment.Start,
+			Duration:  math.Round(segment.Duration*100) / 100,
+		}
+	}
+
+	return output, split, nil
+}
+
+// assembleCreateSegments is a helper function to check and normalize the segments of the task to create,
+// deriving the dates and the duration of the segmented tasks from their segments.
+func assembleCreateSegments(calendar schedule.Calendar, task *taskModel.Create) error {
+	if len(task.Segments) > 0 {
+		var duration *float64
+		if task.Duration > 0 {
+			duration = util.PointerFloat64(task.Duration)
+		}
+
+		segments, split, err := assembleSplit(calendar, task.Segments, task.StartDate, task.EndDate, duration)
+		if err != nil {
+			return fmt.Errorf("task %s: %w", task.TaskID, err)
+		}
+
+		segJson, err := sonic.Marshal(segments)
+		if err != nil {
+			return err
+		}
+
+		task.Segments = segments
+		task.Segment = string(segJson)
+		task.StartDate = util.PointerTime(split.Start)
+		task.EndDate = util.PointerTime(split.Finish)
+		task.Duration = math.Round(split.Duration*100) / 100
+	}
+
+	for _, subtask := range task.Subtask {
+		err := assembleCreateSegments(calendar, subtask)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assembleUpdateSegments is a helper function to check and normalize the changed segments of the task to update,
+// deriving the dates and the duration of the task from its segments.
+func assembleUpdateSegments(calendar schedule.Calendar, task *taskModel.Update) error {
+	if len(task.Segments) == 0 {
+		return nil
+	}
+
+	segments, split, err := assembleSplit(calendar, task.Segments, task.StartDate, task.EndDate, task.Duration)
+	if err != nil {
+		if task.TaskID != nil {
+			return fmt.Errorf("task %s: %w", *task.TaskID, err)
+		}
+		return err
+	}
+
+	segJson, err := sonic.Marshal(segments)
+	if err != nil {
+		return err
+	}
+
+	task.Segments = segments
+	task.Segment = util.PointerString(string(segJson))
+	task.StartDate = util.PointerTime(split.Start)
+	task.EndDate = util.PointerTime(split.Finish)
+	task.Duration = util.PointerFloat64(math.Round(split.Duration*100) / 100)
+
+	return nil
+}
+
+// assembleCreateConstraint is a helper function to normalize the constraint of the task to create and check its constraint date.
+func assembleCreateConstraint(task *taskModel.Create) error {
+	constraintType, err := schedule.ParseConstraint(task.ConstraintType)
+	if err != nil {
+		return fmt.Errorf("task %s: %w", task.TaskID, err)
+	}
+
+	if schedule.RequiresDate(constraintType) && task.ConstraintDate == nil {
+		return fmt.Errorf("task %s: %w", task.TaskID, schedule.ErrMissingConstraintDate)
+	}
+
+	task.ConstraintType = constraintType
+	if !schedule.RequiresDate(constraintType) {
+		task.ConstraintDate = nil
+	}
+
+	for _, subtask := range task.Subtask {
+		err = assembleCreateConstraint(subtask)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assembleUpdateConstraint is a helper function to normalize the constraint of the task to update,
+// completing the missing constraint type or date with the original ones.
+func assembleUpdateConstraint(task *taskModel.Update, original *taskModel.Single) error {
+	var (
+		constraintType string
+		constraintDate *time.Time
+	)
+	if original != nil {
+		constraintType, constraintDate = original.ConstraintType, original.ConstraintDate
+	}
+
+	if task.ConstraintType != nil {
+		parsed, err := schedule.ParseConstraint(*task.ConstraintType)
+		if err != nil {
+			return err
+		}
+
+		task.ConstraintType = util.PointerString(parsed)
+		constraintType = parsed
+	}
+
+	if task.ConstraintDate != nil {
+		constraintDate = task.ConstraintDate
+	}
+
+	if schedule.RequiresDate(constraintType) && (constraintDate == nil || constraintDate.IsZero()) {
+		return schedule.ErrMissingConstraintDate
+	}
+
+	// the constraints without dates remove the former constraint date
+	if !schedule.RequiresDate(constraintType) && constraintDate != nil {
+		task.ConstraintDate = util.PointerTime(time.Time{})
+	}
+
+	return nil
+}
+
+// assembleCreateEstimate is a helper function to check the three-point estimate of the task to create and its subtasks.
+func assembleCreateEstimate(task *taskModel.Create) error {
+	_, err := schedule.NewEstimate(task.OptimisticDuration, task.MostLikelyDuration, task.PessimisticDuration)
+	if err != nil {
+		return fmt.Errorf("task %s: %w", task.TaskID, err)
+	}
+
+	for _, subtask := range task.Subtask {
+		err = assembleCreateEstimate(subtask)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assembleUpdateEstimate is a helper function to check the three-point estimate of the task to update,
+// completing the missing durations with the original ones.
+func assembleUpdateEstimate(task *taskModel.Update, original *taskModel.Single) error {
+	if task.OptimisticDuration == nil && task.MostLikelyDuration == nil && task.PessimisticDuration == nil {
+		return nil
+	}
+
+	optimistic, mostLikely, pessimistic := task.OptimisticDuration, task.MostLikelyDuration, task.PessimisticDuration
+	if original != nil {
+		if optimistic == nil {
+			optimistic = original.OptimisticDuration
+		}
+		if mostLikely == nil {
+			mostLikely = original.MostLikelyDuration
+		}
+		if pessimistic == nil {
+			pessimistic = original.PessimisticDuration
+		}
+	}
+
+	_, err := schedule.NewEstimate(optimistic, mostLikely, pessimistic)
+	return err
+}
+
+// assembleCreateStatus is a helper function to resolve the status and the progress of the task to create and its subtasks.
+func assembleCreateStatus(task *taskModel.Create) error {
+	var status *string
+	if task.Status != "" {
+		status = util.PointerString(task.Status)
+	}
+
+	var progress *int64
+	if task.Progress != 0 {
+		progress = util.PointerInt64(task.Progress)
+	}
+
+	resolved, resolvedProgress, err := schedule.ResolveStatus("", 0, status, progress, schedule.DefaultTransitions())
+	if err != nil {
+		return fmt.Errorf("task %s: %w", task.TaskID, err)
+	}
+	task.Status, task.Progress = resolved, resolvedProgress
+
+	for _, subtask := range task.Subtask {
+		err = assembleCreateStatus(subtask)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assembleUpdateStatus is a helper function to check the change of the status and the progress of the task to update
+// against the transitions of its project type, recording who changed the status and when.
+func assembleUpdateStatus(task *taskModel.Update, original *taskModel.Single, transitions schedule.Transitions) error {
+	if original == nil || (task.Status == nil && task.Progress == nil) {
+		return nil
+	}
+
+	status, progress, err := schedule.ResolveStatus(original.Status, original.Progress, task.Status, task.Progress, transitions)
+	if err != nil {
+		return fmt.Errorf("task %s: %w", original.TaskID, err)
+	}
+
+	if status != original.Status {
+		task.Status = util.PointerString(status)
+		task.StatusChangedBy = task.UpdatedBy
+		task.StatusChangedAt = util.PointerTime(util.NowToUTC())
+	} else {
+		task.Status = nil
+	}
+
+	if task.Progress != nil || progress != original.Progress {
+		task.Progress = util.PointerInt64(progress)
+	}
+
+	return nil
+}
+
+// assembleUpdatedDuration is a helper function to derive the end date and the duration of the changed dates,
+// completing the missing dates with the original ones.
+func assembleUpdatedDuration(calendar schedule.Calendar, start, end *time.Time, duration *float64, originalStart, originalEnd *time.Time) (*time.Time, *float64) {
+	// nothing to align when neither the dates nor the duration are changed
+	if start == nil && end == nil && duration == nil {
+		return end, duration
+	}
+
+	if start == nil {
+		start = originalStart
+	}
+
+	// a changed duration moves the end date unless the end date is also given
+	if end == nil && duration == nil {
+		end = originalEnd
+	}
+
+	var days float64
+	if duration != nil {
+		days = *duration
+	}
+
+	newEnd, days := assembleDuration(calendar, start, end, days)
+	if start == nil || newEnd == nil {
+		return end, duration
+	}
+
+	return newEnd, util.PointerFloat64(days)
+}
+
+// parseImportDate is a helper function to parse the date of the imported file, e.g. "2024/3/4" or "2024-03-04", at noon.
+func parseImportDate(value string) (time.Time, error) {
+	var dateString string
+	for _, v := range strings.Split(strings.ReplaceAll(strings.TrimSpace(value), "/", "-"), "-") {
+		if len(v) == 1 {
+			dateString += "0" + v + "-"
+		} else {
+			dateString += v + "-"
+		}
+	}
+	dateString = dateString[0 : len(dateString)-1]
+
+	return time.Parse("2006-01-02 15:04:05", dateString+" 12:00:00")
+}
+
+// parseDuration is a helper function to parse the duration of the imported file, e.g. "5", "5天", "5 days" or "5d".
+func parseDuration(value string) (float64, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for _, unit := range []string{"天", "days", "day", "d"} {
+		value = strings.TrimSpace(strings.TrimSuffix(value, unit))
+	}
+
+	duration, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration: %s", value)
+	}
+
+	return duration, nil
+}
+
+// parseMilestone is a helper function to parse the milestone flag of the imported file, e.g. "Yes", "true", "1" or "是".
+func parseMilestone(value string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "no", "n", "false", "0", "否":
+		return false, nil
+	case "yes", "y", "true", "1", "是":
+		return true, nil
+	}
+
+	return false, fmt.Errorf("invalid milestone: %s", value)
+}
+
+// generateNewOutlineNumber is a helper function used to generate a new outline number within the "getNextOutlineNumber" function.
+func generateNewOutlineNumber(isSubtask bool, lastOutlineNumber string) (string, error) {
+	var newOutlineNumber string
+	outlines := strings.Split(lastOutlineNumber, ".")
+
+	if isSubtask {
+		lastTaskOutline, err := strconv.Atoi(outlines[len(outlines)-1])
+		if err != nil {
+			log.Error(err)
+			return "", err
+		}
+		newOutlineNumber = fmt.Sprintf("%s.%d", strings.Join(outlines[:len(outlines)-1], "."), lastTaskOutline+1)
+
+	} else {
+		lastTaskOutline, err := strconv.Atoi(outlines[0])
+		if err != nil {
+			log.Error(err)
+			return "", err
+		}
+		newOutlineNumber = strconv.Itoa(lastTaskOutline + 1)
+	}
+	return newOutlineNumber, nil
+}
+
+// GetParentOutlineNumber extracts the parent outline number, e.g. 1.2 of 1.2.3.
+func GetParentOutlineNumber(outlineNumber string) string {
+	parts := strings.Split(outlineNumber, ".")
+	if len(parts) > 1 {
+		return strings.Join(parts[:len(parts)-1], ".")
+	}
+	return ""
+}
+
+// findParentTaskByOutlineNumber is a helper function to find the parent task by outline number.
+func findParentTaskByOutlineNumber(tasks []*taskModel.Single, outlineNumber string) *taskModel.Single {
+	for _, task := range tasks {
+		if task.OutlineNumber == outlineNumber {
+			return task
+		}
+	}
+	return nil
+}
+
+// assembleToCreateAll is a helper function to recursively assemble a hierarchical structure of tasks.
+func assembleToCreateAll(tasks []*taskModel.Create, taskRecordIdx map[string]int, task *taskModel.Create, outlineNumber, selectOutlineNumber, projectID string, resUUID, role *string) []*taskModel.Create {
+	task.ProjectUUID = projectID
+	outlineNumberSplit := strings.Split(outlineNumber, ".")
+
+	// handle the main task
+	if len(outlineNumberSplit) == 1 {
+		// record the outline_number of the current main task
+		if outlineNumber != "" {
+			selectOutlineNumber += "." + outlineNumber
+		}
+		// map the outline_number of the current main task to the index position of allTask
+		taskRecordIdx[selectOutlineNumber] = len(tasks)
+		tasks = append(tasks, task)
+	} else {
+		// handle the current subtask
+		var newOutlineNumber string
+		for i := 1; i < len(outlineNumberSplit); i++ {
+			newOutlineNumber += outlineNumberSplit[i]
+			// if it is not the last subtask, add a separator to the outline_number
+			if i != len(outlineNumberSplit)-1 {
+				newOutlineNumber += "."
+			}
+		}
+
+		// append the outline_number of the current subtask to the outline_number of its parent task
+		if selectOutlineNumber == "" {
+			selectOutlineNumber = outlineNumberSplit[0]
+		} else {
+			selectOutlineNumber += "." + outlineNumberSplit[0]
+		}
+
+		// handle possible subtasks
+		subTasks := assembleToCreateAll(tasks[taskRecordIdx[selectOutlineNumber]].Subtask, taskRecordIdx, task, newOutlineNumber, selectOutlineNumber, projectID, resUUID, role)
+		tasks[taskRecordIdx[selectOutlineNumber]].Subtask = subTasks
+	}
+
+	for _, task := range tasks {
+		if resUUID != nil {
+			task.ResUUID = resUUID
+		}
+		if role != nil {
+			task.Role = role
+		}
+	}
+
+	return tasks
+}
+
+func (m *manager) Create(trx *gorm.DB, input *taskModel.Create) (int, any) {
+	defer trx.Rollback()
+
+	// get the project's info
+	projectBase, err := m.ProjectService.GetBySingle(&projectModel.Field{
+		ProjectUUID: input.ProjectUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check if the user is a project member
+	proResBase, err := m.ProjectResourceService.GetBySingle(&projectResourceModel.Field{
+		ProjectUUID:  util.PointerString(input.ProjectUUID),
+		ResourceUUID: input.ResUUID,
+	})
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	// if the user is a project member, check if the user can edit or delete tasks of the project
+	if proResBase != nil {
+		if !*proResBase.IsEditable {
+			log.Error("You are not allowed to create tasks for this project.")
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "You are not allowed to create tasks for this project.")
+		}
+	} else {
+		// if the user is not a project member, check if the user is an admin or the creator of the project
+		if *input.Role != "admin" {
+			if *projectBase.CreatedBy != input.CreatedBy {
+				log.Error("You are not allowed to create tasks for this project.")
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "You are not allowed to create tasks for this project.")
+			}
+		}
+	}
+
+	// determine if the task is a subtask
+	if input.ParentUUID != nil {
+		parentBase, err := m.TaskService.GetBySingle(&taskModel.Field{
+			TaskUUID: *input.ParentUUID,
+		})
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+		input.ProjectUUID = *parentBase.ProjectUUID
+
+		// get the last outline_number of the same level
+		input.OutlineNumber, err = m.getNextOutlineNumber(true, parentBase.OutlineNumber, input.ProjectUUID)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+		input.IsSubTask = true
+
+		// check if it is the most sub-task
+		if parentBase.Segment != nil {
+			if *parentBase.Segment != "" {
+				log.Info("Please remove the task segmentation first.")
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "Please remove the task segmentation first.")
+			}
+		}
+
+		// transform segments from struct array to string
+		if len(input.Segments) > 0 {
+			segJson, _ := sonic.Marshal(input.Segments)
+			input.Segment = string(segJson)
+		}
+
+		// transform indicators from struct array to string
+		if len(input.Indicators) > 0 {
+			indJson, _ := sonic.Marshal(input.Indicators)
+			input.Indicator = string(indJson)
+		}
+
+	} else {
+		// the main task with subtasks cannot be segmented
+		if len(input.Segments) > 0 {
+			if len(input.Subtask) > 0 {
+				log.Info("The parent task cannot be segmented.")
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The parent task cannot be segmented.")
+			}
+
+			// transform segments from struct array to string
+			segJson, _ := sonic.Marshal(input.Segments)
+			input.Segment = string(segJson)
+		}
+
+		// transform indicators from struct array to string
+		if len(input.Indicators) > 0 {
+			indJson, _ := sonic.Marshal(input.Indicators)
+			input.Indicator = string(indJson)
+		}
+
+		// get the new outline_number
+		newOutlineNumber, err := m.getNextOutlineNumber(false, nil, input.ProjectUUID)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+		input.OutlineNumber = newOutlineNumber
+	}
+
+	// get the working calendar
+	calendar, err := m.GetWorkCalendar(util.PointerString(input.ProjectUUID))
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check the segments and derive the dates of the segmented tasks from them
+	err = assembleCreateSegments(calendar, input)
+	if err != nil {
+		if errors.Is(err, schedule.ErrInvalidSegments) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	assembleCreateDuration(calendar, input)
+
+	// normalize the constraints with their dates
+	err = assembleCreateConstraint(input)
+	if err != nil {
+		log.Info(err.Error())
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+	}
+
+	// check the three-point estimates
+	err = assembleCreateEstimate(input)
+	if err != nil {
+		log.Info(err.Error())
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+	}
+
+	// resolve the statuses with the progress
+	err = assembleCreateStatus(input)
+	if err != nil {
+		log.Info(err.Error())
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+	}
+
+	// the recurring task becomes the summary task of its occurrences
+	var occurrences []*taskModel.Create
+	if input.Recurrence != nil {
+		occurrences, err = m.generateOccurrences(trx, input, calendar)
+		if err != nil {
+			if errors.Is(err, schedule.ErrInvalidRecurrence) {
+				log.Info(err.Error())
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	taskBase, err := m.TaskService.WithTrx(trx).Create(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	var taskResMapList []map[string][]*resourceModel.TaskSingle
+	if len(input.Resources) > 0 {
+		taskResMapList = append(taskResMapList, map[string][]*resourceModel.TaskSingle{
+			*taskBase.TaskUUID: input.Resources,
+		})
+	}
+
+	taskUUIDs := []*string{taskBase.TaskUUID}
+	var recurringTasks []*taskModel.RecurringTask
+	if len(occurrences) > 0 {
+		occurrenceBase, err := m.TaskService.WithTrx(trx).CreateAll(occurrences)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		for i, occurrence := range occurrenceBase {
+			taskUUIDs = append(taskUUIDs, occurrence.TaskUUID)
+			recurringTasks = append(recurringTasks, assembleRecurringTask(occurrence))
+			if len(occurrences[i].Resources) > 0 {
+				taskResMapList = append(taskResMapList, map[string][]*resourceModel.TaskSingle{
+					*occurrence.TaskUUID: occurrences[i].Resources,
+				})
+			}
+		}
+	}
+
+	if len(taskResMapList) > 0 {
+		// get all resources of the project
+		proResBase, err := m.ProjectResourceService.GetByListNoPagination(&projectResourceModel.Field{
+			ProjectUUID: util.PointerString(input.ProjectUUID),
+		})
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Error(err)
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			}
+		}
+
+		var proRes []*projectResourceModel.Single
+		proResByte, err := sonic.Marshal(proResBase)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		err = sonic.Unmarshal(proResByte, &proRes)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		// create a map of resourceUUID
+		proResMap := make(map[string]*projectResourceModel.Single)
+		if len(proRes) > 0 {
+			for _, res := range proRes {
+				proResMap[res.ResourceUUID] = res
+			}
+		}
+
+		// sync create task_resource
+		err = m.SyncCreateTaskResources(trx, taskResMapList, input.CreatedBy, input.ProjectUUID, proResMap)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	// sync update project's start and end dates
+	err = m.SyncUpdateProjectStartEndDate(trx, util.PointerString(input.ProjectUUID), nil, input.BaselineStartDate, input.BaselineEndDate)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync task_dependencies
+	err = m.SyncTaskDependencies(trx, util.PointerString(input.ProjectUUID), input.CreatedBy)
+	if err != nil {
+		if schedule.IsInvalid(err) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync roll up the summary tasks from their subtasks
+	summaryTasks, err := m.syncRollUpSummaryTasks(trx, util.PointerString(input.ProjectUUID), input.CreatedBy)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check the resources assigned on their non-working days
+	warnings, err := m.getResourceWarnings(trx, util.PointerString(input.ProjectUUID), taskUUIDs, calendar)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check the resources assigned beyond their capacity
+	overallocations, err := m.getTaskOverallocations(trx, util.PointerString(input.ProjectUUID), taskUUIDs)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, &taskModel.Result{
+		TaskUUID:        *taskBase.TaskUUID,
+		MovedTasks:      []*taskModel.MovedTask{},
+		Warnings:        warnings,
+		Overallocations: overallocations,
+		SummaryTasks:    summaryTasks,
+		RecurringTasks:  recurringTasks,
+	})
+}
+
+func (m *manager) CreateAll(trx *gorm.DB, input []*taskModel.Create) (int, any) {
+	defer trx.Rollback()
+
+	var (
+		createList                       []*taskModel.Create
+		taskResMapList                   []map[string][]*resourceModel.TaskSingle
+		minBaselineStart, maxBaselineEnd *time.Time
+	)
+
+	// get all resources of the project
+	proResBase, err := m.ProjectResourceService.GetByListNoPagination(&projectResourceModel.Field{
+		ProjectUUID: util.PointerString(input[0].ProjectUUID),
+	})
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	var proRes []*projectResourceModel.Single
+	proResByte, err := sonic.Marshal(proResBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(proResByte, &proRes)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// create a map of resourceUUID
+	proResMap := make(map[string]*projectResourceModel.Single)
+	if len(proRes) > 0 {
+		for _, res := range proRes {
+			proResMap[res.ResourceUUID] = res
+		}
+	}
+
+	// get the new outline_number
+	topOutlineNumber, err := m.getNextOutlineNumber(false, nil, input[0].ProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	newOutlineNumber, err := strconv.Atoi(topOutlineNumber)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// get the project's info
+	projectBase, err := m.ProjectService.GetBySingle(&projectModel.Field{
+		ProjectUUID: input[0].ProjectUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// if the user is a project member, check if the user can edit or delete tasks of the project
+	if proResMap[*input[0].ResUUID] != nil {
+		if !proResMap[*input[0].ResUUID].IsEditable {
+			log.Error("You are not allowed to create tasks for this project.")
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "You are not allowed to create tasks for this project.")
+		}
+	} else {
+		// if the user is not a project member, check if the user is an admin or the creator of the project
+		if *input[0].Role != "admin" {
+			if *projectBase.CreatedBy != input[0].CreatedBy {
+				log.Error("You are not allowed to create tasks for this project.")
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "You are not allowed to create tasks for this project.")
+			}
+		}
+	}
+
+	// get the working calendar
+	calendar, err := m.GetWorkCalendar(util.PointerString(input[0].ProjectUUID))
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// create the main task
+	for i, inputBody := range input {
+		// check the segments and derive the dates of the segmented tasks from them
+		err = assembleCreateSegments(calendar, inputBody)
+		if err != nil {
+			if errors.Is(err, schedule.ErrInvalidSegments) {
+				log.Info(err.Error())
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		// align the durations with the working calendar
+		assembleCreateDuration(calendar, inputBody)
+
+		// normalize the constraints with their dates
+		err = assembleCreateConstraint(inputBody)
+		if err != nil {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		// check the three-point estimates
+		err = assembleCreateEstimate(inputBody)
+		if err != nil {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		// resolve the statuses with the progress
+		err = assembleCreateStatus(inputBody)
+		if err != nil {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		if inputBody.BaselineStartDate != nil && inputBody.BaselineEndDate != nil {
+			// get the minimum baseline_start_date
+			if minBaselineStart == nil || inputBody.BaselineStartDate.Before(*minBaselineStart) {
+				minBaselineStart = inputBody.BaselineStartDate
+			}
+
+			// get the maximum baseline_end_date
+			if maxBaselineEnd == nil || inputBody.BaselineEndDate.After(*maxBaselineEnd) {
+				maxBaselineEnd = inputBody.BaselineEndDate
+			}
+		}
+
+		// the main task with subtasks cannot be segmented
+		if len(inputBody.Segments) > 0 {
+			if len(inputBody.Subtask) > 0 {
+				log.Info("The parent task cannot be segmented.")
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The parent task cannot be segmented.")
+			}
+
+			// transform segments from struct array to string
+			segJson, _ := sonic.Marshal(inputBody.Segments)
+			inputBody.Segment = string(segJson)
+		}
+
+		// transform indicators from struct array to string
+		if len(inputBody.Indicators) > 0 {
+			indJson, _ := sonic.Marshal(inputBody.Indicators)
+			inputBody.Indicator = string(indJson)
+		}
+
+		// get the new outline_number
+		inputBody.OutlineNumber = strconv.Itoa(newOutlineNumber + i)
+		createList = append(createList, inputBody)
+
+		// create subtasks
+		if len(inputBody.Subtask) > 0 {
+			subSubtasks, subMinBaselineStart, subMaxBaselineEnd, err := m.createSubtasks(trx, inputBody, inputBody.Subtask, projectBase.StartDate, projectBase.EndDate, minBaselineStart, maxBaselineEnd)
+			if err != nil {
+				if err.Error() == "the parent task cannot be segmented" {
+					return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The parent task cannot be segmented.")
+				}
+
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			}
+			createList = append(createList, subSubtasks...)
+
+			// compare the minimum baseline_start_date and maximum baseline_end_date of the subtasks
+			if inputBody.BaselineStartDate != nil && inputBody.BaselineEndDate != nil {
+				if minBaselineStart == nil || inputBody.BaselineStartDate.Before(*subMinBaselineStart) {
+					minBaselineStart = inputBody.BaselineStartDate
+				}
+				if maxBaselineEnd == nil || inputBody.BaselineEndDate.After(*subMaxBaselineEnd) {
+					maxBaselineEnd = inputBody.BaselineEndDate
+				}
+			}
+		}
+	}
+
+	tasksBase, err := m.TaskService.WithTrx(trx).CreateAll(createList)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync create task_resource
+	for i, taskBase := range tasksBase {
+		if len(createList[i].Resources) > 0 {
+			taskResources := make(map[string][]*resourceModel.TaskSingle)
+			taskResources[*taskBase.TaskUUID] = createList[i].Resources
+			taskResMapList = append(taskResMapList, taskResources)
+		}
+	}
+
+	// sync create task_resource
+	if len(taskResMapList) > 0 {
+		err = m.SyncCreateTaskResources(trx, taskResMapList, createList[0].CreatedBy, createList[0].ProjectUUID, proResMap)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	// sync update project's start and end dates
+	err = m.SyncUpdateProjectStartEndDate(trx, util.PointerString(input[0].ProjectUUID), nil, minBaselineStart, maxBaselineEnd)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync task_dependencies
+	err = m.SyncTaskDependencies(trx, util.PointerString(input[0].ProjectUUID), input[0].CreatedBy)
+	if err != nil {
+		if schedule.IsInvalid(err) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync roll up the summary tasks from their subtasks
+	summaryTasks, err := m.syncRollUpSummaryTasks(trx, util.PointerString(input[0].ProjectUUID), input[0].CreatedBy)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check the resources assigned on their non-working days
+	var taskUUIDs []*string
+	for _, taskBase := range tasksBase {
+		taskUUIDs = append(taskUUIDs, taskBase.TaskUUID)
+	}
+
+	warnings, err := m.getResourceWarnings(trx, util.PointerString(input[0].ProjectUUID), taskUUIDs, calendar)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check the resources assigned beyond their capacity
+	overallocations, err := m.getTaskOverallocations(trx, util.PointerString(input[0].ProjectUUID), taskUUIDs)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, &taskModel.Result{
+		MovedTasks:      []*taskModel.MovedTask{},
+		Warnings:        warnings,
+		Overallocations: overallocations,
+		SummaryTasks:    summaryTasks,
+	})
+}
+
+func (m *manager) GetByProjectListNoPagination(input *taskModel.ProjectIDs) (int, any) {
+	output := &taskModel.List{}
+
+	// get projects
+	projectBase, err := m.ProjectService.GetByListNoPagination(&projectModel.Field{
+		ProjectUUIDs: input.Projects,
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if len(projectBase) == 0 {
+		log.Error("record not found")
+		return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, "record not found")
+	}
+
+	var projects []*projectModel.Single
+	projectByte, err := sonic.Marshal(projectBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(projectByte, &projects)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// create a map of projectUUID
+	projectMap := make(map[string]*projectModel.Single)
+	for _, project := range projects {
+		projectMap[project.ProjectUUID] = project
+	}
+
+	// check the user's permission of the project
+	isPM := false
+	isEditable := false
+	if len(input.Projects) == 1 {
+		if *input.Role != "admin" {
+			// check the user can edit or delete tasks of the project
+			proResBase, err := m.ProjectResourceService.GetBySingle(&projectResourceModel.Field{
+				ProjectUUID:  input.Projects[0],
+				ResourceUUID: input.ResUUID,
+			})
+			if err != nil {
+				if !errors.Is(err, gorm.ErrRecordNotFound) {
+					log.Error(err)
+					return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+				}
+			}
+
+			if proResBase != nil {
+				// check if the user has permission to edit or delete the project
+				if *proResBase.IsEditable {
+					isEditable = true
+				}
+
+				// check if the user is a project manager
+				if *proResBase.Role == "PM" {
+					isPM = true
+				}
+			}
+		}
+	}
+
+	// get project tasks
+	projectTaskMap := make(map[*string][]*taskModel.Single)
+	var (
+		wg               sync.WaitGroup
+		mu               sync.Mutex
+		projectStartDate *time.Time
+	)
+
+	// get the working calendars of the projects
+	calendarMap := make(map[string]*schedule.WorkCalendar)
+	for _, projectsUUID := range input.Projects {
+		calendar, err := m.GetWorkCalendar(projectsUUID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+		calendarMap[*projectsUUID] = calendar
+	}
+
+	// get the tasks of the selected baselines of the projects
+	baselineMap := make(map[string]map[string]*baselineTaskDB.Base)
+	for _, projectsUUID := range input.Projects {
+		baselineTasks, err := m.getSelectedBaseline(projectsUUID)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+		baselineMap[*projectsUUID] = baselineTasks
+	}
+
+	// make an error channel
+	goroutineErr := make(chan error)
+	// create goroutine
+	for _, projectsUUID := range input.Projects {
+		wg.Add(1)
+		go func(projectsUUID *string) {
+			defer wg.Done()
+			mu.Lock()
+			defer mu.Unlock()
+
+			// get tasks
+			taskBase, err := m.TaskService.GetByListNoPagination(&taskModel.Field{
+				ProjectUUID: projectsUUID,
+				Filter: taskModel.Filter{
+					FilterMilestone: input.FilterMilestone,
+				},
+			})
+			if err != nil {
+				log.Error(err)
+				goroutineErr <- err
+			}
+
+			var projectTasks []*taskModel.Single
+			taskByte, err := sonic.Marshal(taskBase)
+			if err != nil {
+				log.Error(err)
+				goroutineErr <- err
+			}
+
+			err = sonic.Unmarshal(taskByte, &projectTasks)
+			if err != nil {
+				log.Error(err)
+				goroutineErr <- err
+			}
+
+			// create a map of taskUUID
+			taskMap := make(map[string]*taskModel.Single)
+			for _, task := range projectTasks {
+				taskMap[task.TaskUUID] = task
+			}
+
+			for i, task := range projectTasks {
+				task.CreatedBy = *taskBase[i].CreatedByUsers.Name
+				task.UpdatedBy = *taskBase[i].UpdatedByUsers.Name
+				task.StatusChangedBy = *taskBase[i].StatusChangedByUsers.Name
+				for j, file := range taskBase[i].S3Files {
+					task.Files[j].CreatedBy = *file.CreatedByUsers.Name
+				}
+
+				// transform segments to array
+				var segments []taskModel.Segments
+				err = util.DecodeJSONToSlice(*taskBase[i].Segment, &segments)
+				if err != nil {
+					log.Error(err)
+					goroutineErr <- err
+				}
+				task.Segments = segments
+
+				// transform indicator to array
+				var indicators []taskModel.Indicators
+				err = util.DecodeJSONToSlice(*taskBase[i].Indicator, &indicators)
+				if err != nil {
+					log.Error(err)
+					goroutineErr <- err
+				}
+				task.Indicators = indicators
+				if len(indicators) > 0 {
+					task.IndicatorsName = indicators[0].Name
+					task.IndicatorsIconClass = indicators[0].IconClass
+					task.IndicatorsToolTip = indicators[0].ToolTip
+				}
+
+				// transform recurrence rule to object
+				err = util.DecodeJSONToSlice(*taskBase[i].RecurrenceRule, &task.Recurrence)
+				if err != nil {
+					log.Error(err)
+					goroutineErr <- err
+				}
+
+				for j, res := range taskBase[i].TaskResources {
+					task.Resources[j].ResourceUUID = *res.Resources.ResourceUUID
+					task.Resources[j].ResourceID = *res.Resources.Resources.ResourceID
+					task.Resources[j].ResourceName = *res.Resources.Resources.ResourceName
+					task.Resources[j].Email = *res.Resources.Resources.Email
+					task.Resources[j].Phone = *res.Resources.Resources.Phone
+					task.Resources[j].StandardCost = *res.Resources.Resources.StandardCost
+					task.Resources[j].TotalCost = *res.Resources.Resources.TotalCost
+					task.Resources[j].TotalLoad = *res.Resources.Resources.TotalLoad
+					task.Resources[j].IsExpand = *res.Resources.Resources.IsExpand
+					task.Resources[j].Role = *res.Resources.Role
+
+					// transform resource_groups to array
+					var resourceGroup []string
+					err = sonic.Unmarshal([]byte(*res.Resources.Resources.ResourceGroup), &resourceGroup)
+					if err != nil {
+						log.Error(err)
+						goroutineErr <- err
+					}
+					task.Resources[j].ResourceGroups = resourceGroup
+				}
+
+				// check the user can edit or delete the task
+				if *input.Role == "admin" || isPM {
+					task.IsEditable = true
+				} else {
+					if *taskBase[i].CreatedBy == *input.UserID {
+						task.IsEditable = true
+					} else {
+						for _, res := range task.Resources {
+							if res.ResourceUUID == *input.ResUUID {
+								task.IsEditable = true
+								break
+							}
+						}
+					}
+				}
+
+				if !input.FilterMilestone {
+					// determine if the task is a subtask
+					if task.IsSubTask {
+						// get the parent outline number
+						parentOutlineNumber := GetParentOutlineNumber(*taskBase[i].OutlineNumber)
+						if parentOutlineNumber != "" {
+							// find tasks with the same parent outline number
+							parentTask := findParentTaskByOutlineNumber(projectTasks, parentOutlineNumber)
+							if parentTask != nil {
+								parentTask.Subtask = append(parentTask.Subtask, taskMap[task.TaskUUID])
+							}
+						}
+					}
+				}
+			}
+
+			// fill in the baseline fields with the selected baseline
+			if baselineTasks := baselineMap[*projectsUUID]; baselineTasks != nil {
+				for _, task := range projectTasks {
+					assembleBaseline(task, baselineTasks[task.TaskUUID])
+				}
+			}
+
+			// mark the resources assigned on their non-working days
+			var resourceUUIDs []*string
+			resourceUUIDMap := make(map[string]bool)
+			for _, task := range projectTasks {
+				for _, res := range task.Resources {
+					if !resourceUUIDMap[res.ResourceUUID] {
+						resourceUUIDMap[res.ResourceUUID] = true
+						resourceUUIDs = append(resourceUUIDs, util.PointerString(res.ResourceUUID))
+					}
+				}
+			}
+
+			resourceCalendars, err := m.getResourceCalendars(calendarMap[*projectsUUID], resourceUUIDs)
+			if err != nil {
+				log.Error(err)
+				goroutineErr <- err
+			}
+
+			for _, task := range projectTasks {
+				if task.StartDate == nil || task.EndDate == nil {
+					continue
+				}
+
+				for j, res := range task.Resources {
+					if resourceCalendar := resourceCalendars[res.ResourceUUID]; resourceCalendar != nil {
+						task.Resources[j].UnavailableDates = resourceCalendar.Unavailable(*task.StartDate, *task.EndDate)
+					}
+				}
+			}
+
+			// mark the resources assigned beyond their capacity across the projects
+			overallocations, err := m.getOverallocations(nil, projectsUUID, resourceUUIDs)
+			if err != nil {
+				log.Error(err)
+				goroutineErr <- err
+			}
+			assembleOverallocated(projectTasks, overallocations)
+
+			// the critical path of a project which has invalid predecessors is skipped
+			if !input.FilterMilestone {
+				_, err = m.assembleCriticalPath(projectsUUID, projectTasks, calendarMap[*projectsUUID])
+				if err != nil {
+					log.Error(err)
+				}
+			}
+
+			projectTaskMap[projectsUUID] = projectTasks
+		}(projectsUUID)
+	}
+
+	// wait until all goroutines are finished
+	wg.Wait()
+	close(goroutineErr)
+
+	// check if goroutine has error
+	err = <-goroutineErr
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for _, projectsUUID := range input.Projects {
+		if len(projectTaskMap[projectsUUID]) > 0 {
+			if len(input.Projects) == 1 {
+				// if the user is admin or project manager, check if the user can edit or delete tasks of the project
+				if *input.Role == "admin" || isPM || isEditable {
+					output.IsEditable = true
+				} else {
+					// if the user is not a project member, check if the user is the creator of the project
+					if projectMap[*projectsUUID].CreatedBy == *input.UserID {
+						output.IsEditable = true
+					}
+				}
+
+				if !input.FilterMilestone {
+					// filter out the subtasks that have been included in each SubTask
+					var filteredTasks []*taskModel.Single
+					for _, task := range projectTaskMap[projectsUUID] {
+						if !task.IsSubTask {
+							filteredTasks = append(filteredTasks, task)
+						}
+					}
+					output.Tasks = filteredTasks
+				} else {
+					output.Tasks = projectTaskMap[projectsUUID]
+				}
+				// return project status
+				output.ProjectStatus = *projectBase[0].Status
+
+				// get project start date
+				for _, task := range output.Tasks {
+					if task.BaselineStartDate != nil {
+						if projectStartDate == nil || task.BaselineStartDate.Before(*projectStartDate) {
+							projectStartDate = task.BaselineStartDate
+						}
+					}
+
+					if task.StartDate != nil {
+						if projectStartDate == nil || task.StartDate.Before(*projectStartDate) {
+							projectStartDate = task.StartDate
+						}
+					}
+
+					if task.Subtask != nil {
+						for _, subtask := range task.Subtask {
+							if subtask.BaselineStartDate != nil {
+								if projectStartDate == nil || subtask.BaselineStartDate.Before(*projectStartDate) {
+									projectStartDate = subtask.BaselineStartDate
+								}
+							}
+
+							if subtask.StartDate != nil {
+								if projectStartDate == nil || subtask.StartDate.Before(*projectStartDate) {
+									projectStartDate = subtask.StartDate
+								}
+							}
+						}
+					}
+				}
+				output.ProjectStartDate = projectStartDate
+
+			} else {
+				projectTask := &taskModel.Single{
+					TaskUUID:  projectMap[*projectsUUID].ProjectUUID,
+					TaskID:    projectMap[*projectsUUID].ProjectID,
+					TaskName:  projectMap[*projectsUUID].ProjectName,
+					IsSubTask: false,
+				}
+
+				var projectSubtasks []*taskModel.Single
+				for _, task := range projectTaskMap[projectsUUID] {
+					projectSubtasks = append(projectSubtasks, task)
+				}
+				projectTask.Subtask = projectSubtasks
+
+				if !input.FilterMilestone {
+					// filter out the subtasks that have been included in each SubTask
+					var filteredTasks []*taskModel.Single
+					for _, task := range projectTask.Subtask {
+						task.Predecessor = ""
+						if !task.IsSubTask {
+							filteredTasks = append(filteredTasks, task)
+						}
+					}
+					projectTask.Subtask = filteredTasks
+				} else {
+					projectTask.Subtask = projectSubtasks
+				}
+
+				// add project to output.Tasks
+				output.Tasks = append(output.Tasks, projectTask)
+
+				// get project start date
+				for _, task := range output.Tasks {
+					if task.BaselineStartDate != nil {
+						if projectStartDate == nil || task.BaselineStartDate.Before(*projectStartDate) {
+							projectStartDate = task.BaselineStartDate
+						}
+					}
+
+					if task.StartDate != nil {
+						if projectStartDate == nil || task.StartDate.Before(*projectStartDate) {
+							projectStartDate = task.StartDate
+						}
+					}
+
+					if task.Subtask != nil {
+						for _, subtask := range task.Subtask {
+							if subtask.BaselineStartDate != nil {
+								if projectStartDate == nil || subtask.BaselineStartDate.Before(*projectStartDate) {
+									projectStartDate = subtask.BaselineStartDate
+								}
+							}
+
+							if subtask.StartDate != nil {
+								if projectStartDate == nil || subtask.StartDate.Before(*projectStartDate) {
+									projectStartDate = subtask.StartDate
+								}
+							}
+						}
+					}
+				}
+				output.ProjectStartDate = projectStartDate
+			}
+		} else {
+			if len(input.Projects) == 1 {
+				// return project status
+				output.ProjectStatus = *projectBase[0].Status
+
+				// if the user is admin or project manager, check if the user can edit or delete tasks of the project
+				if *input.Role == "admin" || isPM || isEditable {
+					output.IsEditable = true
+				} else {
+					// if the user is not a project member, check if the user is the creator of the project
+					if projectMap[*projectsUUID].CreatedBy == *input.UserID {
+						output.IsEditable = true
+					}
+				}
+			}
+		}
+
+		// check if output.Tasks is null, return empty array
+		if output.Tasks == nil {
+			output.Tasks = []*taskModel.Single{}
+		}
+
+		// get event_marks
+		var eventMarks []*eventMarkModel.Single
+		eventMarkBase, err := m.EventMarkService.GetByListNoPagination(&eventMarkModel.Field{
+			ProjectUUID: projectsUUID,
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+		eventMarkByte, err := sonic.Marshal(eventMarkBase)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		err = sonic.Unmarshal(eventMarkByte, &eventMarks)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		// add event_marks to output.Tasks
+		output.EventMarks = append(output.EventMarks, eventMarks...)
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetByListNoPaginationNoSub(input *taskModel.Field) (int, any) {
+	output := &taskModel.List{}
+	taskBase, err := m.TaskService.GetByListNoPagination(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	taskByte, err := sonic.Marshal(taskBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(taskByte, &output.Tasks)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, task := range output.Tasks {
+		task.CreatedBy = *taskBase[i].CreatedByUsers.Name
+		task.UpdatedBy = *taskBase[i].UpdatedByUsers.Name
+		task.StatusChangedBy = *taskBase[i].StatusChangedByUsers.Name
+		for j, file := range taskBase[i].S3Files {
+			task.Files[j].CreatedBy = *file.CreatedByUsers.Name
+		}
+
+		// transform segments to array
+		var segments []taskModel.Segments
+		err = util.DecodeJSONToSlice(*taskBase[i].Segment, &segments)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+		task.Segments = segments
+
+		// transform indicator to array
+		var indicators []taskModel.Indicators
+		err = util.DecodeJSONToSlice(*taskBase[i].Indicator, &indicators)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+		task.Indicators = indicators
+		if len(indicators) > 0 {
+			task.IndicatorsName = indicators[0].Name
+			task.IndicatorsIconClass = indicators[0].IconClass
+			task.IndicatorsToolTip = indicators[0].ToolTip
+		}
+
+		// transform recurrence rule to object
+		err = util.DecodeJSONToSlice(*taskBase[i].RecurrenceRule, &task.Recurrence)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		for j, res := range taskBase[i].TaskResources {
+			task.Resources[j].ResourceUUID = *res.Resources.ResourceUUID
+			task.Resources[j].ResourceID = *res.Resources.Resources.ResourceID
+			task.Resources[j].ResourceName = *res.Resources.Resources.ResourceName
+			task.Resources[j].Email = *res.Resources.Resources.Email
+			task.Resources[j].Phone = *res.Resources.Resources.Phone
+			task.Resources[j].StandardCost = *res.Resources.Resources.StandardCost
+			task.Resources[j].TotalCost = *res.Resources.Resources.TotalCost
+			task.Resources[j].TotalLoad = *res.Resources.Resources.TotalLoad
+			task.Resources[j].IsExpand = *res.Resources.Resources.IsExpand
+			task.Resources[j].Role = *res.Resources.Role
+
+			// transform resource_groups to array
+			var resourceGroup []string
+			err = sonic.Unmarshal([]byte(*res.Resources.Resources.ResourceGroup), &resourceGroup)
+			if err != nil {
+				log.Error(err)
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			}
+			task.Resources[j].ResourceGroups = resourceGroup
+		}
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetBySingle(input *taskModel.Field) (int, any) {
+	taskBase, err := m.TaskService.GetBySingle(input)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output := &taskModel.Single{}
+	taskByte, _ := sonic.Marshal(taskBase)
+	err = sonic.Unmarshal(taskByte, &output)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output.CreatedBy = *taskBase.CreatedByUsers.Name
+	output.UpdatedBy = *taskBase.UpdatedByUsers.Name
+	output.StatusChangedBy = *taskBase.StatusChangedByUsers.Name
+	for j, file := range taskBase.S3Files {
+		output.Files[j].CreatedBy = *file.CreatedByUsers.Name
+	}
+
+	// transform segments to array
+	var segments []taskModel.Segments
+	err = util.DecodeJSONToSlice(*taskBase.Segment, &segments)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	output.Segments = segments
+
+	// transform indicator to array
+	var indicators []taskModel.Indicators
+	err = util.DecodeJSONToSlice(*taskBase.Indicator, &indicators)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	output.Indicators = indicators
+	if len(indicators) > 0 {
+		output.IndicatorsName = indicators[0].Name
+		output.IndicatorsIconClass = indicators[0].IconClass
+		output.IndicatorsToolTip = indicators[0].ToolTip
+	}
+
+	// transform recurrence rule to object
+	err = util.DecodeJSONToSlice(*taskBase.RecurrenceRule, &output.Recurrence)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, res := range taskBase.TaskResources {
+		output.Resources[i].ResourceUUID = *res.Resources.ResourceUUID
+		output.Resources[i].ResourceID = *res.Resources.Resources.ResourceID
+		output.Resources[i].ResourceName = *res.Resources.Resources.ResourceName
+		output.Resources[i].Email = *res.Resources.Resources.Email
+		output.Resources[i].Phone = *res.Resources.Resources.Phone
+		output.Resources[i].StandardCost = *res.Resources.Resources.StandardCost
+		output.Resources[i].TotalCost = *res.Resources.Resources.TotalCost
+		output.Resources[i].TotalLoad = *res.Resources.Resources.TotalLoad
+		output.Resources[i].IsExpand = *res.Resources.Resources.IsExpand
+		output.Resources[i].Role = *res.Resources.Role
+
+		// transform resource_groups to array
+		var resourceGroup []string
+		err = sonic.Unmarshal([]byte(*res.Resources.Resources.ResourceGroup), &resourceGroup)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+		output.Resources[i].ResourceGroups = resourceGroup
+	}
+
+	// flag the broken constraint and the missed deadline
+	activity := assembleActivity(output)
+	output.IsConstraintViolated = activity.ConstraintViolated()
+	output.IsDeadlineMissed = activity.DeadlineMissed()
+
+	// flag the resources assigned beyond their capacity
+	var resourceUUIDs []*string
+	for _, res := range output.Resources {
+		resourceUUIDs = append(resourceUUIDs, util.PointerString(res.ResourceUUID))
+	}
+
+	overallocations, err := m.getOverallocations(nil, util.PointerString(output.ProjectUUID), resourceUUIDs)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	assembleOverallocated([]*taskModel.Single{output}, overallocations)
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) Delete(trx *gorm.DB, input *taskModel.DeletedTaskUUIDs) (int, any) {
+	defer trx.Rollback()
+
+	// check the update_by has the permission to delete the project's tasks
+	if *input.Role != "admin" {
+		// search project_resource
+		proResBase, err := m.ProjectResourceService.GetBySingle(&projectResourceModel.Field{
+			ProjectUUID:  input.ProjectUUID,
+			ResourceUUID: input.ResUUID,
+		})
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		if proResBase != nil {
+			if !*proResBase.IsEditable {
+				log.Info("The user don't have permission to update the project's tasks.")
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The user don't have permission to update the project's tasks.")
+			}
+		}
+	}
+
+	// sync delete task_dependencies
+	err := m.SyncDeleteTaskDependencies(trx, input.ProjectUUID, input.Tasks, *input.UpdatedBy)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = m.TaskService.WithTrx(trx).Delete(&taskModel.Field{
+		DeletedTaskUUIDs: input.Tasks,
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync delete task_resource
+	err = m.SyncDeleteTaskResources(trx, nil, input.Tasks, true)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync delete the checklists of the tasks
+	err = m.TaskChecklistItemService.WithTrx(trx).Delete(&taskChecklistItemModel.Field{
+		TaskUUIDs: input.Tasks,
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync roll up the summary tasks from their subtasks
+	_, err = m.syncRollUpSummaryTasks(trx, input.ProjectUUID, *input.UpdatedBy)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync update project's start and end dates
+	err = m.SyncUpdateProjectStartEndDate(trx, input.ProjectUUID, input.Tasks, nil, nil)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, "Delete ok!")
+}
+
+func (m *manager) Update(trx *gorm.DB,
+	input *taskModel.Update) (int, any) {
+	defer trx.Rollback()
+
+	// get all resources of the project
+	proResBase, err := m.ProjectResourceService.GetByListNoPagination(&projectResourceModel.Field{
+		ProjectUUID: input.ProjectUUID,
+	})
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	var proRes []*projectResourceModel.Single
+	proResByte, err := sonic.Marshal(proResBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(proResByte, &proRes)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// create a map of resourceUUID
+	proResMap := make(map[string]*projectResourceModel.Single)
+	if len(proRes) > 0 {
+		for _, res := range proRes {
+			proResMap[res.ResourceUUID] = res
+		}
+	}
+
+	taskBase, err := m.TaskService.GetBySingle(&taskModel.Field{
+		TaskUUID: input.TaskUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// get the project for the task
+	projectBase, err := m.ProjectService.GetBySingle(&projectModel.Field{
+		ProjectUUID: *taskBase.ProjectUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check the update_by has the permission to update the project's tasks
+	if *input.Role != "admin" {
+		if proResMap[*input.ResUUID] != nil {
+			if !proResMap[*input.ResUUID].IsEditable {
+				log.Info("The user don't have permission to update the project's tasks.")
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The user don't have permission to update the project's tasks.")
+			}
+		}
+
+		// check if the update_by is the task's creator or assigned resource
+		if taskBase.CreatedBy != input.UpdatedBy {
+			assigned := false
+			for _, res := range taskBase.TaskResources {
+				if *res.ResourceUUID == *input.ResUUID {
+					assigned = true
+					break
+				}
+			}
+			if !assigned {
+				log.Info("The user don't have permission to update the project's tasks.")
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The user don't have permission to update the project's tasks.")
+			}
+		}
+	}
+
+	// determine the project status
+	if *projectBase.Status != "建檔中" {
+		if *projectBase.Status == "執行中" || *projectBase.Status == "暫停中" {
+			if (!input.BaselineStartDate.IsZero() && input.BaselineStartDate != taskBase.BaselineStartDate) || (!input.BaselineEndDate.IsZero() && input.BaselineEndDate != taskBase.BaselineEndDate) {
+				log.Info("The baseline cannot be modified while project is in progress.")
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The baseline cannot be modified while project is in progress.")
+			}
+		} else {
+			// transform taskBase to update struct
+			original := &taskModel.Update{}
+			taskByte, err := sonic.Marshal(taskBase)
+			if err != nil {
+				log.Error(err)
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			}
+
+			err = sonic.Unmarshal(taskByte, &original)
+			if err != nil {
+				log.Error(err)
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			}
+
+			if input != original {
+				log.Info("The project is completed and cannot be modified.")
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The project is completed and cannot be modified.")
+			}
+		}
+	}
+
+	// get the quantity of subtasks
+	subQuantity, _ := m.TaskService.GetByQuantity(&taskModel.Field{
+		OutlineNumber: taskBase.OutlineNumber,
+	})
+
+	if len(input.Segments) > 0 {
+		if subQuantity > 0 {
+			log.Info("The parent task cannot be segmented.")
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The parent task cannot be segmented.")
+		}
+
+		// transform segments from struct array to string
+		segJson, _ := sonic.Marshal(input.Segments)
+		input.Segment = util.PointerString(string(segJson))
+	} else {
+		input.ClearSegment = util.PointerBool(true)
+	}
+
+	// transform indicators from struct array to string
+	if len(input.Indicators) > 0 {
+		indJson, _ := sonic.Marshal(input.Indicators)
+		input.Indicator = util.PointerString(string(indJson))
+	} else {
+		input.ClearIndicator = util.PointerBool(true)
+	}
+
+	// get the working calendar
+	calendar, err := m.GetWorkCalendar(taskBase.ProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// align the durations with the working calendar
+	original := &taskModel.Single{}
+	originalByte, err := sonic.Marshal(taskBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(originalByte, &original)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check the segments and derive the dates of the task from them
+	err = assembleUpdateSegments(calendar, input)
+	if err != nil {
+		if errors.Is(err, schedule.ErrInvalidSegments) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	assembleUpdateDuration(calendar, input, original)
+
+	// normalize the constraint with its date
+	err = assembleUpdateConstraint(input, original)
+	if err != nil {
+		log.Info(err.Error())
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+	}
+
+	// check the three-point estimate
+	err = assembleUpdateEstimate(input, original)
+	if err != nil {
+		log.Info(err.Error())
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+	}
+
+	// the progress derived from the checklist cannot be changed by hand
+	if input.Progress != nil && *input.Progress != original.Progress {
+		checklistMap, err := m.getChecklistProgressTasks(trx, taskBase.ProjectUUID, []*string{taskBase.TaskUUID})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		if checklistMap[*taskBase.TaskUUID] {
+			log.Info("The progress of the task is derived from its checklist.")
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The progress of the task is derived from its checklist.")
+		}
+	}
+
+	// check the change of the status with the transitions of the project type
+	transitions, err := m.getStatusTransitions(taskBase.ProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = assembleUpdateStatus(input, original, transitions)
+	if err != nil {
+		log.Info(err.Error())
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+	}
+
+	// sync delete task_resource
+	err = m.SyncDeleteTaskResources(trx, util.PointerString(input.TaskUUID), nil, false)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync create task_resource
+	if len(input.Resources) > 0 {
+		taskResMapList := []map[string][]*resourceModel.TaskSingle{
+			{*taskBase.TaskUUID: input.Resources},
+		}
+		err = m.SyncCreateTaskResources(trx, taskResMapList, *input.UpdatedBy, *input.ProjectUUID, proResMap)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	err = m.TaskService.WithTrx(trx).Update(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync task_dependencies
+	err = m.SyncTaskDependencies(trx, taskBase.ProjectUUID, *input.UpdatedBy)
+	if err != nil {
+		if schedule.IsInvalid(err) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync reschedule the successors when the task's dates are changed
+	var changed []string
+	if (input.StartDate != nil && (taskBase.StartDate == nil || !input.StartDate.Equal(*taskBase.StartDate))) ||
+		(input.EndDate != nil && (taskBase.EndDate == nil || !input.EndDate.Equal(*taskBase.EndDate))) {
+		changed = append(changed, input.TaskUUID)
+	}
+
+	movedTasks, err := m.syncRescheduleSuccessors(trx, taskBase.ProjectUUID, changed, *input.UpdatedBy)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync update project's start and end dates
+	start, end := extendDateRange(input.BaselineStartDate, input.BaselineEndDate, movedTasks)
+	err = m.SyncUpdateProjectStartEndDate(trx, taskBase.ProjectUUID, nil, start, end)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync roll up the summary tasks from their subtasks
+	summaryTasks, err := m.syncRollUpSummaryTasks(trx, taskBase.ProjectUUID, *input.UpdatedBy)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check the resources assigned on their non-working days
+	taskUUIDs := []*string{taskBase.TaskUUID}
+	for _, task := range movedTasks {
+		taskUUIDs = append(taskUUIDs, util.PointerString(task.TaskUUID))
+	}
+
+	warnings, err := m.getResourceWarnings(trx, taskBase.ProjectUUID, taskUUIDs, calendar)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check the resources assigned beyond their capacity
+	overallocations, err := m.getTaskOverallocations(trx, taskBase.ProjectUUID, taskUUIDs)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, &taskModel.Result{
+		TaskUUID:        *taskBase.TaskUUID,
+		MovedTasks:      movedTasks,
+		Warnings:        warnings,
+		Overallocations: overallocations,
+		SummaryTasks:    summaryTasks,
+	})
+}
+
+func (m *manager) UpdateAll(trx *gorm.DB, input []*taskModel.Update) (int, any) {
+	defer trx.Rollback()
+
+	log.Info("UpdateAll Start !!")
+	var (
+		updateList                       []*taskModel.Update
+		taskResMapList                   []map[string][]*resourceModel.TaskSingle
+		TaskUUIDs                        []*string
+		minBaselineStart, maxBaselineEnd *time.Time
+	)
+
+	// get all resources of the project
+	proResBase, err := m.ProjectResourceService.GetByListNoPagination(&projectResourceModel.Field{
+		ProjectUUID: input[0].ProjectUUID,
+	})
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	var proRes []*projectResourceModel.Single
+	proResByte, err := sonic.Marshal(proResBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(proResByte, &proRes)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// create a map of resourceUUID
+	proResMap := make(map[string]*projectResourceModel.Single)
+	if len(proRes) > 0 {
+		for _, res := range proRes {
+			proResMap[res.ResourceUUID] = res
+		}
+	}
+
+	// check the update_by has the permission to update the project's tasks
+	if *input[0].Role != "admin" {
+		if proResMap[*input[0].ResUUID] != nil {
+			if !proResMap[*input[0].ResUUID].IsEditable {
+				log.Error("The user don't have permission to update the project's tasks.")
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The user don't have permission to update the project's tasks.")
+			}
+		} else {
+			log.Error("The user don't have permission to update the project's tasks.")
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The user don't have permission to update the project's tasks.")
+		}
+	}
+
+	// get tasks for the project
+	taskBase, err := m.TaskService.GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: input[0].ProjectUUID,
+	})
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	var tasks []*taskModel.Single
+	taskByte, err := sonic.Marshal(taskBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(taskByte, &tasks)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// create a map of taskUUID
+	taskMap := make(map[string]*taskModel.Single)
+	if len(tasks) > 0 {
+		for _, task := range tasks {
+			taskMap[task.TaskUUID] = task
+		}
+	}
+
+	// update the main task
+	for i, inputBody := range input {
+		// check if the update_by is the task's creator or assigned resource
+		if *input[0].Role != "admin" && input[0].ResUUID != nil && taskMap[inputBody.TaskUUID] != nil {
+			if taskMap[inputBody.TaskUUID].CreatedBy != *inputBody.UpdatedBy {
+				assigned := false
+				for _, res := range taskMap[inputBody.TaskUUID].Resources {
+					if res.ResourceUUID == *input[0].ResUUID {
+						assigned = true
+						break
+					}
+				}
+				if !assigned {
+					continue
+				}
+			}
+		}
+
+		if inputBody.BaselineStartDate != nil && inputBody.BaselineEndDate != nil {
+			// get the minimum baseline_start_date
+			if minBaselineStart == nil || inputBody.BaselineStartDate.Before(*minBaselineStart) {
+				minBaselineStart = inputBody.BaselineStartDate
+			}
+
+			// get the maximum baseline_end_date
+			if maxBaselineEnd == nil || inputBody.BaselineEndDate.After(*maxBaselineEnd) {
+				maxBaselineEnd = inputBody.BaselineEndDate
+			}
+		}
+
+		// the main task with subtasks cannot be segmented
+		if len(inputBody.Segments) > 0 {
+			if len(inputBody.Subtask) > 0 {
+				log.Info("The parent task cannot be segmented.")
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The parent task cannot be segmented.")
+			}
+
+			// transform segments from struct array to string
+			segJson, _ := sonic.Marshal(inputBody.Segments)
+			inputBody.Segment = util.PointerString(string(segJson))
+		} else {
+			inputBody.ClearSegment = util.PointerBool(true)
+		}
+
+		// transform indicators from struct array to string
+		if len(inputBody.Indicators) > 0 {
+			indJson, _ := sonic.Marshal(inputBody.Indicators)
+			inputBody.Indicator = util.PointerString(string(indJson))
+		} else {
+			inputBody.ClearIndicator = util.PointerBool(true)
+		}
+
+		inputBody.IsSubTask = util.PointerBool(false)
+		// calculate the new outline_number
+		inputBody.OutlineNumber = util.PointerString(strconv.Itoa(1 + i))
+
+		// sync update task_resource
+		TaskUUIDs = append(TaskUUIDs, util.PointerString(inputBody.TaskUUID))
+		if len(inputBody.Resources) > 0 {
+			taskResources := make(map[string][]*resourceModel.TaskSingle)
+			taskResources[inputBody.TaskUUID] = inputBody.Resources
+			taskResMapList = append(taskResMapList, taskResources)
+		}
+
+		// update the main task
+		updateList = append(updateList, inputBody)
+
+		// update subtasks
+		if len(inputBody.Subtask) > 0 {
+			subTaskUUIDs, subUpdateList, subTaskResMapList, subMinBaselineStart, subMaxBaselineEnd, err := m.updateSubtasks(trx, inputBody, inputBody.Subtask, minBaselineStart, maxBaselineEnd, input[0].Role, input[0].ResUUID, taskMap)
+			if err != nil {
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			}
+			taskResMapList = append(taskResMapList, subTaskResMapList...)
+			updateList = append(updateList, subUpdateList...)
+			TaskUUIDs = append(TaskUUIDs, subTaskUUIDs...)
+
+			if inputBody.BaselineStartDate != nil && inputBody.BaselineEndDate != nil {
+				// compare the minimum baseline_start_date and maximum baseline_end_date of the subtasks
+				if minBaselineStart == nil || inputBody.BaselineStartDate.Before(*subMinBaselineStart) {
+					minBaselineStart = inputBody.BaselineStartDate
+				}
+				if maxBaselineEnd == nil || inputBody.BaselineEndDate.After(*subMaxBaselineEnd) {
+					maxBaselineEnd = inputBody.BaselineEndDate
+				}
+			}
+		}
+	}
+
+	// get the working calendar
+	calendar, err := m.GetWorkCalendar(input[0].ProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// get the transitions of the task statuses
+	transitions, err := m.getStatusTransitions(input[0].ProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// get the tasks whose progress is derived from their checklists among the tasks whose progress is changed
+	var progressedUUIDs []*string
+	for _, task := range updateList {
+		if task.Progress != nil && taskMap[task.TaskUUID] != nil && *task.Progress != taskMap[task.TaskUUID].Progress {
+			progressedUUIDs = append(progressedUUIDs, util.PointerString(task.TaskUUID))
+		}
+	}
+
+	checklistMap, err := m.getChecklistProgressTasks(trx, input[0].ProjectUUID, progressedUUIDs)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// align the durations with the working calendar
+	for _, task := range updateList {
+		// check the segments and derive the dates of the task from them
+		err = assembleUpdateSegments(calendar, task)
+		if err != nil {
+			if errors.Is(err, schedule.ErrInvalidSegments) {
+				log.Info(err.Error())
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+		assembleUpdateDuration(calendar, task, taskMap[task.TaskUUID])
+
+		// normalize the constraint with its date
+		err = assembleUpdateConstraint(task, taskMap[task.TaskUUID])
+		if err != nil {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		// check the three-point estimate
+		err = assembleUpdateEstimate(task, taskMap[task.TaskUUID])
+		if err != nil {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		// the progress derived from the checklist cannot be changed by hand
+		if checklistMap[task.TaskUUID] {
+			log.Info("The progress of the task is derived from its checklist.")
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The progress of the task is derived from its checklist.")
+		}
+
+		// check the change of the status with the transitions of the project type
+		err = assembleUpdateStatus(task, taskMap[task.TaskUUID], transitions)
+		if err != nil {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+	}
+
+	var wg sync.WaitGroup
 	// make an error channel
 	goroutineErr := make(chan error)
 	// create goroutine
-	for _, projectsUUID := range input.Projects {
+	for _, task := range updateList {
 		wg.Add(1)
-		go func(projectsUUID *string) {
+		go func(task *taskModel.Update) {
 			defer wg.Done()
-			mu.Lock()
-			defer mu.Unlock()
+			// update task
+			err := m.TaskService.WithTrx(trx).Update(task)
+			if err != nil {
+				log.Error(err)
+				goroutineErr <- err
+			}
+		}(task)
+	}
+
+	// wait until all goroutines are finished
+	wg.Wait()
+	close(goroutineErr)
+
+	// check if goroutine has error
+	err = <-goroutineErr
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync delete task_resource
+	err = m.SyncDeleteTaskResources(trx, nil, TaskUUIDs, true)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync create task_resource
+	if len(taskResMapList) > 0 {
+		err = m.SyncCreateTaskResources(trx, taskResMapList, *input[0].UpdatedBy, *input[0].ProjectUUID, proResMap)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	// sync task_dependencies
+	err = m.SyncTaskDependencies(trx, input[0].ProjectUUID, *input[0].UpdatedBy)
+	if err != nil {
+		if schedule.IsInvalid(err) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync reschedule the successors of the tasks whose dates are changed
+	var changed []string
+	for _, task := range updateList {
+		original := taskMap[task.TaskUUID]
+		if original == nil {
+			continue
+		}
+
+		if (task.StartDate != nil && (original.StartDate == nil || !task.StartDate.Equal(*original.StartDate))) ||
+			(task.EndDate != nil && (original.EndDate == nil || !task.EndDate.Equal(*original.EndDate))) {
+			changed = append(changed, task.TaskUUID)
+		}
+	}
+
+	movedTasks, err := m.syncRescheduleSuccessors(trx, input[0].ProjectUUID, changed, *input[0].UpdatedBy)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync update project's start and end dates
+	minBaselineStart, maxBaselineEnd = extendDateRange(minBaselineStart, maxBaselineEnd, movedTasks)
+	err = m.SyncUpdateProjectStartEndDate(trx, input[0].ProjectUUID, nil, minBaselineStart, maxBaselineEnd)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync roll up the summary tasks from their subtasks
+	summaryTasks, err := m.syncRollUpSummaryTasks(trx, input[0].ProjectUUID, *input[0].UpdatedBy)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check the resources assigned on their non-working days
+	for _, task := range movedTasks {
+		TaskUUIDs = append(TaskUUIDs, util.PointerString(task.TaskUUID))
+	}
+
+	warnings, err := m.getResourceWarnings(trx, input[0].ProjectUUID, TaskUUIDs, calendar)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check the resources assigned beyond their capacity
+	overallocations, err := m.getTaskOverallocations(trx, input[0].ProjectUUID, TaskUUIDs)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, &taskModel.Result{
+		MovedTasks:      movedTasks,
+		Warnings:        warnings,
+		Overallocations: overallocations,
+		SummaryTasks:    summaryTasks,
+	})
+}
+
+func (m *manager) Import(trx *gorm.DB, input *taskModel.Import) (int, any) {
+	defer trx.Rollback()
+
+	// get resources
+	resBase, err := m.ResourceService.GetByListNoPagination(&resourceModel.Field{})
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	// create a map of resource_name and project_resource_id
+	nameToResIDMap := make(map[string]string)
+	for _, res := range resBase {
+		nameToResIDMap[*res.ResourceName] = *res.ResourceUUID
+	}
+
+	// set CSV parse options
+	input.CSVFile.LazyQuotes = true       // loosely process quotes
+	input.CSVFile.TrimLeadingSpace = true // automatically remove spaces before each field
+	input.CSVFile.FieldsPerRecord = -1    // do not force each record to have the same number of fields
+
+	// read and parse CSV file
+	records, err := input.CSVFile.ReadAll()
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	taskIdx := [25]int{}
+	taskRecordIdx := make(map[string]int)
+	var createAllTask []*taskModel.Create
+	for i, record := range records {
+		if i == 0 {
+			// identify the CSV header row and record the index of each field
+			for index, value := range record {
+				log.Debug("index: ", index, " value: ", value)
+				// 0: gantt project
+				if input.FileType == 1 {
+					switch value {
+					// set the index of each field according to the column name
+					case "ID", "編號":
+						taskIdx[0] = index
+					case "Name", "名稱":
+						taskIdx[1] = index
+					case "Begin date", "實際起始日":
+						taskIdx[2] = index
+					case "End date", "實際完成日":
+						taskIdx[3] = index
+					case "Duration", "期間":
+						taskIdx[4] = index
+					case "Completion", "完成":
+						taskIdx[5] = index
+					case "Cost":
+						taskIdx[6] = index
+					//case "Coordinator", "協調者":
+					//	taskIdx[7] = index
+					case "Predecessors", "父階":
+						taskIdx[8] = index
+					case "Outline number", "大綱編號":
+						taskIdx[9] = index
+					case "Resources", "資源":
+						taskIdx[10] = index
+					case "Assignments":
+						taskIdx[11] = index
+					case "Task color", "任務顏色":
+						taskIdx[12] = index
+					case "Web Link", "超連結":
+						taskIdx[13] = index
+					case "Notes", "備註":
+						taskIdx[14] = index
+					case "Baseline Begin date", "起始日期":
+						taskIdx[15] = index
+					case "Baseline End date", "結束日期":
+						taskIdx[16] = index
+					case "Constraint type", "限制類型":
+						taskIdx[18] = index
+					case "Constraint date", "限制日期":
+						taskIdx[19] = index
+					case "Deadline", "期限":
+						taskIdx[20] = index
+					case "Milestone", "里程碑":
+						taskIdx[21] = index
+					case "Optimistic duration", "樂觀期間":
+						taskIdx[22] = index
+					case "Most likely duration", "最可能期間":
+						taskIdx[23] = index
+					case "Pessimistic duration", "悲觀期間":
+						taskIdx[24] = index
+					}
+					// 1: saas pmi
+				} else if input.FileType == 2 {
+					switch value {
+					// set the index of each field according to the column name
+					case "ID", "編號":
+						taskIdx[0] = index
+					case "Name", "名稱":
+						taskIdx[1] = index
+					case "Begin date", "開始日期":
+						taskIdx[2] = index
+					case "End date", "結束日期":
+						taskIdx[3] = index
+					case "Duration", "工作天":
+						taskIdx[4] = index
+					case "Completion", "進度(%)":
+						taskIdx[5] = index
+					case "Cost", "工時表":
+						taskIdx[6] = index
+					case "Predecessors", "相依性":
+						taskIdx[8] = index
+					case "Outline number", "大綱編號":
+						taskIdx[9] = index
+					case "Resources", "負責人":
+						taskIdx[10] = index
+					case "Notes", "備註":
+						taskIdx[14] = index
+					case "Baseline Begin date", "基準開始日":
+						taskIdx[15] = index
+					case "Baseline End date", "基準結束日":
+						taskIdx[16] = index
+					case "Baseline Duration", "基準工作天":
+						taskIdx[17] = index
+					case "Constraint type", "限制類型":
+						taskIdx[18] = index
+					case "Constraint date", "限制日期":
+						taskIdx[19] = index
+					case "Deadline", "期限":
+						taskIdx[20] = index
+					case "Milestone", "里程碑":
+						taskIdx[21] = index
+					case "Optimistic duration", "樂觀期間":
+						taskIdx[22] = index
+					case "Most likely duration", "最可能期間":
+						taskIdx[23] = index
+					case "Pessimistic duration", "悲觀期間":
+						taskIdx[24] = index
+					}
+				}
+			}
+			continue
+		}
+
+		// skip the record if there is no outline_number
+		if record[taskIdx[9]] == "" {
+			continue
+		}
+
+		// build the top outline_number for validation
+		outlineNumberSplit := strings.Split(record[taskIdx[9]], ".")
+		var topOutlineNumber string
+		for i := 0; i < len(outlineNumberSplit)-1; i++ {
+			topOutlineNumber += outlineNumberSplit[i]
+			if i != len(outlineNumberSplit)-2 {
+				topOutlineNumber += "."
+			}
+		}
+
+		// verify the hierarchy relationship in the file
+		if _, ok := taskRecordIdx[topOutlineNumber]; topOutlineNumber != "" && !ok {
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, "import errors")
+		}
+
+		// combine the parsed data into the 'Create' structure
+		createTask := &taskModel.Create{}
+		createTask.TaskID = record[taskIdx[0]]
+		createTask.TaskName = record[taskIdx[1]]
+		if record[taskIdx[2]] != "" {
+			// transform the date format from "/" to "-"
+			var startDateString string
+			for _, v := range strings.Split(strings.ReplaceAll(record[taskIdx[2]], "/", "-"), "-") {
+				if len(v) == 1 {
+					startDateString += "0" + v + "-"
+				} else {
+					startDateString += v + "-"
+				}
+			}
+			startDateString = startDateString[0 : len(startDateString)-1]
+			startDate, err := time.Parse("2006-01-02", startDateString)
+			if err != nil {
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			}
+			createTask.StartDate = util.PointerTime(startDate)
+		}
+
+		if record[taskIdx[3]] != "" {
+			// transform the date format from "/" to "-"
+			var endDateString string
+			for _, v := range strings.Split(strings.ReplaceAll(record[taskIdx[3]], "/", "-"), "-") {
+				if len(v) == 1 {
+					endDateString += "0" + v + "-"
+				} else {
+					endDateString += v + "-"
+				}
+			}
+			endDateString = endDateString[0 : len(endDateString)-1]
+			endDateString += " 12:00:00"
+			endDate, err := time.Parse("2006-01-02 15:04:05", endDateString)
+			if err != nil {
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			}
+			createTask.EndDate = util.PointerTime(endDate)
+		}
+
+		// the duration is aligned with the working calendar when the task is created
+		if taskIdx[4] > 0 && record[taskIdx[4]] != "" {
+			duration, err := parseDuration(record[taskIdx[4]])
+			if err != nil {
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+			}
+			createTask.Duration = duration
+		}
+
+		if record[taskIdx[5]] != "" {
+			progress, _ := strconv.Atoi(record[taskIdx[5]])
+			createTask.Progress = int64(progress)
+		}
+
+		if record[taskIdx[6]] != "" {
+			cost, err := strconv.ParseFloat(record[taskIdx[6]], 64)
+			if err != nil {
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			}
+			createTask.Cost = int64(cost)
+		}
+
+		createTask.Predecessor = record[taskIdx[8]]
+		if record[taskIdx[10]] != "" {
+			var resourceSplit []string
+			separators := []string{";", ","}
+			for _, sep := range separators {
+				if strings.Contains(record[taskIdx[10]], sep) {
+					resourceSplit = strings.Split(record[taskIdx[10]], sep)
+					break
+				}
+			}
+
+			if len(resourceSplit) == 0 {
+				resourceSplit = append(resourceSplit, record[taskIdx[10]])
+			}
+
+			for _, res := range resourceSplit {
+				var percentage float64
+				var name string
+				if strings.Contains(res, "[") {
+					res = strings.Trim(res, "[]")
+					parts := strings.Split(res, "[")
+					name = parts[0]
+					percentage, _ = strconv.ParseFloat(strings.TrimRight(parts[1], "%"), 64)
+				} else {
+					name = res
+					percentage = 100
+				}
+
+				if nameToResIDMap[name] != "" {
+					createTask.Resources = append(createTask.Resources, &resourceModel.TaskSingle{
+						ResourceUUID: nameToResIDMap[name],
+						Unit:         percentage,
+					})
+				}
+				continue
+			}
+		}
+
+		createTask.Assignments = record[taskIdx[11]]
+		createTask.TaskColor = record[taskIdx[12]]
+		createTask.WebLink = record[taskIdx[13]]
+		createTask.Notes = record[taskIdx[14]]
+
+		if record[taskIdx[15]] != "" {
+			// transform the date format from "/" to "-"
+			var startDateString string
+			for _, v := range strings.Split(strings.ReplaceAll(record[taskIdx[15]], "/", "-"), "-") {
+				if len(v) == 1 {
+					startDateString += "0" + v + "-"
+				} else {
+					startDateString += v + "-"
+				}
+			}
+			startDateString = startDateString[0 : len(startDateString)-1]
+			startDate, err := time.Parse("2006-01-02", startDateString)
+			if err != nil {
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			}
+			createTask.BaselineStartDate = util.PointerTime(startDate)
+		}
+
+		if record[taskIdx[16]] != "" {
+			// transform the date format from "/" to "-"
+			var endDateString string
+			for _, v := range strings.Split(strings.ReplaceAll(record[taskIdx[16]], "/", "-"), "-") {
+				if len(v) == 1 {
+					endDateString += "0" + v + "-"
+				} else {
+					endDateString += v + "-"
+				}
+			}
+			endDateString = endDateString[0 : len(endDateString)-1]
+			endDateString += " 12:00:00"
+			endDate, err := time.Parse("2006-01-02 15:04:05", endDateString)
+			if err != nil {
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			}
+			createTask.BaselineEndDate = util.PointerTime(endDate)
+		}
+
+		if taskIdx[17] > 0 && record[taskIdx[17]] != "" {
+			duration, err := parseDuration(record[taskIdx[17]])
+			if err != nil {
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+			}
+			createTask.BaselineDuration = duration
+		}
+
+		if taskIdx[18] > 0 && record[taskIdx[18]] != "" {
+			constraintType, err := schedule.ParseConstraint(record[taskIdx[18]])
+			if err != nil {
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+			}
+			createTask.ConstraintType = constraintType
+		}
+
+		if taskIdx[19] > 0 && record[taskIdx[19]] != "" {
+			constraintDate, err := parseImportDate(record[taskIdx[19]])
+			if err != nil {
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+			}
+			createTask.ConstraintDate = util.PointerTime(constraintDate)
+		}
+
+		if taskIdx[20] > 0 && record[taskIdx[20]] != "" {
+			deadline, err := parseImportDate(record[taskIdx[20]])
+			if err != nil {
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+			}
+			createTask.Deadline = util.PointerTime(deadline)
+		}
 
-			// get tasks
-			taskBase, err := m.TaskService.GetByListNoPagination(&taskModel.Field{
-				ProjectUUID: projectsUUID,
-				Filter: taskModel.Filter{
-					FilterMilestone: input.FilterMilestone,
-				},
-			})
+		if taskIdx[21] > 0 {
+			isMilestone, err := parseMilestone(record[taskIdx[21]])
 			if err != nil {
-				log.Error(err)
-				goroutineErr <- err
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
 			}
+			createTask.IsMilestone = isMilestone
+		} else {
+			// without the milestone column, the tasks of zero duration within a day are the milestones
+			createTask.IsMilestone = createTask.Duration == 0 && createTask.StartDate != nil && createTask.EndDate != nil &&
+				createTask.StartDate.Format(time.DateOnly) == createTask.EndDate.Format(time.DateOnly)
+		}
 
-			var projectTasks []*taskModel.Single
-			taskByte, err := sonic.Marshal(taskBase)
+		// the three-point estimates are checked when the tasks are created
+		if taskIdx[22] > 0 && record[taskIdx[22]] != "" {
+			duration, err := parseDuration(record[taskIdx[22]])
 			if err != nil {
-				log.Error(err)
-				goroutineErr <- err
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
 			}
+			createTask.OptimisticDuration = util.PointerFloat64(duration)
+		}
 
-			err = sonic.Unmarshal(taskByte, &projectTasks)
+		if taskIdx[23] > 0 && record[taskIdx[23]] != "" {
+			duration, err := parseDuration(record[taskIdx[23]])
 			if err != nil {
-				log.Error(err)
-				goroutineErr <- err
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
 			}
+			createTask.MostLikelyDuration = util.PointerFloat64(duration)
+		}
 
-			// create a map of taskUUID
-			taskMap := make(map[string]*taskModel.Single)
-			for _, task := range projectTasks {
-				taskMap[task.TaskUUID] = task
+		if taskIdx[24] > 0 && record[taskIdx[24]] != "" {
+			duration, err := parseDuration(record[taskIdx[24]])
+			if err != nil {
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
 			}
+			createTask.PessimisticDuration = util.PointerFloat64(duration)
+		}
 
-			for i, task := range projectTasks {
-				task.CreatedBy = *taskBase[i].CreatedByUsers.Name
-				task.UpdatedBy = *taskBase[i].UpdatedByUsers.Name
-				for j, file := range taskBase[i].S3Files {
-					task.Files[j].CreatedBy = *file.CreatedByUsers.Name
-				}
+		// check if there is no data with the same outline_number
+		if _, ok := taskRecordIdx[record[taskIdx[9]]]; !ok {
+			// record the index of the current task in createAllTask
+			taskRecordIdx[record[taskIdx[9]]] = len(createAllTask)
+		}
 
-				// transform segments to array
-				var segments []taskModel.Segments
-				err = util.DecodeJSONToSlice(*taskBase[i].Segment, &segments)
-				if err != nil {
-					log.Error(err)
-					goroutineErr <- err
-				}
-				task.Segments = segments
+		createTask.CreatedBy = input.CreatedBy
+		createAllTask = assembleToCreateAll(createAllTask, taskRecordIdx, createTask, record[taskIdx[9]], "", input.ProjectUUID, input.ResUUID, input.Role)
+	}
 
-				// transform indicator to array
-				var indicators []taskModel.Indicators
-				err = util.DecodeJSONToSlice(*taskBase[i].Indicator, &indicators)
-				if err != nil {
-					log.Error(err)
-					goroutineErr <- err
-				}
-				task.Indicators = indicators
-				if len(indicators) > 0 {
-					task.IndicatorsName = indicators[0].Name
-					task.IndicatorsIconClass = indicators[0].IconClass
-					task.IndicatorsToolTip = indicators[0].ToolTip
-				}
+	// the result carries the warnings of the imported tasks
+	httpCode, message := m.CreateAll(trx, createAllTask)
+	if httpCode != code.Successful {
+		return httpCode, message
+	}
 
-				for j, res := range taskBase[i].TaskResources {
-					task.Resources[j].ResourceUUID = *res.Resources.ResourceUUID
-					task.Resources[j].ResourceID = *res.Resources.Resources.ResourceID
-					task.Resources[j].ResourceName = *res.Resources.Resources.ResourceName
-					task.Resources[j].Email = *res.Resources.Resources.Email
-					task.Resources[j].Phone = *res.Resources.Resources.Phone
-					task.Resources[j].StandardCost = *res.Resources.Resources.StandardCost
-					task.Resources[j].TotalCost = *res.Resources.Resources.TotalCost
-					task.Resources[j].TotalLoad = *res.Resources.Resources.TotalLoad
-					task.Resources[j].IsExpand = *res.Resources.Resources.IsExpand
-					task.Resources[j].Role = *res.Resources.Role
+	trx.Commit()
+	return httpCode, message
+}
 
-					// transform resource_groups to array
-					var resourceGroup []string
-					err = sonic.Unmarshal([]byte(*res.Resources.Resources.ResourceGroup), &resourceGroup)
-					if err != nil {
-						log.Error(err)
-						goroutineErr <- err
-					}
-					task.Resources[j].ResourceGroups = resourceGroup
-				}
+func (m *manager) GetByCriticalPath(input *taskModel.Field) (int, any) {
+	taskBase, err := m.TaskService.GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: input.ProjectUUID,
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-				// check the user can edit or delete the task
-				if *input.Role == "admin" || isPM {
-					task.IsEditable = true
-				} else {
-					if *taskBase[i].CreatedBy == *input.UserID {
-						task.IsEditable = true
-					} else {
-						for _, res := range task.Resources {
-							if res.ResourceUUID == *input.ResUUID {
-								task.IsEditable = true
-								break
-							}
-						}
-					}
-				}
+	var tasks []*taskModel.Single
+	taskByte, err := sonic.Marshal(taskBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-				if !input.FilterMilestone {
-					// determine if the task is a subtask
-					if task.IsSubTask {
-						// get the parent outline number
-						parentOutlineNumber := getParentOutlineNumber(*taskBase[i].OutlineNumber)
-						if parentOutlineNumber != "" {
-							// find tasks with the same parent outline number
-							parentTask := findParentTaskByOutlineNumber(projectTasks, parentOutlineNumber)
-							if parentTask != nil {
-								parentTask.Subtask = append(parentTask.Subtask, taskMap[task.TaskUUID])
-							}
-						}
-					}
-				}
+	err = sonic.Unmarshal(taskByte, &tasks)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, task := range tasks {
+		task.CreatedBy = *taskBase[i].CreatedByUsers.Name
+		task.UpdatedBy = *taskBase[i].UpdatedByUsers.Name
+	}
+
+	// get the working calendar
+	calendar, err := m.GetWorkCalendar(input.ProjectUUID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	result, err := m.assembleCriticalPath(input.ProjectUUID, tasks, calendar)
+	if err != nil {
+		if schedule.IsInvalid(err) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output := &taskModel.CriticalPath{
+		ProjectUUID:   *input.ProjectUUID,
+		CriticalTasks: []string{},
+		Tasks:         tasks,
+	}
+	if !result.Start.IsZero() {
+		output.StartDate = util.PointerTime(result.Start)
+		output.FinishDate = util.PointerTime(result.Finish)
+	}
+
+	for _, task := range tasks {
+		if task.IsCritical {
+			output.CriticalTasks = append(output.CriticalTasks, task.TaskUUID)
+		}
+	}
+
+	if output.Tasks == nil {
+		output.Tasks = []*taskModel.Single{}
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetByEarnedValue(input *taskModel.EarnedValueField) (int, any) {
+	taskBase, err := m.TaskService.GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: util.PointerString(input.ProjectUUID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// get the working calendar
+	calendar, err := m.GetWorkCalendar(util.PointerString(input.ProjectUUID))
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// get the snapshot of the selected baseline
+	baselineMap, err := m.getSelectedBaseline(util.PointerString(input.ProjectUUID))
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// the status date counts the whole day
+	statusDate := util.NowToUTC()
+	if input.StatusDate != nil {
+		statusDate = *input.StatusDate
+	}
+	statusDate = time.Date(statusDate.Year(), statusDate.Month(), statusDate.Day(), 0, 0, 0, 0, time.UTC)
+
+	values := make([]*schedule.EarnedValue, len(taskBase))
+	for i, task := range taskBase {
+		values[i] = assembleEarnedValue(task, baselineMap, calendar.HoursPerDay())
+	}
+
+	project := assembleEarnedValueMetrics(schedule.EarnedValues(values, statusDate.AddDate(0, 0, 1), calendar))
+	output := &taskModel.EarnedValue{
+		ProjectUUID: input.ProjectUUID,
+		StatusDate:  statusDate,
+		Project:     &project,
+		Tasks:       make([]*taskModel.TaskEarnedValue, len(taskBase)),
+	}
+	for i, task := range taskBase {
+		output.Tasks[i] = &taskModel.TaskEarnedValue{
+			TaskUUID:           *task.TaskUUID,
+			TaskID:             *task.TaskID,
+			TaskName:           *task.TaskName,
+			OutlineNumber:      *task.OutlineNumber,
+			IsSummary:          values[i].IsSummary,
+			Progress:           *task.Progress,
+			EarnedValueMetrics: assembleEarnedValueMetrics(values[i]),
+		}
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetByForecast(input *taskModel.ForecastField) (int, any) {
+	taskBase, err := m.TaskService.GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: util.PointerString(input.ProjectUUID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// get the working calendar
+	calendar, err := m.GetWorkCalendar(util.PointerString(input.ProjectUUID))
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// the complete tasks keep their durations
+	taskMap := make(map[string]*taskDB.Base)
+	activities := make([]*schedule.Activity, 0, len(taskBase))
+	estimates := make(map[string]*schedule.Estimate)
+	for _, task := range taskBase {
+		taskMap[*task.TaskUUID] = task
+		activities = append(activities, assembleTaskActivity(task))
+		estimate, err := schedule.NewEstimate(task.OptimisticDuration, task.MostLikelyDuration, task.PessimisticDuration)
+		if err == nil && estimate != nil && (task.Progress == nil || *task.Progress < 100) {
+			estimates[*task.TaskUUID] = estimate
+		}
+	}
+
+	// the milestones of the project are forecast when none is selected
+	milestoneUUIDs := input.MilestoneUUIDs
+	if len(milestoneUUIDs) == 0 {
+		for _, task := range taskBase {
+			if task.IsMilestone != nil && *task.IsMilestone {
+				milestoneUUIDs = append(milestoneUUIDs, *task.TaskUUID)
 			}
+		}
+	}
+
+	for _, milestoneUUID := range milestoneUUIDs {
+		if _, ok := taskMap[milestoneUUID]; !ok {
+			log.Info("The milestone does not belong to the project.")
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The milestone does not belong to the project.")
+		}
+	}
+
+	dependencies, err := m.getDependencies(nil, util.PointerString(input.ProjectUUID), activities)
+	if err != nil {
+		if schedule.IsInvalid(err) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	result, err := schedule.CriticalPath(activities, dependencies, calendar)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// the seed is returned to reproduce the forecast
+	seed := time.Now().UnixNano()
+	if input.Seed != nil {
+		seed = *input.Seed
+	}
+
+	forecast, err := schedule.Simulate(activities, dependencies, estimates, calendar, milestoneUUIDs, input.Iterations, seed)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output := &taskModel.Forecast{
+		ProjectUUID: input.ProjectUUID,
+		Iterations:  forecast.Iterations,
+		Seed:        seed,
+		Project:     assembleForecastDates(forecast.Finishes),
+		Milestones:  make([]*taskModel.MilestoneForecast, 0, len(milestoneUUIDs)),
+		Tasks:       make([]*taskModel.TaskForecast, 0, len(taskBase)),
+	}
+	if !result.Finish.IsZero() {
+		output.FinishDate = util.PointerTime(result.Finish)
+	}
+
+	for _, milestoneUUID := range milestoneUUIDs {
+		task := taskMap[milestoneUUID]
+		output.Milestones = append(output.Milestones, &taskModel.MilestoneForecast{
+			TaskUUID:      *task.TaskUUID,
+			TaskID:        *task.TaskID,
+			TaskName:      *task.TaskName,
+			OutlineNumber: *task.OutlineNumber,
+			Date:          task.EndDate,
+			ForecastDates: assembleForecastDates(forecast.TaskFinishes[milestoneUUID]),
+		})
+	}
+
+	for _, task := range taskBase {
+		taskForecast := &taskModel.TaskForecast{
+			TaskUUID:            *task.TaskUUID,
+			TaskID:              *task.TaskID,
+			TaskName:            *task.TaskName,
+			OutlineNumber:       *task.OutlineNumber,
+			OptimisticDuration:  task.OptimisticDuration,
+			MostLikelyDuration:  task.MostLikelyDuration,
+			PessimisticDuration: task.PessimisticDuration,
+			CriticalityIndex:    forecast.Criticality[*task.TaskUUID],
+		}
+		if task.Duration != nil {
+			taskForecast.Duration = *task.Duration
+		}
+
+		if estimate, ok := estimates[*task.TaskUUID]; ok {
+			taskForecast.ExpectedDuration = util.PointerFloat64(estimate.Mean())
+			taskForecast.StandardDeviation = util.PointerFloat64(estimate.StandardDeviation())
+		}
+
+		output.Tasks = append(output.Tasks, taskForecast)
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetByResourceLoad(input *resourceModel.Load) (int, any) {
+	if input.EndDate.Before(input.StartDate) {
+		log.Info("The end date must not be before the start date.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The end date must not be before the start date.")
+	}
+
+	interval := schedule.IntervalDay
+	if input.Interval != nil {
+		interval = *input.Interval
+	}
+
+	// the periods follow the days of the working calendar
+	location := util.LoadLocation(constants.Timezone)
+	periods := schedule.Periods(
+		time.Date(input.StartDate.Year(), input.StartDate.Month(), input.StartDate.Day(), 0, 0, 0, 0, location),
+		time.Date(input.EndDate.Year(), input.EndDate.Month(), input.EndDate.Day(), 0, 0, 0, 0, location),
+		interval,
+	)
+	if len(periods) > 366 {
+		log.Info("The date range must not exceed 366 periods.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The date range must not exceed 366 periods.")
+	}
 
-			projectTaskMap[projectsUUID] = projectTasks
-		}(projectsUUID)
+	output := &resourceModel.LoadList{
+		StartDate: periods[0].Start,
+		EndDate:   periods[len(periods)-1].End.AddDate(0, 0, -1),
+		Interval:  interval,
+		Resources: []*resourceModel.ResourceLoad{},
 	}
 
-	// wait until all goroutines are finished
-	wg.Wait()
-	close(goroutineErr)
+	resourceBase, err := m.ResourceService.GetByListNoPagination(&resourceModel.Field{
+		ResourceUUIDs: input.ResourceUUIDs,
+		Filter: resourceModel.Filter{
+			FilterResourceGroups: input.ResourceGroups,
+		},
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-	// check if goroutine has error
-	err = <-goroutineErr
+	if len(resourceBase) == 0 {
+		return code.Successful, code.GetCodeMessage(code.Successful, output)
+	}
+
+	var resourceUUIDs []*string
+	for _, res := range resourceBase {
+		resourceUUIDs = append(resourceUUIDs, res.ResourceUUID)
+	}
+
+	// get the assignments of the resources in every project
+	taskResourceBase, err := m.TaskResourceService.GetByListNoPagination(&taskResourceModel.Field{
+		ResourceUUIDs: resourceUUIDs,
+	})
 	if err != nil {
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	for _, projectsUUID := range input.Projects {
-		if len(projectTaskMap[projectsUUID]) > 0 {
-			if len(input.Projects) == 1 {
-				// if the user is admin or project manager, check if the user can edit or delete tasks of the project
-				if *input.Role == "admin" || isPM || isEditable {
-					output.IsEditable = true
-				} else {
-					// if the user is not a project member, check if the user is the creator of the project
-					if projectMap[*projectsUUID].CreatedBy == *input.UserID {
-						output.IsEditable = true
-					}
-				}
+	taskMap := make(map[string]*taskDB.Base)
+	if len(taskResourceBase) > 0 {
+		var taskUUIDs []*string
+		for _, res := range taskResourceBase {
+			taskUUIDs = append(taskUUIDs, res.TaskUUID)
+		}
 
-				if !input.FilterMilestone {
-					// filter out the subtasks that have been included in each SubTask
-					var filteredTasks []*taskModel.Single
-					for _, task := range projectTaskMap[projectsUUID] {
-						if !task.IsSubTask {
-							filteredTasks = append(filteredTasks, task)
-						}
-					}
-					output.Tasks = filteredTasks
-				} else {
-					output.Tasks = projectTaskMap[projectsUUID]
-				}
-				// return project status
-				output.ProjectStatus = *projectBase[0].Status
+		taskBase, err := m.TaskService.GetByListNoPagination(&taskModel.Field{
+			TaskUUIDs: taskUUIDs,
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
 
-				// get project start date
-				for _, task := range output.Tasks {
-					if task.BaselineStartDate != nil {
-						if projectStartDate == nil || task.BaselineStartDate.Before(*projectStartDate) {
-							projectStartDate = task.BaselineStartDate
-						}
-					}
+		// the tasks of the scenarios are not loaded on the resources
+		excludedProjects, err := m.getExcludedProjects(nil, nil)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
 
-					if task.StartDate != nil {
-						if projectStartDate == nil || task.StartDate.Before(*projectStartDate) {
-							projectStartDate = task.StartDate
-						}
-					}
+		for _, task := range taskBase {
+			if task.ProjectUUID != nil && excludedProjects[*task.ProjectUUID] {
+				continue
+			}
 
-					if task.Subtask != nil {
-						for _, subtask := range task.Subtask {
-							if subtask.BaselineStartDate != nil {
-								if projectStartDate == nil || subtask.BaselineStartDate.Before(*projectStartDate) {
-									projectStartDate = subtask.BaselineStartDate
-								}
-							}
+			taskMap[*task.TaskUUID] = task
+		}
+	}
 
-							if subtask.StartDate != nil {
-								if projectStartDate == nil || subtask.StartDate.Before(*projectStartDate) {
-									projectStartDate = subtask.StartDate
-								}
-							}
-						}
-					}
-				}
-				output.ProjectStartDate = projectStartDate
+	// the available hours follow the default calendar
+	calendar, err := m.GetWorkCalendar(nil)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-			} else {
-				projectTask := &taskModel.Single{
-					TaskUUID:  projectMap[*projectsUUID].ProjectUUID,
-					TaskID:    projectMap[*projectsUUID].ProjectID,
-					TaskName:  projectMap[*projectsUUID].ProjectName,
-					IsSubTask: false,
-				}
+	resourceCalendars, err := m.getResourceCalendars(calendar, resourceUUIDs)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-				var projectSubtasks []*taskModel.Single
-				for _, task := range projectTaskMap[projectsUUID] {
-					projectSubtasks = append(projectSubtasks, task)
-				}
-				projectTask.Subtask = projectSubtasks
+	// the allocated hours follow the calendar of the project of each task
+	hoursMap := make(map[string][]float64)
+	projectCalendarMap := make(map[string]map[string]*schedule.ResourceCalendar)
+	for _, res := range taskResourceBase {
+		task := taskMap[*res.TaskUUID]
+		if task == nil || task.ProjectUUID == nil || task.StartDate == nil || task.EndDate == nil ||
+			!task.EndDate.After(periods[0].Start) || !task.StartDate.Before(periods[len(periods)-1].End) {
+			continue
+		}
 
-				if !input.FilterMilestone {
-					// filter out the subtasks that have been included in each SubTask
-					var filteredTasks []*taskModel.Single
-					for _, task := range projectTask.Subtask {
-						task.Predecessor = ""
-						if !task.IsSubTask {
-							filteredTasks = append(filteredTasks, task)
-						}
-					}
-					projectTask.Subtask = filteredTasks
-				} else {
-					projectTask.Subtask = projectSubtasks
-				}
+		projectCalendars, ok := projectCalendarMap[*task.ProjectUUID]
+		if !ok {
+			projectCalendar, err := m.GetWorkCalendar(task.ProjectUUID)
+			if err != nil {
+				log.Error(err)
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			}
 
-				// add project to output.Tasks
-				output.Tasks = append(output.Tasks, projectTask)
+			projectCalendars, err = m.getResourceCalendars(projectCalendar, resourceUUIDs)
+			if err != nil {
+				log.Error(err)
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+			}
+			projectCalendarMap[*task.ProjectUUID] = projectCalendars
+		}
 
-				// get project start date
-				for _, task := range output.Tasks {
-					if task.BaselineStartDate != nil {
-						if projectStartDate == nil || task.BaselineStartDate.Before(*projectStartDate) {
-							projectStartDate = task.BaselineStartDate
-						}
-					}
+		hours := projectCalendars[*res.ResourceUUID].Allocate(*task.StartDate, *task.EndDate, *res.Unit, periods)
+		if hoursMap[*res.ResourceUUID] == nil {
+			hoursMap[*res.ResourceUUID] = make([]float64, len(periods))
+		}
+		for i, hour := range hours {
+			hoursMap[*res.ResourceUUID][i] += hour
+		}
+	}
 
-					if task.StartDate != nil {
-						if projectStartDate == nil || task.StartDate.Before(*projectStartDate) {
-							projectStartDate = task.StartDate
-						}
-					}
+	for _, res := range resourceBase {
+		resourceLoad := &resourceModel.ResourceLoad{
+			ResourceUUID: *res.ResourceUUID,
+			ResourceName: *res.ResourceName,
+			Loads:        make([]*resourceModel.PeriodLoad, len(periods)),
+		}
 
-					if task.Subtask != nil {
-						for _, subtask := range task.Subtask {
-							if subtask.BaselineStartDate != nil {
-								if projectStartDate == nil || subtask.BaselineStartDate.Before(*projectStartDate) {
-									projectStartDate = subtask.BaselineStartDate
-								}
-							}
+		err = util.DecodeJSONToSlice(*res.ResourceGroup, &resourceLoad.ResourceGroups)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
 
-							if subtask.StartDate != nil {
-								if projectStartDate == nil || subtask.StartDate.Before(*projectStartDate) {
-									projectStartDate = subtask.StartDate
-								}
-							}
-						}
-					}
-				}
-				output.ProjectStartDate = projectStartDate
+		hours := hoursMap[*res.ResourceUUID]
+		for i, period := range periods {
+			periodLoad := &resourceModel.PeriodLoad{
+				StartDate:      period.Start,
+				EndDate:        period.End.AddDate(0, 0, -1),
+				AvailableHours: math.Round(resourceCalendars[*res.ResourceUUID].WorkingHours(period.Start, period.End)*100) / 100,
 			}
-		} else {
-			if len(input.Projects) == 1 {
-				// return project status
-				output.ProjectStatus = *projectBase[0].Status
-
-				// if the user is admin or project manager, check if the user can edit or delete tasks of the project
-				if *input.Role == "admin" || isPM || isEditable {
-					output.IsEditable = true
-				} else {
-					// if the user is not a project member, check if the user is the creator of the project
-					if projectMap[*projectsUUID].CreatedBy == *input.UserID {
-						output.IsEditable = true
-					}
-				}
+			if hours != nil {
+				periodLoad.Hours = math.Round(hours[i]*100) / 100
 			}
-		}
 
-		// check if output.Tasks is null, return empty array
-		if output.Tasks == nil {
-			output.Tasks = []*taskModel.Single{}
+			resourceLoad.TotalHours += periodLoad.Hours
+			resourceLoad.Loads[i] = periodLoad
 		}
 
-		// get event_marks
-		var eventMarks []*eventMarkModel.Single
-		eventMarkBase, err := m.EventMarkService.GetByListNoPagination(&eventMarkModel.Field{
-			ProjectUUID: projectsUUID,
-		})
+		resourceLoad.TotalHours = math.Round(resourceLoad.TotalHours*100) / 100
+		output.Resources = append(output.Resources, resourceLoad)
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) Level(trx *gorm.DB, input *taskModel.Level) (int, any) {
+	defer trx.Rollback()
+
+	if input.Mode == "" {
+		input.Mode = "preview"
+	}
+
+	if input.Mode == "commit" {
+		reason, err := m.CheckEditable(trx, input.ProjectUUID, input.Role, input.ResUUID)
 		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+			}
+
 			log.Error(err)
 			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 		}
-		eventMarkByte, err := sonic.Marshal(eventMarkBase)
-		if err != nil {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+
+		if reason != "" {
+			log.Info(reason)
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
+		}
+	}
+
+	// get tasks for the project
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: util.PointerString(input.ProjectUUID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	activities := make([]*schedule.Activity, 0, len(taskBase))
+	taskMap := make(map[string]*taskDB.Base)
+	var (
+		taskUUIDs     []*string
+		resourceUUIDs []*string
+	)
+	resourceUUIDMap := make(map[string]bool)
+	summaryMap := make(map[string]bool)
+	for _, task := range taskBase {
+		taskMap[*task.TaskUUID] = task
+		taskUUIDs = append(taskUUIDs, task.TaskUUID)
+		if index := strings.LastIndex(*task.OutlineNumber, "."); index >= 0 {
+			summaryMap[(*task.OutlineNumber)[:index]] = true
+		}
+
+		activity := assembleTaskActivity(task)
+
+		// the started and the segmented tasks are kept in place
+		if (task.Progress != nil && *task.Progress > 0) || (task.Segment != nil && *task.Segment != "") {
+			activity.Priority = schedule.DoNotLevel
+		}
+		activities = append(activities, activity)
+
+		for _, res := range task.TaskResources {
+			if res.ResourceUUID != nil && !resourceUUIDMap[*res.ResourceUUID] {
+				resourceUUIDMap[*res.ResourceUUID] = true
+				resourceUUIDs = append(resourceUUIDs, res.ResourceUUID)
+			}
+		}
+	}
+
+	dependencies, err := m.getDependencies(trx, util.PointerString(input.ProjectUUID), activities)
+	if err != nil {
+		if schedule.IsInvalid(err) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// get the assignments of the project's resources across all the projects
+	allocation, err := m.getAllocation(trx, util.PointerString(input.ProjectUUID), resourceUUIDs)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// get the working calendar
+	calendar, err := m.GetWorkCalendar(util.PointerString(input.ProjectUUID))
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	moved, err := schedule.Level(activities, dependencies, allocation.assignments, allocation.capacities, calendar)
+	if err != nil {
+		if schedule.IsInvalid(err) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output := &taskModel.LevelResult{
+		ProjectUUID:     input.ProjectUUID,
+		Mode:            input.Mode,
+		LeveledTasks:    []*taskModel.LeveledTask{},
+		MovedTasks:      []*taskModel.MovedTask{},
+		SummaryTasks:    []*taskModel.SummaryTask{},
+		Warnings:        []*taskModel.ResourceWarning{},
+		Overallocations: []*resourceModel.Overallocation{},
+	}
+
+	activityMap := make(map[string]*schedule.Activity)
+	for _, activity := range moved {
+		activityMap[activity.UUID] = activity
+		task := taskMap[activity.UUID]
+		leveledTask := &taskModel.LeveledTask{
+			TaskUUID:          activity.UUID,
+			TaskID:            activity.TaskID,
+			TaskName:          *task.TaskName,
+			IsSummary:         summaryMap[activity.OutlineNumber],
+			OriginalStartDate: task.StartDate,
+			OriginalEndDate:   task.EndDate,
+			StartDate:         util.PointerTime(activity.Start),
+			EndDate:           util.PointerTime(activity.End),
+		}
+		if task.StartDate != nil {
+			leveledTask.Delay = math.Round(calendar.Days(*task.StartDate, activity.Start)*100) / 100
+		}
+		output.LeveledTasks = append(output.LeveledTasks, leveledTask)
+	}
+
+	if input.Mode == "preview" {
+		// find the over-allocations which remain after the proposed changes
+		for _, assignment := range allocation.assignments {
+			if activity, ok := activityMap[assignment.TaskUUID]; ok {
+				assignment.Start, assignment.End = activity.Start, activity.End
+			}
+		}
+
+		for _, item := range schedule.Overallocations(allocation.assignments, allocation.capacities, calendar) {
+			for _, taskUUID := range item.TaskUUIDs {
+				if taskMap[taskUUID] != nil {
+					output.Overallocations = append(output.Overallocations, allocation.assembleOverallocation(item))
+					break
+				}
+			}
 		}
 
-		err = sonic.Unmarshal(eventMarkByte, &eventMarks)
+		return code.Successful, code.GetCodeMessage(code.Successful, output)
+	}
+
+	// apply the proposed changes as the task updates
+	var (
+		changed      []string
+		leveledTasks []*taskModel.MovedTask
+	)
+	for _, activity := range moved {
+		err = m.TaskService.WithTrx(trx).Update(&taskModel.Update{
+			TaskUUID:  activity.UUID,
+			StartDate: util.PointerTime(activity.Start),
+			EndDate:   util.PointerTime(activity.End),
+			UpdatedBy: input.UpdatedBy,
+		})
 		if err != nil {
 			log.Error(err)
 			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 		}
 
-		// add event_marks to output.Tasks
-		output.EventMarks = append(output.EventMarks, eventMarks...)
+		changed = append(changed, activity.UUID)
+		leveledTasks = append(leveledTasks, &taskModel.MovedTask{
+			TaskUUID:  activity.UUID,
+			TaskID:    activity.TaskID,
+			StartDate: util.PointerTime(activity.Start),
+			EndDate:   util.PointerTime(activity.End),
+		})
 	}
 
-	return code.Successful, code.GetCodeMessage(code.Successful, output)
-}
+	// sync reschedule the successors of the leveled tasks
+	output.MovedTasks, err = m.syncRescheduleSuccessors(trx, util.PointerString(input.ProjectUUID), changed, *input.UpdatedBy)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-func (m *manager) GetByListNoPaginationNoSub(input *taskModel.Field) (int, any) {
-	output := &taskModel.List{}
-	taskBase, err := m.TaskService.GetByListNoPagination(input)
+	// sync update project's start and end dates
+	start, end := extendDateRange(nil, nil, append(leveledTasks, output.MovedTasks...))
+	err = m.SyncUpdateProjectStartEndDate(trx, util.PointerString(input.ProjectUUID), nil, start, end)
 	if err != nil {
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	taskByte, err := sonic.Marshal(taskBase)
+	// sync roll up the summary tasks from their subtasks
+	output.SummaryTasks, err = m.syncRollUpSummaryTasks(trx, util.PointerString(input.ProjectUUID), *input.UpdatedBy)
 	if err != nil {
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	err = sonic.Unmarshal(taskByte, &output.Tasks)
+	// check the resources assigned on their non-working days
+	output.Warnings, err = m.getResourceWarnings(trx, util.PointerString(input.ProjectUUID), taskUUIDs, calendar)
 	if err != nil {
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	for i, task := range output.Tasks {
-		task.CreatedBy = *taskBase[i].CreatedByUsers.Name
-		task.UpdatedBy = *taskBase[i].UpdatedByUsers.Name
-		for j, file := range taskBase[i].S3Files {
-			task.Files[j].CreatedBy = *file.CreatedByUsers.Name
-		}
+	// check the resources assigned beyond their capacity
+	output.Overallocations, err = m.getTaskOverallocations(trx, util.PointerString(input.ProjectUUID), taskUUIDs)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-		// transform segments to array
-		var segments []taskModel.Segments
-		err = util.DecodeJSONToSlice(*taskBase[i].Segment, &segments)
-		if err != nil {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-		}
-		task.Segments = segments
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
 
-		// transform indicator to array
-		var indicators []taskModel.Indicators
-		err = util.DecodeJSONToSlice(*taskBase[i].Indicator, &indicators)
-		if err != nil {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-		}
-		task.Indicators = indicators
-		if len(indicators) > 0 {
-			task.IndicatorsName = indicators[0].Name
-			task.IndicatorsIconClass = indicators[0].IconClass
-			task.IndicatorsToolTip = indicators[0].ToolTip
+func (m *manager) RollUp(trx *gorm.DB, input *taskModel.RollUp) (int, any) {
+	defer trx.Rollback()
+
+	reason, err := m.CheckEditable(trx, input.ProjectUUID, input.Role, input.ResUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
 		}
 
-		for j, res := range taskBase[i].TaskResources {
-			task.Resources[j].ResourceUUID = *res.Resources.ResourceUUID
-			task.Resources[j].ResourceID = *res.Resources.Resources.ResourceID
-			task.Resources[j].ResourceName = *res.Resources.Resources.ResourceName
-			task.Resources[j].Email = *res.Resources.Resources.Email
-			task.Resources[j].Phone = *res.Resources.Resources.Phone
-			task.Resources[j].StandardCost = *res.Resources.Resources.StandardCost
-			task.Resources[j].TotalCost = *res.Resources.Resources.TotalCost
-			task.Resources[j].TotalLoad = *res.Resources.Resources.TotalLoad
-			task.Resources[j].IsExpand = *res.Resources.Resources.IsExpand
-			task.Resources[j].Role = *res.Resources.Role
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-			// transform resource_groups to array
-			var resourceGroup []string
-			err = sonic.Unmarshal([]byte(*res.Resources.Resources.ResourceGroup), &resourceGroup)
-			if err != nil {
-				log.Error(err)
-				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-			}
-			task.Resources[j].ResourceGroups = resourceGroup
-		}
+	if reason != "" {
+		log.Info(reason)
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
 	}
 
-	return code.Successful, code.GetCodeMessage(code.Successful, output)
+	// sync roll up the summary tasks from their subtasks
+	summaryTasks, err := m.syncRollUpSummaryTasks(trx, util.PointerString(input.ProjectUUID), *input.UpdatedBy)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, &taskModel.RollUpResult{
+		ProjectUUID:  input.ProjectUUID,
+		SummaryTasks: summaryTasks,
+	})
 }
 
-func (m *manager) GetBySingle(input *taskModel.Field) (int, any) {
-	taskBase, err := m.TaskService.GetBySingle(input)
+func (m *manager) Move(trx *gorm.DB, input *taskModel.Move) (int, any) {
+	defer trx.Rollback()
+
+	taskBase, err := m.TaskService.WithTrx(trx).GetBySingle(&taskModel.Field{
+		TaskUUID: input.TaskUUID,
+	})
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
@@ -1310,270 +5228,494 @@ func (m *manager) GetBySingle(input *taskModel.Field) (int, any) {
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	output := &taskModel.Single{}
-	taskByte, _ := sonic.Marshal(taskBase)
-	err = sonic.Unmarshal(taskByte, &output)
+	reason, err := m.CheckEditable(trx, *taskBase.ProjectUUID, input.Role, input.ResUUID)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	output.CreatedBy = *taskBase.CreatedByUsers.Name
-	output.UpdatedBy = *taskBase.UpdatedByUsers.Name
-	for j, file := range taskBase.S3Files {
-		output.Files[j].CreatedBy = *file.CreatedByUsers.Name
+	if reason != "" {
+		log.Info(reason)
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
 	}
 
-	// transform segments to array
-	var segments []taskModel.Segments
-	err = util.DecodeJSONToSlice(*taskBase.Segment, &segments)
+	// get tasks for the project in outline order
+	tasksBase, err := m.TaskService.WithTrx(trx).GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: taskBase.ProjectUUID,
+	})
 	if err != nil {
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
-	output.Segments = segments
 
-	// transform indicator to array
-	var indicators []taskModel.Indicators
-	err = util.DecodeJSONToSlice(*taskBase.Indicator, &indicators)
+	// the task_ids follow the rows when they are the row numbers
+	isRowNumber := true
+	nodes := make([]*schedule.Node, 0, len(tasksBase))
+	taskMap := make(map[string]*taskDB.Base)
+	for i, task := range tasksBase {
+		taskMap[*task.TaskUUID] = task
+		nodes = append(nodes, &schedule.Node{
+			UUID:          *task.TaskUUID,
+			OutlineNumber: *task.OutlineNumber,
+		})
+		if *task.TaskID != strconv.Itoa(i+1) {
+			isRowNumber = false
+		}
+	}
+
+	if input.TargetUUID != "" && taskMap[input.TargetUUID] == nil {
+		log.Info("The target task does not belong to the project.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The target task does not belong to the project.")
+	}
+
+	nodes, err = schedule.Restructure(nodes, input.TaskUUID, input.Position, input.TargetUUID)
 	if err != nil {
+		if errors.Is(err, schedule.ErrInvalidMove) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
-	output.Indicators = indicators
-	if len(indicators) > 0 {
-		output.IndicatorsName = indicators[0].Name
-		output.IndicatorsIconClass = indicators[0].IconClass
-		output.IndicatorsToolTip = indicators[0].ToolTip
+
+	outlineMap := make(map[string]*taskDB.Base)
+	taskIDMap := make(map[string]string)
+	for i, node := range nodes {
+		task := taskMap[node.UUID]
+		outlineMap[node.OutlineNumber] = task
+		if isRowNumber {
+			taskIDMap[*task.TaskID] = strconv.Itoa(i + 1)
+		}
 	}
 
-	for i, res := range taskBase.TaskResources {
-		output.Resources[i].ResourceUUID = *res.Resources.ResourceUUID
-		output.Resources[i].ResourceID = *res.Resources.Resources.ResourceID
-		output.Resources[i].ResourceName = *res.Resources.Resources.ResourceName
-		output.Resources[i].Email = *res.Resources.Resources.Email
-		output.Resources[i].Phone = *res.Resources.Resources.Phone
-		output.Resources[i].StandardCost = *res.Resources.Resources.StandardCost
-		output.Resources[i].TotalCost = *res.Resources.Resources.TotalCost
-		output.Resources[i].TotalLoad = *res.Resources.Resources.TotalLoad
-		output.Resources[i].IsExpand = *res.Resources.Resources.IsExpand
-		output.Resources[i].Role = *res.Resources.Role
+	// the segmented task cannot become a parent task
+	for _, node := range nodes {
+		if node.UUID != input.TaskUUID {
+			continue
+		}
 
-		// transform resource_groups to array
-		var resourceGroup []string
-		err = sonic.Unmarshal([]byte(*res.Resources.Resources.ResourceGroup), &resourceGroup)
-		if err != nil {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		if parent := outlineMap[GetParentOutlineNumber(node.OutlineNumber)]; parent != nil && parent.Segment != nil && *parent.Segment != "" {
+			log.Info("Please remove the task segmentation first.")
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "Please remove the task segmentation first.")
 		}
-		output.Resources[i].ResourceGroups = resourceGroup
 	}
-	return code.Successful, code.GetCodeMessage(code.Successful, output)
-}
 
-func (m *manager) Delete(trx *gorm.DB, input *taskModel.DeletedTaskUUIDs) (int, any) {
-	defer trx.Rollback()
+	outlineTasks := []*taskModel.OutlineTask{}
+	for _, node := range nodes {
+		task := taskMap[node.UUID]
+		outlineTask := &taskModel.OutlineTask{
+			TaskUUID:      node.UUID,
+			TaskID:        *task.TaskID,
+			OutlineNumber: node.OutlineNumber,
+			IsSubTask:     strings.Contains(node.OutlineNumber, "."),
+			Predecessor:   *task.Predecessor,
+		}
 
-	// check the update_by has the permission to delete the project's tasks
-	if *input.Role != "admin" {
-		// search project_resource
-		proResBase, err := m.ProjectResourceService.GetBySingle(&projectResourceModel.Field{
-			ProjectUUID:  input.ProjectUUID,
-			ResourceUUID: input.ResUUID,
-		})
-		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		// fix the references to the renumbered tasks
+		if len(taskIDMap) > 0 {
+			outlineTask.TaskID = taskIDMap[*task.TaskID]
+			predecessors, err := schedule.ParsePredecessors(*task.Predecessor)
+			if err == nil {
+				for _, predecessor := range predecessors {
+					if taskID, ok := taskIDMap[predecessor.TaskID]; ok {
+						predecessor.TaskID = taskID
+					}
+				}
+				outlineTask.Predecessor = schedule.FormatPredecessors(predecessors)
 			}
+		}
+
+		if outlineTask.TaskID == *task.TaskID && outlineTask.OutlineNumber == *task.OutlineNumber &&
+			task.IsSubTask != nil && outlineTask.IsSubTask == *task.IsSubTask && outlineTask.Predecessor == *task.Predecessor {
+			continue
+		}
 
+		err = m.TaskService.WithTrx(trx).Update(&taskModel.Update{
+			TaskUUID:      node.UUID,
+			TaskID:        util.PointerString(outlineTask.TaskID),
+			OutlineNumber: util.PointerString(outlineTask.OutlineNumber),
+			IsSubTask:     util.PointerBool(outlineTask.IsSubTask),
+			Predecessor:   util.PointerString(outlineTask.Predecessor),
+			UpdatedBy:     input.UpdatedBy,
+		})
+		if err != nil {
 			log.Error(err)
 			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 		}
 
-		if proResBase != nil {
-			if !*proResBase.IsEditable {
-				log.Info("The user don't have permission to update the project's tasks.")
-				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The user don't have permission to update the project's tasks.")
-			}
-		}
+		outlineTasks = append(outlineTasks, outlineTask)
 	}
 
-	err := m.TaskService.WithTrx(trx).Delete(&taskModel.Field{
-		DeletedTaskUUIDs: input.Tasks,
-	})
+	// sync task_dependencies
+	err = m.SyncTaskDependencies(trx, taskBase.ProjectUUID, *input.UpdatedBy)
 	if err != nil {
+		if schedule.IsInvalid(err) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	// sync delete task_resource
-	err = m.syncDeleteTaskResources(trx, nil, input.Tasks, true)
+	// sync roll up the summary tasks from their subtasks
+	summaryTasks, err := m.syncRollUpSummaryTasks(trx, taskBase.ProjectUUID, *input.UpdatedBy)
 	if err != nil {
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	// sync update project's start and end dates
-	err = m.syncUpdateProjectStartEndDate(trx, input.ProjectUUID, input.Tasks, nil, nil)
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, &taskModel.Result{
+		TaskUUID:        input.TaskUUID,
+		MovedTasks:      []*taskModel.MovedTask{},
+		Warnings:        []*taskModel.ResourceWarning{},
+		Overallocations: []*resourceModel.Overallocation{},
+		SummaryTasks:    summaryTasks,
+		OutlineTasks:    outlineTasks,
+	})
+}
+
+func (m *manager) Duplicate(trx *gorm.DB, input *taskModel.Duplicate) (int, any) {
+	defer trx.Rollback()
+
+	taskBase, err := m.TaskService.WithTrx(trx).GetBySingle(&taskModel.Field{
+		TaskUUID: input.TaskUUID,
+	})
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	trx.Commit()
-	return code.Successful, code.GetCodeMessage(code.Successful, "Delete ok!")
-}
+	// the task is duplicated within its project by default
+	if input.ProjectUUID == nil {
+		input.ProjectUUID = taskBase.ProjectUUID
+	}
+	isSameProject := *input.ProjectUUID == *taskBase.ProjectUUID
 
-func (m *manager) Update(trx *gorm.DB,
-	input *taskModel.Update) (int, any) {
-	defer trx.Rollback()
+	reason, err := m.CheckEditable(trx, *input.ProjectUUID, input.Role, input.ResUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
 
-	// get all resources of the project
-	proResBase, err := m.ProjectResourceService.GetByListNoPagination(&projectResourceModel.Field{
-		ProjectUUID: input.ProjectUUID,
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if reason != "" {
+		log.Info(reason)
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
+	}
+
+	// get the task with its subtasks in outline order
+	sourceBase, err := m.TaskService.WithTrx(trx).GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: taskBase.ProjectUUID,
 	})
 	if err != nil {
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	var subtree []*taskDB.Base
+	for _, task := range sourceBase {
+		if *task.TaskUUID == input.TaskUUID || strings.HasPrefix(*task.OutlineNumber, *taskBase.OutlineNumber+".") {
+			subtree = append(subtree, task)
+		}
+	}
+
+	// get tasks for the target project in outline order
+	tasksBase := sourceBase
+	if !isSameProject {
+		tasksBase, err = m.TaskService.WithTrx(trx).GetByListNoPagination(&taskModel.Field{
+			ProjectUUID: input.ProjectUUID,
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	// the task_ids follow the rows when they are the row numbers, otherwise the copies take the next task_ids
+	isRowNumber := true
+	lastTaskID := 0
+	topLevel := 0
+	nodes := make([]*schedule.Node, 0, len(tasksBase)+len(subtree))
+	taskMap := make(map[string]*taskDB.Base)
+	for i, task := range tasksBase {
+		taskMap[*task.TaskUUID] = task
+		nodes = append(nodes, &schedule.Node{
+			UUID:          *task.TaskUUID,
+			OutlineNumber: *task.OutlineNumber,
+		})
+		if *task.TaskID != strconv.Itoa(i+1) {
+			isRowNumber = false
+		}
+		if taskID, err := strconv.Atoi(*task.TaskID); err == nil && taskID > lastTaskID {
+			lastTaskID = taskID
+		}
+		if !strings.Contains(*task.OutlineNumber, ".") {
+			topLevel++
+		}
+	}
+
+	if input.TargetUUID != nil && taskMap[*input.TargetUUID] == nil {
+		log.Info("The target task does not belong to the project.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The target task does not belong to the project.")
+	}
+
+	// the copies are appended to the end of the project, keyed by their source UUIDs, before they are placed
+	copyMap := make(map[string]*taskDB.Base)
+	rootOutlineNumber := strconv.Itoa(topLevel + 1)
+	for _, task := range subtree {
+		key := "copy:" + *task.TaskUUID
+		copyMap[key] = task
+		nodes = append(nodes, &schedule.Node{
+			UUID:          key,
+			OutlineNumber: rootOutlineNumber + strings.TrimPrefix(*task.OutlineNumber, *taskBase.OutlineNumber),
+		})
+	}
+
+	if input.TargetUUID != nil {
+		position := input.Position
+		if position == "" {
+			position = schedule.MoveAfter
+		}
+
+		nodes, err = schedule.Restructure(nodes, "copy:"+input.TaskUUID, position, *input.TargetUUID)
+		if err != nil {
+			if errors.Is(err, schedule.ErrInvalidMove) {
+				log.Info(err.Error())
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+			}
+
 			log.Error(err)
 			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 		}
 	}
 
-	var proRes []*projectResourceModel.Single
-	proResByte, err := sonic.Marshal(proResBase)
-	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-	}
+	outlineMap := make(map[string]*taskDB.Base)
+	taskIDMap := make(map[string]string)
+	copyIDMap := make(map[string]string)
+	for i, node := range nodes {
+		if source, ok := copyMap[node.UUID]; ok {
+			if isRowNumber {
+				copyIDMap[*source.TaskID] = strconv.Itoa(i + 1)
+			} else {
+				lastTaskID++
+				copyIDMap[*source.TaskID] = strconv.Itoa(lastTaskID)
+			}
 
-	err = sonic.Unmarshal(proResByte, &proRes)
-	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-	}
+			continue
+		}
 
-	// create a map of resourceUUID
-	proResMap := make(map[string]*projectResourceModel.Single)
-	if len(proRes) > 0 {
-		for _, res := range proRes {
-			proResMap[res.ResourceUUID] = res
+		task := taskMap[node.UUID]
+		outlineMap[node.OutlineNumber] = task
+		if isRowNumber {
+			taskIDMap[*task.TaskID] = strconv.Itoa(i + 1)
 		}
 	}
 
-	taskBase, err := m.TaskService.GetBySingle(&taskModel.Field{
-		TaskUUID: input.TaskUUID,
-	})
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+	// the segmented task cannot become a parent task
+	for _, node := range nodes {
+		if node.UUID != "copy:"+input.TaskUUID {
+			continue
 		}
 
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		if parent := outlineMap[GetParentOutlineNumber(node.OutlineNumber)]; parent != nil && parent.Segment != nil && *parent.Segment != "" {
+			log.Info("Please remove the task segmentation first.")
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "Please remove the task segmentation first.")
+		}
 	}
 
-	// get the project for the task
-	projectBase, err := m.ProjectService.GetBySingle(&projectModel.Field{
-		ProjectUUID: *taskBase.ProjectUUID,
+	// get the resources of the target project
+	proResBase, err := m.ProjectResourceService.WithTrx(trx).GetByListNoPagination(&projectResourceModel.Field{
+		ProjectUUID: input.ProjectUUID,
 	})
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 		}
+	}
 
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	proResMap := make(map[string]*projectResourceModel.Single)
+	for _, res := range proResBase {
+		proResMap[*res.ResourceUUID] = &projectResourceModel.Single{
+			ResourceUUID: *res.ResourceUUID,
+		}
 	}
 
-	// check the update_by has the permission to update the project's tasks
-	if *input.Role != "admin" {
-		if proResMap[*input.ResUUID] != nil {
-			if !proResMap[*input.ResUUID].IsEditable {
-				log.Info("The user don't have permission to update the project's tasks.")
-				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The user don't have permission to update the project's tasks.")
-			}
+	var (
+		minBaselineStart, maxBaselineEnd *time.Time
+		taskUUIDs                        []*string
+		taskResMapList                   []map[string][]*resourceModel.TaskSingle
+	)
+	outlineTasks := []*taskModel.OutlineTask{}
+	duplicatedTasks := []*taskModel.DuplicatedTask{}
+	for _, node := range nodes {
+		source, isCopy := copyMap[node.UUID]
+		task := taskMap[node.UUID]
+		if isCopy {
+			task = source
 		}
 
-		// check if the update_by is the task's creator or assigned resource
-		if taskBase.CreatedBy != input.UpdatedBy {
-			assigned := false
-			for _, res := range taskBase.TaskResources {
-				if *res.ResourceUUID == *input.ResUUID {
-					assigned = true
-					break
+		outlineTask := &taskModel.OutlineTask{
+			TaskID:        *task.TaskID,
+			OutlineNumber: node.OutlineNumber,
+			IsSubTask:     strings.Contains(node.OutlineNumber, "."),
+			Predecessor:   *task.Predecessor,
+		}
+
+		// fix the references to the renumbered and copied tasks, the copies drop the references to the other projects
+		predecessors, err := schedule.ParsePredecessors(*task.Predecessor)
+		if err == nil && (isCopy || len(taskIDMap) > 0) {
+			var remapped []*schedule.Predecessor
+			for _, predecessor := range predecessors {
+				if taskID, ok := copyIDMap[predecessor.TaskID]; ok && isCopy {
+					predecessor.TaskID = taskID
+				} else if isCopy && !isSameProject {
+					continue
+				} else if taskID, ok := taskIDMap[predecessor.TaskID]; ok {
+					predecessor.TaskID = taskID
 				}
+				remapped = append(remapped, predecessor)
 			}
-			if !assigned {
-				log.Info("The user don't have permission to update the project's tasks.")
-				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The user don't have permission to update the project's tasks.")
-			}
+			outlineTask.Predecessor = schedule.FormatPredecessors(remapped)
 		}
-	}
 
-	// determine the project status
-	if *projectBase.Status != "建檔中" {
-		if *projectBase.Status == "執行中" || *projectBase.Status == "暫停中" {
-			if (!input.BaselineStartDate.IsZero() && input.BaselineStartDate != taskBase.BaselineStartDate) || (!input.BaselineEndDate.IsZero() && input.BaselineEndDate != taskBase.BaselineEndDate) {
-				log.Info("The baseline cannot be modified while project is in progress.")
-				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The baseline cannot be modified while project is in progress.")
+		if !isCopy {
+			outlineTask.TaskUUID = node.UUID
+			if taskID, ok := taskIDMap[*task.TaskID]; ok {
+				outlineTask.TaskID = taskID
 			}
-		} else {
-			// transform taskBase to update struct
-			original := &taskModel.Update{}
-			taskByte, err := sonic.Marshal(taskBase)
-			if err != nil {
-				log.Error(err)
-				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+
+			if outlineTask.TaskID == *task.TaskID && outlineTask.OutlineNumber == *task.OutlineNumber &&
+				task.IsSubTask != nil && outlineTask.IsSubTask == *task.IsSubTask && outlineTask.Predecessor == *task.Predecessor {
+				continue
 			}
 
-			err = sonic.Unmarshal(taskByte, &original)
+			err = m.TaskService.WithTrx(trx).Update(&taskModel.Update{
+				TaskUUID:      node.UUID,
+				TaskID:        util.PointerString(outlineTask.TaskID),
+				OutlineNumber: util.PointerString(outlineTask.OutlineNumber),
+				IsSubTask:     util.PointerBool(outlineTask.IsSubTask),
+				Predecessor:   util.PointerString(outlineTask.Predecessor),
+				UpdatedBy:     util.PointerString(input.CreatedBy),
+			})
 			if err != nil {
 				log.Error(err)
 				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 			}
 
-			if input != original {
-				log.Info("The project is completed and cannot be modified.")
-				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The project is completed and cannot be modified.")
-			}
+			outlineTasks = append(outlineTasks, outlineTask)
+			continue
 		}
-	}
 
-	// get the quantity of subtasks
-	subQuantity, _ := m.TaskService.GetByQuantity(&taskModel.Field{
-		OutlineNumber: taskBase.OutlineNumber,
-	})
+		outlineTask.TaskID = copyIDMap[*task.TaskID]
+		copyBase, err := m.TaskService.WithTrx(trx).Create(&taskModel.Create{
+			TaskID:              outlineTask.TaskID,
+			TaskName:            *task.TaskName,
+			StartDate:           task.StartDate,
+			EndDate:             task.EndDate,
+			BaselineStartDate:   task.BaselineStartDate,
+			BaselineEndDate:     task.BaselineEndDate,
+			BaselineDuration:    *task.BaselineDuration,
+			Duration:            *task.Duration,
+			OptimisticDuration:  task.OptimisticDuration,
+			MostLikelyDuration:  task.MostLikelyDuration,
+			PessimisticDuration: task.PessimisticDuration,
+			Progress:            *task.Progress,
+			Status:              *task.Status,
+			Cost:                *task.Cost,
+			Predecessor:         outlineTask.Predecessor,
+			OutlineNumber:       outlineTask.OutlineNumber,
+			Assignments:         *task.Assignments,
+			TaskColor:           *task.TaskColor,
+			WebLink:             *task.WebLink,
+			IsSubTask:           outlineTask.IsSubTask,
+			IsMilestone:         *task.IsMilestone,
+			ProjectUUID:         *input.ProjectUUID,
+			Segment:             *task.Segment,
+			Indicator:           *task.Indicator,
+			Notes:               *task.Notes,
+			ConstraintType:      *task.ConstraintType,
+			ConstraintDate:      task.ConstraintDate,
+			Deadline:            task.Deadline,
+			Priority:            task.Priority,
+			CreatedBy:           input.CreatedBy,
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
 
-	if len(input.Segments) > 0 {
-		if subQuantity > 0 {
-			log.Info("The parent task cannot be segmented.")
-			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The parent task cannot be segmented.")
+		outlineTask.TaskUUID = *copyBase.TaskUUID
+		outlineTasks = append(outlineTasks, outlineTask)
+		taskUUIDs = append(taskUUIDs, copyBase.TaskUUID)
+		duplicatedTasks = append(duplicatedTasks, &taskModel.DuplicatedTask{
+			SourceTaskUUID: *task.TaskUUID,
+			TaskUUID:       *copyBase.TaskUUID,
+			TaskID:         outlineTask.TaskID,
+			OutlineNumber:  outlineTask.OutlineNumber,
+		})
+
+		if task.BaselineStartDate != nil && task.BaselineEndDate != nil {
+			if minBaselineStart == nil || task.BaselineStartDate.Before(*minBaselineStart) {
+				minBaselineStart = task.BaselineStartDate
+			}
+			if maxBaselineEnd == nil || task.BaselineEndDate.After(*maxBaselineEnd) {
+				maxBaselineEnd = task.BaselineEndDate
+			}
 		}
 
-		// transform segments from struct array to string
-		segJson, _ := sonic.Marshal(input.Segments)
-		input.Segment = util.PointerString(string(segJson))
-	}
+		// the resources are assigned when they are members of the target project
+		var resources []*resourceModel.TaskSingle
+		for _, res := range task.TaskResources {
+			if proResMap[*res.ResourceUUID] != nil {
+				resources = append(resources, &resourceModel.TaskSingle{
+					ResourceUUID: *res.ResourceUUID,
+					Unit:         *res.Unit,
+				})
+			}
+		}
 
-	// transform indicators from struct array to string
-	if len(input.Indicators) > 0 {
-		indJson, _ := sonic.Marshal(input.Indicators)
-		input.Indicator = util.PointerString(string(indJson))
-	}
+		if len(resources) > 0 {
+			taskResMapList = append(taskResMapList, map[string][]*resourceModel.TaskSingle{
+				*copyBase.TaskUUID: resources,
+			})
+		}
 
-	// sync delete task_resource
-	err = m.syncDeleteTaskResources(trx, util.PointerString(input.TaskUUID), nil, false)
-	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		// the attachments share the uploaded files of the source task
+		if input.IncludeAttachments {
+			for _, file := range task.S3Files {
+				_, err = m.S3FileService.WithTrx(trx).Create(&s3FileModel.Create{
+					FileUrl:       *file.FileUrl,
+					FileName:      *file.FileName,
+					FileExtension: *file.FileExtension,
+					SourceUUID:    *copyBase.TaskUUID,
+					CreatedBy:     input.CreatedBy,
+				})
+				if err != nil {
+					log.Error(err)
+					return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+				}
+			}
+		}
 	}
 
 	// sync create task_resource
-	if len(input.Resources) > 0 {
-		taskResMapList := []map[string][]*resourceModel.TaskSingle{
-			{*taskBase.TaskUUID: input.Resources},
-		}
-		err = m.syncCreateTaskResources(trx, taskResMapList, *input.UpdatedBy, *input.ProjectUUID, proResMap)
+	if len(taskResMapList) > 0 {
+		err = m.SyncCreateTaskResources(trx, taskResMapList, input.CreatedBy, *input.ProjectUUID, proResMap)
 		if err != nil {
 			log.Error(err)
 			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
@@ -1581,550 +5723,720 @@ func (m *manager) Update(trx *gorm.DB,
 	}
 
 	// sync update project's start and end dates
-	err = m.syncUpdateProjectStartEndDate(trx, taskBase.ProjectUUID, nil, input.BaselineStartDate, input.BaselineEndDate)
+	err = m.SyncUpdateProjectStartEndDate(trx, input.ProjectUUID, nil, minBaselineStart, maxBaselineEnd)
 	if err != nil {
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	err = m.TaskService.WithTrx(trx).Update(input)
+	// sync task_dependencies
+	err = m.SyncTaskDependencies(trx, input.ProjectUUID, input.CreatedBy)
 	if err != nil {
+		if schedule.IsInvalid(err) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	trx.Commit()
-	return code.Successful, code.GetCodeMessage(code.Successful, taskBase.TaskUUID)
-}
-
-func (m *manager) UpdateAll(trx *gorm.DB, input []*taskModel.Update) (int, any) {
-	defer trx.Rollback()
-
-	log.Info("UpdateAll Start !!")
-	var (
-		updateList                       []*taskModel.Update
-		taskResMapList                   []map[string][]*resourceModel.TaskSingle
-		TaskUUIDs                        []*string
-		minBaselineStart, maxBaselineEnd *time.Time
-	)
+	// sync roll up the summary tasks from their subtasks
+	summaryTasks, err := m.syncRollUpSummaryTasks(trx, input.ProjectUUID, input.CreatedBy)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-	// get all resources of the project
-	proResBase, err := m.ProjectResourceService.GetByListNoPagination(&projectResourceModel.Field{
-		ProjectUUID: input[0].ProjectUUID,
-	})
+	// get the working calendar
+	calendar, err := m.GetWorkCalendar(input.ProjectUUID)
 	if err != nil {
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-		}
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	var proRes []*projectResourceModel.Single
-	proResByte, err := sonic.Marshal(proResBase)
+	// check the resources assigned on their non-working days
+	warnings, err := m.getResourceWarnings(trx, input.ProjectUUID, taskUUIDs, calendar)
 	if err != nil {
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	err = sonic.Unmarshal(proResByte, &proRes)
+	// check the resources assigned beyond their capacity
+	overallocations, err := m.getTaskOverallocations(trx, input.ProjectUUID, taskUUIDs)
 	if err != nil {
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	// create a map of resourceUUID
-	proResMap := make(map[string]*projectResourceModel.Single)
-	if len(proRes) > 0 {
-		for _, res := range proRes {
-			proResMap[res.ResourceUUID] = res
-		}
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, &taskModel.Result{
+		TaskUUID:        duplicatedTasks[0].TaskUUID,
+		MovedTasks:      []*taskModel.MovedTask{},
+		Warnings:        warnings,
+		Overallocations: overallocations,
+		SummaryTasks:    summaryTasks,
+		OutlineTasks:    outlineTasks,
+		DuplicatedTasks: duplicatedTasks,
+	})
+}
+
+// occurrence is an occurrence of a recurring task, either a kept task or a task to create.
+type occurrence struct {
+	start  *time.Time
+	task   *taskDB.Base
+	create *taskModel.Create
+}
+
+// assembleRecurrence is a helper function to convert the recurrence of the task to the rule of the schedule.
+func assembleRecurrence(recurrence *taskModel.Recurrence) (*schedule.Recurrence, error) {
+	weekdays, err := schedule.ParseWeekdays(recurrence.Weekdays)
+	if err != nil {
+		return nil, err
 	}
 
-	// check the update_by has the permission to update the project's tasks
-	if *input[0].Role != "admin" {
-		if proResMap[*input[0].ResUUID] != nil {
-			if !proResMap[*input[0].ResUUID].IsEditable {
-				log.Error("The user don't have permission to update the project's tasks.")
-				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The user don't have permission to update the project's tasks.")
-			}
-		} else {
-			log.Error("The user don't have permission to update the project's tasks.")
-			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The user don't have permission to update the project's tasks.")
-		}
+	rule := &schedule.Recurrence{
+		Frequency:   recurrence.Frequency,
+		Interval:    recurrence.Interval,
+		Weekdays:    weekdays,
+		DayOfMonth:  recurrence.DayOfMonth,
+		WeekOfMonth: recurrence.WeekOfMonth,
+		Count:       recurrence.Count,
+	}
+	if recurrence.Until != nil {
+		rule.Until = *recurrence.Until
 	}
 
-	// get tasks for the project
-	taskBase, err := m.TaskService.GetByListNoPagination(&taskModel.Field{
-		ProjectUUID: input[0].ProjectUUID,
+	return rule, nil
+}
+
+// assembleOccurrence is a helper function to create the numbered occurrence of the recurring task on the date, with the duration,
+// the resources and the attributes of the task; the occurrence starts no earlier than its date.
+func assembleOccurrence(task *taskModel.Create, number int, date time.Time, calendar schedule.Calendar) *taskModel.Create {
+	start := date
+	end := calendar.Add(start, task.Duration)
+	output := &taskModel.Create{
+		TaskName:       fmt.Sprintf("%s %d", task.TaskName, number),
+		StartDate:      &start,
+		EndDate:        &end,
+		Duration:       task.Duration,
+		Resources:      task.Resources,
+		TaskColor:      task.TaskColor,
+		WebLink:        task.WebLink,
+		IsSubTask:      true,
+		ProjectUUID:    task.ProjectUUID,
+		Notes:          task.Notes,
+		ConstraintType: schedule.StartNoEarlierThan,
+		ConstraintDate: &start,
+		Priority:       task.Priority,
+		CreatedBy:      task.CreatedBy,
+	}
+
+	if task.BaselineStartDate != nil && task.BaselineEndDate != nil {
+		output.BaselineStartDate = &start
+		output.BaselineEndDate = &end
+		output.BaselineDuration = task.Duration
+	}
+
+	return output
+}
+
+// assembleRecurringTask is a helper function to get the occurrence created by the recurrence.
+func assembleRecurringTask(task *taskDB.Base) *taskModel.RecurringTask {
+	return &taskModel.RecurringTask{
+		TaskUUID:      *task.TaskUUID,
+		TaskID:        *task.TaskID,
+		TaskName:      *task.TaskName,
+		OutlineNumber: *task.OutlineNumber,
+		StartDate:     task.StartDate,
+		EndDate:       task.EndDate,
+	}
+}
+
+// getLastTaskID is a helper function to get the largest numeric task_id of the project.
+func (m *manager) getLastTaskID(trx *gorm.DB, projectUUID string) (int, error) {
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoQuantity(&taskModel.Field{
+		ProjectUUID: util.PointerString(projectUUID),
 	})
 	if err != nil {
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		return 0, err
+	}
+
+	lastTaskID := 0
+	for _, task := range taskBase {
+		if taskID, err := strconv.Atoi(*task.TaskID); err == nil && taskID > lastTaskID {
+			lastTaskID = taskID
 		}
 	}
 
-	var tasks []*taskModel.Single
-	taskByte, err := sonic.Marshal(taskBase)
-	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	return lastTaskID, nil
+}
+
+// generateOccurrences is a helper function to generate the occurrences of the recurring task as its subtasks, numbered after
+// the last task_id of the project. The task keeps the rule with its start date and spans its occurrences, which take its resources.
+func (m *manager) generateOccurrences(trx *gorm.DB, task *taskModel.Create, calendar *schedule.WorkCalendar) ([]*taskModel.Create, error) {
+	if task.StartDate == nil {
+		return nil, fmt.Errorf("%w: the start date is required", schedule.ErrInvalidRecurrence)
 	}
 
-	err = sonic.Unmarshal(taskByte, &tasks)
+	if len(task.Segments) > 0 {
+		return nil, fmt.Errorf("%w: the recurring task cannot be segmented", schedule.ErrInvalidRecurrence)
+	}
+
+	rule, err := assembleRecurrence(task.Recurrence)
 	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		return nil, err
 	}
 
-	// create a map of taskUUID
-	taskMap := make(map[string]*taskModel.Single)
-	if len(tasks) > 0 {
-		for _, task := range tasks {
-			taskMap[task.TaskUUID] = task
-		}
+	dates, err := schedule.Occurrences(rule, *task.StartDate, calendar)
+	if err != nil {
+		return nil, err
 	}
 
-	// update the main task
-	for i, inputBody := range input {
-		// check if the update_by is the task's creator or assigned resource
-		if *input[0].Role != "admin" && input[0].ResUUID != nil && taskMap[inputBody.TaskUUID] != nil {
-			if taskMap[inputBody.TaskUUID].CreatedBy != *inputBody.UpdatedBy {
-				assigned := false
-				for _, res := range taskMap[inputBody.TaskUUID].Resources {
-					if res.ResourceUUID == *input[0].ResUUID {
-						assigned = true
-						break
-					}
-				}
-				if !assigned {
-					continue
-				}
-			}
-		}
+	if len(dates) == 0 {
+		return nil, fmt.Errorf("%w: the rule has no occurrence", schedule.ErrInvalidRecurrence)
+	}
 
-		if inputBody.BaselineStartDate != nil && inputBody.BaselineEndDate != nil {
-			// get the minimum baseline_start_date
-			if minBaselineStart == nil || inputBody.BaselineStartDate.Before(*minBaselineStart) {
-				minBaselineStart = inputBody.BaselineStartDate
-			}
+	lastTaskID, err := m.getLastTaskID(trx, task.ProjectUUID)
+	if err != nil {
+		return nil, err
+	}
 
-			// get the maximum baseline_end_date
-			if maxBaselineEnd == nil || inputBody.BaselineEndDate.After(*maxBaselineEnd) {
-				maxBaselineEnd = inputBody.BaselineEndDate
-			}
-		}
+	if taskID, err := strconv.Atoi(task.TaskID); err == nil && taskID > lastTaskID {
+		lastTaskID = taskID
+	}
 
-		// the main task with subtasks cannot be segmented
-		if len(inputBody.Segments) > 0 {
-			if len(inputBody.Subtask) > 0 {
-				log.Info("The parent task cannot be segmented.")
-				return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The parent task cannot be segmented.")
-			}
+	occurrences := make([]*taskModel.Create, len(dates))
+	for i, date := range dates {
+		occurrences[i] = assembleOccurrence(task, i+1, date, calendar)
+		occurrences[i].TaskID = strconv.Itoa(lastTaskID + i + 1)
+		occurrences[i].OutlineNumber = fmt.Sprintf("%s.%d", task.OutlineNumber, i+1)
+	}
 
-			// transform segments from struct array to string
-			segJson, _ := sonic.Marshal(inputBody.Segments)
-			inputBody.Segment = util.PointerString(string(segJson))
-		}
+	// the rule is kept with the start date of the series to regenerate the same occurrences
+	task.Recurrence.StartDate = task.StartDate
+	ruleJson, err := sonic.Marshal(task.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	task.RecurrenceRule = string(ruleJson)
+
+	first, last := occurrences[0], occurrences[len(occurrences)-1]
+	task.StartDate, task.EndDate = first.StartDate, last.EndDate
+	task.Duration = calendar.Days(*first.StartDate, *last.EndDate)
+	if task.BaselineStartDate != nil && task.BaselineEndDate != nil {
+		task.BaselineStartDate, task.BaselineEndDate = first.StartDate, last.EndDate
+		task.BaselineDuration = task.Duration
+	}
+	task.Resources = nil
 
-		// transform indicators from struct array to string
-		if len(inputBody.Indicators) > 0 {
-			indJson, _ := sonic.Marshal(inputBody.Indicators)
-			inputBody.Indicator = util.PointerString(string(indJson))
-		}
+	return occurrences, nil
+}
 
-		inputBody.IsSubTask = util.PointerBool(false)
-		// calculate the new outline_number
-		inputBody.OutlineNumber = util.PointerString(strconv.Itoa(1 + i))
+func (m *manager) UpdateRecurrence(trx *gorm.DB, input *taskModel.UpdateRecurrence) (int, any) {
+	defer trx.Rollback()
 
-		// sync update task_resource
-		TaskUUIDs = append(TaskUUIDs, util.PointerString(inputBody.TaskUUID))
-		if len(inputBody.Resources) > 0 {
-			taskResources := make(map[string][]*resourceModel.TaskSingle)
-			taskResources[inputBody.TaskUUID] = inputBody.Resources
-			taskResMapList = append(taskResMapList, taskResources)
+	taskBase, err := m.TaskService.WithTrx(trx).GetBySingle(&taskModel.Field{
+		TaskUUID: input.TaskUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
 		}
 
-		// update the main task
-		updateList = append(updateList, inputBody)
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-		// update subtasks
-		if len(inputBody.Subtask) > 0 {
-			subTaskUUIDs, subUpdateList, subTaskResMapList, subMinBaselineStart, subMaxBaselineEnd, err := m.updateSubtasks(trx, inputBody, inputBody.Subtask, minBaselineStart, maxBaselineEnd, input[0].Role, input[0].ResUUID, taskMap)
-			if err != nil {
-				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-			}
-			taskResMapList = append(taskResMapList, subTaskResMapList...)
-			updateList = append(updateList, subUpdateList...)
-			TaskUUIDs = append(TaskUUIDs, subTaskUUIDs...)
+	if taskBase.RecurrenceRule == nil || *taskBase.RecurrenceRule == "" {
+		log.Info("The task is not a recurring task.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The task is not a recurring task.")
+	}
 
-			if inputBody.BaselineStartDate != nil && inputBody.BaselineEndDate != nil {
-				// compare the minimum baseline_start_date and maximum baseline_end_date of the subtasks
-				if minBaselineStart == nil || inputBody.BaselineStartDate.Before(*subMinBaselineStart) {
-					minBaselineStart = inputBody.BaselineStartDate
-				}
-				if maxBaselineEnd == nil || inputBody.BaselineEndDate.After(*subMaxBaselineEnd) {
-					maxBaselineEnd = inputBody.BaselineEndDate
-				}
-			}
+	reason, err := m.CheckEditable(trx, *taskBase.ProjectUUID, input.Role, input.ResUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
 		}
-	}
 
-	var wg sync.WaitGroup
-	// make an error channel
-	goroutineErr := make(chan error)
-	// create goroutine
-	for _, task := range updateList {
-		wg.Add(1)
-		go func(task *taskModel.Update) {
-			defer wg.Done()
-			// update task
-			err := m.TaskService.WithTrx(trx).Update(task)
-			if err != nil {
-				log.Error(err)
-				goroutineErr <- err
-			}
-		}(task)
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	// wait until all goroutines are finished
-	wg.Wait()
-	close(goroutineErr)
+	if reason != "" {
+		log.Info(reason)
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
+	}
 
-	// check if goroutine has error
-	err = <-goroutineErr
+	// the series keeps its start date with the new rule
+	recurrence := &taskModel.Recurrence{}
+	err = sonic.Unmarshal([]byte(*taskBase.RecurrenceRule), recurrence)
 	if err != nil {
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	// sync delete task_resource
-	err = m.syncDeleteTaskResources(trx, nil, TaskUUIDs, true)
+	seriesStart := recurrence.StartDate
+	if seriesStart == nil {
+		seriesStart = taskBase.StartDate
+	}
+	if input.Recurrence != nil {
+		recurrence = input.Recurrence
+	}
+	recurrence.StartDate = seriesStart
+
+	if seriesStart == nil {
+		log.Info("The recurring task has no start date.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The recurring task has no start date.")
+	}
+
+	rule, err := assembleRecurrence(recurrence)
+	if err != nil {
+		log.Info(err.Error())
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+	}
+
+	// get the working calendar
+	calendar, err := m.GetWorkCalendar(taskBase.ProjectUUID)
 	if err != nil {
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	// sync create task_resource
-	if len(taskResMapList) > 0 {
-		err = m.syncCreateTaskResources(trx, taskResMapList, *input[0].UpdatedBy, *input[0].ProjectUUID, proResMap)
-		if err != nil {
-			log.Error(err)
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	dates, err := schedule.Occurrences(rule, *seriesStart, calendar)
+	if err != nil {
+		if errors.Is(err, schedule.ErrInvalidRecurrence) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
 		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	// sync update project's start and end dates
-	err = m.syncUpdateProjectStartEndDate(trx, input[0].ProjectUUID, nil, minBaselineStart, maxBaselineEnd)
+	effective := util.NowToUTC()
+	if input.EffectiveDate != nil {
+		effective = *input.EffectiveDate
+	}
+	effective = time.Date(effective.Year(), effective.Month(), effective.Day(), 0, 0, 0, 0, effective.Location())
+
+	// get the occurrences of the series in outline order
+	tasksBase, err := m.TaskService.WithTrx(trx).GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: taskBase.ProjectUUID,
+	})
 	if err != nil {
 		log.Error(err)
 		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 	}
 
-	trx.Commit()
-	return code.Successful, code.GetCodeMessage(code.Successful, "Successful update!")
-}
+	prefix := *taskBase.OutlineNumber + "."
+	lastTaskID := 0
+	parents := make(map[string]bool)
+	var children []*taskDB.Base
+	for _, task := range tasksBase {
+		if taskID, err := strconv.Atoi(*task.TaskID); err == nil && taskID > lastTaskID {
+			lastTaskID = taskID
+		}
 
-func (m *manager) Import(trx *gorm.DB, input *taskModel.Import) (int, any) {
-	defer trx.Rollback()
+		if !strings.HasPrefix(*task.OutlineNumber, prefix) {
+			continue
+		}
 
-	// get resources
-	resBase, err := m.ResourceService.GetByListNoPagination(&resourceModel.Field{})
-	if err != nil {
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		rest := strings.TrimPrefix(*task.OutlineNumber, prefix)
+		if index := strings.Index(rest, "."); index >= 0 {
+			parents[prefix+rest[:index]] = true
+			continue
 		}
+
+		children = append(children, task)
 	}
 
-	// create a map of resource_name and project_resource_id
-	nameToResIDMap := make(map[string]string)
-	for _, res := range resBase {
-		nameToResIDMap[*res.ResourceName] = *res.ResourceUUID
+	// the new occurrences follow the last occurrence unless they are given
+	template := &taskModel.Create{
+		TaskName:    *taskBase.TaskName,
+		TaskColor:   *taskBase.TaskColor,
+		WebLink:     *taskBase.WebLink,
+		ProjectUUID: *taskBase.ProjectUUID,
+		Notes:       *taskBase.Notes,
+		Priority:    taskBase.Priority,
+		CreatedBy:   *input.UpdatedBy,
+	}
+	if len(children) > 0 {
+		last := children[len(children)-1]
+		template.Duration = *last.Duration
+		for _, res := range last.TaskResources {
+			template.Resources = append(template.Resources, &resourceModel.TaskSingle{
+				ResourceUUID: *res.ResourceUUID,
+				Unit:         *res.Unit,
+			})
+		}
+	}
+	if input.TaskName != nil {
+		template.TaskName = *input.TaskName
+	}
+	if input.Duration != nil {
+		template.Duration = *input.Duration
+	}
+	if input.Resources != nil {
+		template.Resources = input.Resources
 	}
 
-	// set CSV parse options
-	input.CSVFile.LazyQuotes = true       // loosely process quotes
-	input.CSVFile.TrimLeadingSpace = true // automatically remove spaces before each field
-	input.CSVFile.FieldsPerRecord = -1    // do not force each record to have the same number of fields
+	// the occurrences from the effective date on which have not started are regenerated
+	var (
+		deletedTaskUUIDs []*string
+		occurrences      []*occurrence
+	)
+	location := seriesStart.Location()
+	if len(dates) > 0 {
+		location = dates[0].Location()
+	}
 
-	// read and parse CSV file
-	records, err := input.CSVFile.ReadAll()
-	if err != nil {
-		log.Error(err)
-		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	keptDays := make(map[string]bool)
+	for _, task := range children {
+		if task.StartDate != nil && !task.StartDate.Before(effective) && *task.Progress == 0 && !parents[*task.OutlineNumber] {
+			deletedTaskUUIDs = append(deletedTaskUUIDs, task.TaskUUID)
+			continue
+		}
+
+		occurrences = append(occurrences, &occurrence{start: task.StartDate, task: task})
+		if task.StartDate != nil {
+			keptDays[task.StartDate.In(location).Format(time.DateOnly)] = true
+		}
 	}
 
-	taskIdx := [18]int{}
-	taskRecordIdx := make(map[string]int)
-	var createAllTask []*taskModel.Create
-	for i, record := range records {
-		if i == 0 {
-			// identify the CSV header row and record the index of each field
-			for index, value := range record {
-				log.Debug("index: ", index, " value: ", value)
-				// 0: gantt project
-				if input.FileType == 1 {
-					switch value {
-					// set the index of each field according to the column name
-					case "ID", "編號":
-						taskIdx[0] = index
-					case "Name", "名稱":
-						taskIdx[1] = index
-					case "Begin date", "實際起始日":
-						taskIdx[2] = index
-					case "End date", "實際完成日":
-						taskIdx[3] = index
-					case "Duration", "期間":
-						taskIdx[4] = index
-					case "Completion", "完成":
-						taskIdx[5] = index
-					case "Cost":
-						taskIdx[6] = index
-					//case "Coordinator", "協調者":
-					//	taskIdx[7] = index
-					case "Predecessors", "父階":
-						taskIdx[8] = index
-					case "Outline number", "大綱編號":
-						taskIdx[9] = index
-					case "Resources", "資源":
-						taskIdx[10] = index
-					case "Assignments":
-						taskIdx[11] = index
-					case "Task color", "任務顏色":
-						taskIdx[12] = index
-					case "Web Link", "超連結":
-						taskIdx[13] = index
-					case "Notes", "備註":
-						taskIdx[14] = index
-					case "Baseline Begin date", "起始日期":
-						taskIdx[15] = index
-					case "Baseline End date", "結束日期":
-						taskIdx[16] = index
-					}
-					// 1: saas pmi
-				} else if input.FileType == 2 {
-					switch value {
-					// set the index of each field according to the column name
-					case "ID", "編號":
-						taskIdx[0] = index
-					case "Name", "名稱":
-						taskIdx[1] = index
-					case "Begin date", "開始日期":
-						taskIdx[2] = index
-					case "End date", "結束日期":
-						taskIdx[3] = index
-					case "Duration", "工作天":
-						taskIdx[4] = index
-					case "Completion", "進度(%)":
-						taskIdx[5] = index
-					case "Cost", "工時表":
-						taskIdx[6] = index
-					case "Predecessors", "相依性":
-						taskIdx[8] = index
-					case "Outline number", "大綱編號":
-						taskIdx[9] = index
-					case "Resources", "負責人":
-						taskIdx[10] = index
-					case "Notes", "備註":
-						taskIdx[14] = index
-					case "Baseline Begin date", "基準開始日":
-						taskIdx[15] = index
-					case "Baseline End date", "基準結束日":
-						taskIdx[16] = index
-					case "Baseline Duration", "基準工作天":
-						taskIdx[17] = index
-					}
-				}
-			}
+	var createList []*taskModel.Create
+	for i, date := range dates {
+		if date.Before(effective) || keptDays[date.Format(time.DateOnly)] {
+			continue
+		}
+
+		lastTaskID++
+		create := assembleOccurrence(template, i+1, date, calendar)
+		create.TaskID = strconv.Itoa(lastTaskID)
+		createList = append(createList, create)
+		occurrences = append(occurrences, &occurrence{start: create.StartDate, create: create})
+	}
+
+	if len(deletedTaskUUIDs) > 0 {
+		// sync delete task_dependencies
+		err = m.SyncDeleteTaskDependencies(trx, taskBase.ProjectUUID, deletedTaskUUIDs, *input.UpdatedBy)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		err = m.TaskService.WithTrx(trx).Delete(&taskModel.Field{
+			DeletedTaskUUIDs: deletedTaskUUIDs,
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		// sync delete task_resource
+		err = m.SyncDeleteTaskResources(trx, nil, deletedTaskUUIDs, true)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	// the occurrences are numbered by their start dates, the subtasks of the kept ones follow them
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		a, b := occurrences[i].start, occurrences[j].start
+		return a != nil && (b == nil || a.Before(*b))
+	})
+
+	outlineTasks := []*taskModel.OutlineTask{}
+	for i, item := range occurrences {
+		outlineNumber := prefix + strconv.Itoa(i+1)
+		if item.create != nil {
+			item.create.OutlineNumber = outlineNumber
 			continue
 		}
 
-		// skip the record if there is no outline_number
-		if record[taskIdx[9]] == "" {
+		if *item.task.OutlineNumber == outlineNumber {
 			continue
 		}
 
-		// build the top outline_number for validation
-		outlineNumberSplit := strings.Split(record[taskIdx[9]], ".")
-		var topOutlineNumber string
-		for i := 0; i < len(outlineNumberSplit)-1; i++ {
-			topOutlineNumber += outlineNumberSplit[i]
-			if i != len(outlineNumberSplit)-2 {
-				topOutlineNumber += "."
+		for _, task := range tasksBase {
+			if *task.TaskUUID != *item.task.TaskUUID && !strings.HasPrefix(*task.OutlineNumber, *item.task.OutlineNumber+".") {
+				continue
+			}
+
+			newOutlineNumber := outlineNumber + strings.TrimPrefix(*task.OutlineNumber, *item.task.OutlineNumber)
+			err = m.TaskService.WithTrx(trx).Update(&taskModel.Update{
+				TaskUUID:      *task.TaskUUID,
+				OutlineNumber: util.PointerString(newOutlineNumber),
+				UpdatedBy:     input.UpdatedBy,
+			})
+			if err != nil {
+				log.Error(err)
+				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 			}
+
+			outlineTasks = append(outlineTasks, &taskModel.OutlineTask{
+				TaskUUID:      *task.TaskUUID,
+				TaskID:        *task.TaskID,
+				OutlineNumber: newOutlineNumber,
+				IsSubTask:     true,
+				Predecessor:   *task.Predecessor,
+			})
 		}
+	}
 
-		// verify the hierarchy relationship in the file
-		if _, ok := taskRecordIdx[topOutlineNumber]; topOutlineNumber != "" && !ok {
-			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, "import errors")
+	taskUUIDs := []*string{}
+	recurringTasks := []*taskModel.RecurringTask{}
+	if len(createList) > 0 {
+		occurrenceBase, err := m.TaskService.WithTrx(trx).CreateAll(createList)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 		}
 
-		// combine the parsed data into the 'Create' structure
-		createTask := &taskModel.Create{}
-		createTask.TaskID = record[taskIdx[0]]
-		createTask.TaskName = record[taskIdx[1]]
-		if record[taskIdx[2]] != "" {
-			// transform the date format from "/" to "-"
-			var startDateString string
-			for _, v := range strings.Split(strings.ReplaceAll(record[taskIdx[2]], "/", "-"), "-") {
-				if len(v) == 1 {
-					startDateString += "0" + v + "-"
-				} else {
-					startDateString += v + "-"
-				}
+		var taskResMapList []map[string][]*resourceModel.TaskSingle
+		for i, task := range occurrenceBase {
+			taskUUIDs = append(taskUUIDs, task.TaskUUID)
+			recurringTasks = append(recurringTasks, assembleRecurringTask(task))
+			if len(createList[i].Resources) > 0 {
+				taskResMapList = append(taskResMapList, map[string][]*resourceModel.TaskSingle{
+					*task.TaskUUID: createList[i].Resources,
+				})
 			}
-			startDateString = startDateString[0 : len(startDateString)-1]
-			startDate, err := time.Parse("2006-01-02", startDateString)
+		}
+
+		if len(taskResMapList) > 0 {
+			// get the resources of the project
+			proResBase, err := m.ProjectResourceService.WithTrx(trx).GetByListNoPagination(&projectResourceModel.Field{
+				ProjectUUID: taskBase.ProjectUUID,
+			})
 			if err != nil {
-				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+				if !errors.Is(err, gorm.ErrRecordNotFound) {
+					log.Error(err)
+					return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+				}
 			}
-			createTask.StartDate = util.PointerTime(startDate)
-		}
 
-		if record[taskIdx[3]] != "" {
-			// transform the date format from "/" to "-"
-			var endDateString string
-			for _, v := range strings.Split(strings.ReplaceAll(record[taskIdx[3]], "/", "-"), "-") {
-				if len(v) == 1 {
-					endDateString += "0" + v + "-"
-				} else {
-					endDateString += v + "-"
+			proResMap := make(map[string]*projectResourceModel.Single)
+			for _, res := range proResBase {
+				proResMap[*res.ResourceUUID] = &projectResourceModel.Single{
+					ResourceUUID: *res.ResourceUUID,
 				}
 			}
-			endDateString = endDateString[0 : len(endDateString)-1]
-			endDateString += " 12:00:00"
-			endDate, err := time.Parse("2006-01-02 15:04:05", endDateString)
+
+			// sync create task_resource
+			err = m.SyncCreateTaskResources(trx, taskResMapList, *input.UpdatedBy, *taskBase.ProjectUUID, proResMap)
 			if err != nil {
+				log.Error(err)
 				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
 			}
-			createTask.EndDate = util.PointerTime(endDate)
 		}
+	}
 
-		//if record[taskIdx[4]] != "" {
-		//	duration, err := strconv.ParseFloat(record[taskIdx[4]], 64)
-		//	if err != nil {
-		//		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-		//	}
-		//	createTask.Duration = duration
-		//}
+	// keep the new rule on the recurring task
+	ruleJson, err := sonic.Marshal(recurrence)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-		if record[taskIdx[5]] != "" {
-			progress, _ := strconv.Atoi(record[taskIdx[5]])
-			createTask.Progress = int64(progress)
-		}
+	err = m.TaskService.WithTrx(trx).Update(&taskModel.Update{
+		TaskUUID:       input.TaskUUID,
+		TaskName:       input.TaskName,
+		RecurrenceRule: util.PointerString(string(ruleJson)),
+		UpdatedBy:      input.UpdatedBy,
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-		if record[taskIdx[6]] != "" {
-			cost, err := strconv.ParseFloat(record[taskIdx[6]], 64)
-			if err != nil {
-				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-			}
-			createTask.Cost = int64(cost)
+	// sync task_dependencies
+	err = m.SyncTaskDependencies(trx, taskBase.ProjectUUID, *input.UpdatedBy)
+	if err != nil {
+		if schedule.IsInvalid(err) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
 		}
 
-		createTask.Predecessor = record[taskIdx[8]]
-		if record[taskIdx[10]] != "" {
-			var resourceSplit []string
-			separators := []string{";", ","}
-			for _, sep := range separators {
-				if strings.Contains(record[taskIdx[10]], sep) {
-					resourceSplit = strings.Split(record[taskIdx[10]], sep)
-					break
-				}
-			}
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-			if len(resourceSplit) == 0 {
-				resourceSplit = append(resourceSplit, record[taskIdx[10]])
-			}
+	// sync roll up the summary tasks from their subtasks
+	summaryTasks, err := m.syncRollUpSummaryTasks(trx, taskBase.ProjectUUID, *input.UpdatedBy)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-			for _, res := range resourceSplit {
-				var percentage float64
-				var name string
-				if strings.Contains(res, "[") {
-					res = strings.Trim(res, "[]")
-					parts := strings.Split(res, "[")
-					name = parts[0]
-					percentage, _ = strconv.ParseFloat(strings.TrimRight(parts[1], "%"), 64)
-				} else {
-					name = res
-					percentage = 100
-				}
+	// sync update project's start and end dates
+	err = m.SyncUpdateProjectStartEndDate(trx, taskBase.ProjectUUID, deletedTaskUUIDs, nil, nil)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
 
-				if nameToResIDMap[name] != "" {
-					createTask.Resources = append(createTask.Resources, &resourceModel.TaskSingle{
-						ResourceUUID: nameToResIDMap[name],
-						Unit:         percentage,
-					})
-				}
-				continue
-			}
+	// check the resources assigned on their non-working days
+	warnings, err := m.getResourceWarnings(trx, taskBase.ProjectUUID, taskUUIDs, calendar)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// check the resources assigned beyond their capacity
+	overallocations, err := m.getTaskOverallocations(trx, taskBase.ProjectUUID, taskUUIDs)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, &taskModel.Result{
+		TaskUUID:        input.TaskUUID,
+		MovedTasks:      []*taskModel.MovedTask{},
+		Warnings:        warnings,
+		Overallocations: overallocations,
+		SummaryTasks:    summaryTasks,
+		OutlineTasks:    outlineTasks,
+		RecurringTasks:  recurringTasks,
+	})
+}
+
+// getChecklistProgressTasks is a helper function to find which of the tasks derive their progress from their checklists,
+// none when the project does not derive the progress from the checklists.
+func (m *manager) getChecklistProgressTasks(trx *gorm.DB, projectUUID *string, taskUUIDs []*string) (map[string]bool, error) {
+	output := make(map[string]bool)
+	if projectUUID == nil || len(taskUUIDs) == 0 {
+		return output, nil
+	}
+
+	projectBase, err := m.ProjectService.WithTrx(trx).GetBySingle(&projectModel.Field{
+		ProjectUUID: *projectUUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if projectBase.ProgressFromChecklist == nil || !*projectBase.ProgressFromChecklist {
+		return output, nil
+	}
+
+	itemBase, err := m.TaskChecklistItemService.WithTrx(trx).GetByListNoPagination(&taskChecklistItemModel.Field{
+		TaskUUIDs: taskUUIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range itemBase {
+		output[*item.TaskUUID] = true
+	}
+
+	return output, nil
+}
+
+// SyncChecklistProgress derives the progresses of the tasks of the project from the completion of their checklists when the project opts in,
+// all of its tasks with a checklist when no task is given. The statuses follow the progresses through the transitions of the project type,
+// the done or cancelled tasks are left as they are, and the progresses are rolled up to the summary tasks.
+// The returned error wraps schedule.ErrInvalidStatus when the project type does not allow a derived status.
+func (m *manager) SyncChecklistProgress(trx *gorm.DB, projectUUID *string, taskUUIDs []*string, updatedBy string) error {
+	projectBase, err := m.ProjectService.WithTrx(trx).GetBySingle(&projectModel.Field{
+		ProjectUUID: *projectUUID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if projectBase.ProgressFromChecklist == nil || !*projectBase.ProgressFromChecklist {
+		return nil
+	}
+
+	taskBase, err := m.TaskService.WithTrx(trx).GetByListNoPagination(&taskModel.Field{
+		ProjectUUID: projectUUID,
+		TaskUUIDs:   taskUUIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(taskBase) == 0 {
+		return nil
+	}
+
+	var projectTaskUUIDs []*string
+	for _, task := range taskBase {
+		projectTaskUUIDs = append(projectTaskUUIDs, task.TaskUUID)
+	}
+
+	itemBase, err := m.TaskChecklistItemService.WithTrx(trx).GetByListNoPagination(&taskChecklistItemModel.Field{
+		TaskUUIDs: projectTaskUUIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	doneMap := make(map[string]int64)
+	totalMap := make(map[string]int64)
+	for _, item := range itemBase {
+		totalMap[*item.TaskUUID]++
+		if item.IsDone != nil && *item.IsDone {
+			doneMap[*item.TaskUUID]++
 		}
+	}
 
-		createTask.Assignments = record[taskIdx[11]]
-		createTask.TaskColor = record[taskIdx[12]]
-		createTask.WebLink = record[taskIdx[13]]
-		createTask.Notes = record[taskIdx[14]]
+	// the derived progress goes through the transitions of the project type as the progress changed by hand
+	transitions, err := m.getStatusTransitions(projectUUID)
+	if err != nil {
+		return err
+	}
 
-		if record[taskIdx[15]] != "" {
-			// transform the date format from "/" to "-"
-			var startDateString string
-			for _, v := range strings.Split(strings.ReplaceAll(record[taskIdx[15]], "/", "-"), "-") {
-				if len(v) == 1 {
-					startDateString += "0" + v + "-"
-				} else {
-					startDateString += v + "-"
-				}
-			}
-			startDateString = startDateString[0 : len(startDateString)-1]
-			startDate, err := time.Parse("2006-01-02", startDateString)
-			if err != nil {
-				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-			}
-			createTask.BaselineStartDate = util.PointerTime(startDate)
+	synced := false
+	for _, task := range taskBase {
+		var (
+			status          string
+			currentProgress int64
+		)
+		if task.Status != nil {
+			status = *task.Status
+		}
+		if task.Progress != nil {
+			currentProgress = *task.Progress
 		}
 
-		if record[taskIdx[16]] != "" {
-			// transform the date format from "/" to "-"
-			var endDateString string
-			for _, v := range strings.Split(strings.ReplaceAll(record[taskIdx[16]], "/", "-"), "-") {
-				if len(v) == 1 {
-					endDateString += "0" + v + "-"
-				} else {
-					endDateString += v + "-"
-				}
-			}
-			endDateString = endDateString[0 : len(endDateString)-1]
-			endDateString += " 12:00:00"
-			endDate, err := time.Parse("2006-01-02 15:04:05", endDateString)
-			if err != nil {
-				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-			}
-			createTask.BaselineEndDate = util.PointerTime(endDate)
+		resolvedStatus, progress, err := schedule.ResolveChecklistStatus(status, currentProgress,
+			doneMap[*task.TaskUUID], totalMap[*task.TaskUUID], transitions)
+		if err != nil {
+			return fmt.Errorf("task %s: %w", *task.TaskID, err)
 		}
 
-		if record[taskIdx[17]] != "" {
-			duration := strings.Replace(record[taskIdx[17]], "天", "", -1)
-			durationNum, err := strconv.ParseFloat(duration, 64)
-			if err != nil {
-				return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
-			}
-			createTask.BaselineDuration = durationNum
+		if task.Progress != nil && currentProgress == progress && status == resolvedStatus {
+			continue
 		}
 
-		// check if there is no data with the same outline_number
-		if _, ok := taskRecordIdx[record[taskIdx[9]]]; !ok {
-			// record the index of the current task in createAllTask
-			taskRecordIdx[record[taskIdx[9]]] = len(createAllTask)
+		update := &taskModel.Update{
+			TaskUUID:  *task.TaskUUID,
+			Progress:  util.PointerInt64(progress),
+			UpdatedBy: util.PointerString(updatedBy),
+		}
+		if status != resolvedStatus {
+			update.Status = util.PointerString(resolvedStatus)
+			update.StatusChangedBy = util.PointerString(updatedBy)
+			update.StatusChangedAt = util.PointerTime(util.NowToUTC())
 		}
 
-		createTask.CreatedBy = input.CreatedBy
-		createAllTask = assembleToCreateAll(createAllTask, taskRecordIdx, createTask, record[taskIdx[9]], "", input.ProjectUUID, input.ResUUID, input.Role)
+		err = m.TaskService.WithTrx(trx).Update(update)
+		if err != nil {
+			return err
+		}
+
+		synced = true
 	}
 
-	httpCode, message := m.CreateAll(trx, createAllTask)
-	if httpCode != code.Successful {
-		return httpCode, message
+	if !synced {
+		return nil
 	}
 
-	trx.Commit()
-	return code.Successful, code.GetCodeMessage(code.Successful, "Successful import!")
+	_, err = m.syncRollUpSummaryTasks(trx, projectUUID, updatedBy)
+	return err
 }
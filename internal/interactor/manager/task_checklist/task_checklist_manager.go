@@ -0,0 +1,383 @@
+package task_checklist
+
+import (
+	"errors"
+	"gantt/internal/interactor/pkg/schedule"
+	"gantt/internal/interactor/pkg/util"
+
+	"github.com/bytedance/sonic"
+
+	"gorm.io/gorm"
+
+	taskDB "gantt/internal/entity/postgresql/db/tasks"
+	taskManager "gantt/internal/interactor/manager/task"
+	resourceModel "gantt/internal/interactor/models/resources"
+	taskChecklistItemModel "gantt/internal/interactor/models/task_checklist_items"
+	taskModel "gantt/internal/interactor/models/tasks"
+	resourceService "gantt/internal/interactor/service/resource"
+	taskService "gantt/internal/interactor/service/task"
+	taskChecklistItemService "gantt/internal/interactor/service/task_checklist_item"
+
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+)
+
+type Manager interface {
+	Create(trx *gorm.DB, input *taskChecklistItemModel.Create) (int, any)
+	GetByList(input *taskChecklistItemModel.Field) (int, any)
+	Update(trx *gorm.DB, input *taskChecklistItemModel.Update) (int, any)
+	Delete(trx *gorm.DB, input *taskChecklistItemModel.Field) (int, any)
+	Reorder(trx *gorm.DB, input *taskChecklistItemModel.Reorder) (int, any)
+}
+
+type manager struct {
+	TaskChecklistItemService taskChecklistItemService.Service
+	TaskService              taskService.Service
+	ResourceService          resourceService.Service
+	TaskManager              taskManager.Manager
+}
+
+func Init(db *gorm.DB) Manager {
+	return &manager{
+		TaskChecklistItemService: taskChecklistItemService.Init(db),
+		TaskService:              taskService.Init(db),
+		ResourceService:          resourceService.Init(db),
+		TaskManager:              taskManager.Init(db),
+	}
+}
+
+// getChecklistTask is a helper function to get the task of the checklist and check the user can update its tasks,
+// returning the reason when the checklist cannot be modified.
+func (m *manager) getChecklistTask(trx *gorm.DB, taskUUID string, role, resUUID *string) (*taskDB.Base, string, error) {
+	taskBase, err := m.TaskService.WithTrx(trx).GetBySingle(&taskModel.Field{
+		TaskUUID: taskUUID,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	reason, err := m.TaskManager.CheckEditable(trx, *taskBase.ProjectUUID, role, resUUID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return taskBase, reason, nil
+}
+
+// checkChecklistResource is a helper function to check the resource assigned to the checklist item exists,
+// returning the reason when it does not.
+func (m *manager) checkChecklistResource(trx *gorm.DB, resourceUUID *string) (string, error) {
+	if resourceUUID == nil || *resourceUUID == "" {
+		return "", nil
+	}
+
+	_, err := m.ResourceService.WithTrx(trx).GetBySingle(&resourceModel.Field{
+		ResourceUUID: *resourceUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "The resource of the checklist item does not exist.", nil
+		}
+
+		return "", err
+	}
+
+	return "", nil
+}
+
+func (m *manager) Create(trx *gorm.DB, input *taskChecklistItemModel.Create) (int, any) {
+	defer trx.Rollback()
+
+	taskBase, reason, err := m.getChecklistTask(trx, input.TaskUUID, input.Role, input.ResUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if reason == "" {
+		reason, err = m.checkChecklistResource(trx, input.ResourceUUID)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	if reason != "" {
+		log.Info(reason)
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
+	}
+
+	// the new item is appended to the end of the checklist
+	itemBase, err := m.TaskChecklistItemService.WithTrx(trx).GetByListNoPagination(&taskChecklistItemModel.Field{
+		TaskUUID: util.PointerString(input.TaskUUID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	input.SortOrder = 1
+	if len(itemBase) > 0 {
+		input.SortOrder = *itemBase[len(itemBase)-1].SortOrder + 1
+	}
+
+	checklistItemBase, err := m.TaskChecklistItemService.WithTrx(trx).Create(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync the progress of the task with its checklist
+	err = m.TaskManager.SyncChecklistProgress(trx, taskBase.ProjectUUID, []*string{taskBase.TaskUUID}, input.CreatedBy)
+	if err != nil {
+		if errors.Is(err, schedule.ErrInvalidStatus) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, checklistItemBase.ID)
+}
+
+func (m *manager) GetByList(input *taskChecklistItemModel.Field) (int, any) {
+	// check if the task exists
+	_, err := m.TaskService.GetBySingle(&taskModel.Field{
+		TaskUUID: *input.TaskUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	itemBase, err := m.TaskChecklistItemService.GetByListNoPagination(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output := &taskChecklistItemModel.List{
+		TaskUUID: *input.TaskUUID,
+		Items:    make([]*taskChecklistItemModel.Single, 0, len(itemBase)),
+	}
+	itemByte, err := sonic.Marshal(itemBase)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	err = sonic.Unmarshal(itemByte, &output.Items)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	for i, item := range output.Items {
+		if itemBase[i].Resources.ResourceName != nil {
+			item.ResourceName = *itemBase[i].Resources.ResourceName
+		}
+		item.CreatedBy = *itemBase[i].CreatedByUsers.Name
+		item.UpdatedBy = *itemBase[i].UpdatedByUsers.Name
+
+		if item.IsDone {
+			output.Done++
+		}
+	}
+	output.Total = int64(len(output.Items))
+	output.Progress = schedule.ChecklistProgress(output.Done, output.Total)
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) Update(trx *gorm.DB, input *taskChecklistItemModel.Update) (int, any) {
+	defer trx.Rollback()
+
+	// check if the item is in the checklist of the task
+	_, err := m.TaskChecklistItemService.WithTrx(trx).GetBySingle(&taskChecklistItemModel.Field{
+		ID:       util.PointerString(input.ID),
+		TaskUUID: util.PointerString(input.TaskUUID),
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	taskBase, reason, err := m.getChecklistTask(trx, input.TaskUUID, input.Role, input.ResUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if reason == "" {
+		reason, err = m.checkChecklistResource(trx, input.ResourceUUID)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	if reason != "" {
+		log.Info(reason)
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
+	}
+
+	input.SortOrder = nil
+	err = m.TaskChecklistItemService.WithTrx(trx).Update(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync the progress of the task with its checklist
+	if input.IsDone != nil {
+		err = m.TaskManager.SyncChecklistProgress(trx, taskBase.ProjectUUID, []*string{taskBase.TaskUUID}, *input.UpdatedBy)
+		if err != nil {
+			if errors.Is(err, schedule.ErrInvalidStatus) {
+				log.Info(err.Error())
+				return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+			}
+
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, input.ID)
+}
+
+func (m *manager) Delete(trx *gorm.DB, input *taskChecklistItemModel.Field) (int, any) {
+	defer trx.Rollback()
+
+	// check if the item is in the checklist of the task
+	_, err := m.TaskChecklistItemService.WithTrx(trx).GetBySingle(&taskChecklistItemModel.Field{
+		ID:       input.ID,
+		TaskUUID: input.TaskUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	taskBase, reason, err := m.getChecklistTask(trx, *input.TaskUUID, input.Role, input.ResUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if reason != "" {
+		log.Info(reason)
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
+	}
+
+	err = m.TaskChecklistItemService.WithTrx(trx).Delete(&taskChecklistItemModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// sync the progress of the task with its checklist
+	err = m.TaskManager.SyncChecklistProgress(trx, taskBase.ProjectUUID, []*string{taskBase.TaskUUID}, *input.UpdatedBy)
+	if err != nil {
+		if errors.Is(err, schedule.ErrInvalidStatus) {
+			log.Info(err.Error())
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, "Delete ok!")
+}
+
+func (m *manager) Reorder(trx *gorm.DB, input *taskChecklistItemModel.Reorder) (int, any) {
+	defer trx.Rollback()
+
+	_, reason, err := m.getChecklistTask(trx, input.TaskUUID, input.Role, input.ResUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if reason != "" {
+		log.Info(reason)
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
+	}
+
+	itemBase, err := m.TaskChecklistItemService.WithTrx(trx).GetByListNoPagination(&taskChecklistItemModel.Field{
+		TaskUUID: util.PointerString(input.TaskUUID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	ids := make([]string, len(itemBase))
+	sortOrders := make(map[string]int64, len(itemBase))
+	for i, item := range itemBase {
+		ids[i] = *item.ID
+		sortOrders[*item.ID] = *item.SortOrder
+	}
+
+	err = schedule.CheckOrder(ids, input.IDs)
+	if err != nil {
+		log.Info(err.Error())
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, err.Error())
+	}
+
+	// only the items whose position changed are updated
+	for i, id := range input.IDs {
+		sortOrder := int64(i + 1)
+		if sortOrders[id] == sortOrder {
+			continue
+		}
+
+		err = m.TaskChecklistItemService.WithTrx(trx).Update(&taskChecklistItemModel.Update{
+			ID:        id,
+			SortOrder: util.PointerInt64(sortOrder),
+			UpdatedBy: util.PointerString(input.UpdatedBy),
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, input.IDs)
+}
@@ -0,0 +1,699 @@
+package timesheet
+
+import (
+	"errors"
+	timesheetDB "gantt/internal/entity/postgresql/db/timesheets"
+	taskManager "gantt/internal/interactor/manager/task"
+	affiliationModel "gantt/internal/interactor/models/affiliations"
+	taskResourceModel "gantt/internal/interactor/models/task_resources"
+	taskModel "gantt/internal/interactor/models/tasks"
+	"gantt/internal/interactor/pkg/util"
+	affiliationService "gantt/internal/interactor/service/affiliation"
+	taskService "gantt/internal/interactor/service/task"
+	taskResourceService "gantt/internal/interactor/service/task_resource"
+	"math"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"gorm.io/gorm"
+
+	timesheetEntryModel "gantt/internal/interactor/models/timesheet_entries"
+	timesheetModel "gantt/internal/interactor/models/timesheets"
+	timesheetService "gantt/internal/interactor/service/timesheet"
+	timesheetEntryService "gantt/internal/interactor/service/timesheet_entry"
+
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+)
+
+// 每日工時上限
+const maxDailyHours = 24
+
+type Manager interface {
+	CreateEntry(trx *gorm.DB, input *timesheetEntryModel.Create) (int, any)
+	UpdateEntry(trx *gorm.DB, input *timesheetEntryModel.Update) (int, any)
+	DeleteEntry(trx *gorm.DB, input *timesheetEntryModel.Field) (int, any)
+	GetByList(input *timesheetModel.Fields) (int, any)
+	GetByApprovalList(input *timesheetModel.Fields) (int, any)
+	GetBySingle(input *timesheetModel.Field) (int, any)
+	Submit(trx *gorm.DB, input *timesheetModel.Review) (int, any)
+	Approve(trx *gorm.DB, input *timesheetModel.Review) (int, any)
+	Reject(trx *gorm.DB, input *timesheetModel.Review) (int, any)
+}
+
+type manager struct {
+	TimesheetService      timesheetService.Service
+	TimesheetEntryService timesheetEntryService.Service
+	TaskService           taskService.Service
+	TaskResourceService   taskResourceService.Service
+	AffiliationService    affiliationService.Service
+	TaskManager           taskManager.Manager
+}
+
+func Init(db *gorm.DB) Manager {
+	return &manager{
+		TimesheetService:      timesheetService.Init(db),
+		TimesheetEntryService: timesheetEntryService.Init(db),
+		TaskService:           taskService.Init(db),
+		TaskResourceService:   taskResourceService.Init(db),
+		AffiliationService:    affiliationService.Init(db),
+		TaskManager:           taskManager.Init(db),
+	}
+}
+
+// toDate is a helper function to truncate the time to its calendar date in UTC.
+func toDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// weekStart is a helper function to get the monday of the week of the date.
+func weekStart(date time.Time) time.Time {
+	offset := (int(date.Weekday()) + 6) % 7
+	return date.AddDate(0, 0, -offset)
+}
+
+// isEditable is a helper function to report whether the entries of the timesheet can be modified, which is before it is submitted or after it is rejected.
+func isEditable(timesheetBase *timesheetDB.Base) bool {
+	return *timesheetBase.Status == timesheetModel.StatusDraft || *timesheetBase.Status == timesheetModel.StatusRejected
+}
+
+// getDailyHours is a helper function to get the hours logged by the user on the date, excluding the entry.
+// The entries of every status are counted: the timesheet is unique to the user and the week, so the entries
+// of a rejected timesheet are the ones being corrected for resubmission and stay in the daily cap.
+func (m *manager) getDailyHours(trx *gorm.DB, userID string, date time.Time, excludedID string) (float64, error) {
+	entryBase, err := m.TimesheetEntryService.WithTrx(trx).GetByListNoPagination(&timesheetEntryModel.Field{
+		UserID: util.PointerString(userID),
+		Date:   util.PointerTime(date),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	hours := 0.0
+	for _, entry := range entryBase {
+		if *entry.ID != excludedID {
+			hours += *entry.Hours
+		}
+	}
+
+	return hours, nil
+}
+
+// getSupervisedUsers is a helper function to get the users of the departments supervised by the user, excluding the user.
+func (m *manager) getSupervisedUsers(userID string) (map[string]bool, error) {
+	supervisorBase, err := m.AffiliationService.GetByListNoPagination(&affiliationModel.Field{
+		UserID:       util.PointerString(userID),
+		IsSupervisor: util.PointerBool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	users := make(map[string]bool)
+	if len(supervisorBase) == 0 {
+		return users, nil
+	}
+
+	var deptIDs []*string
+	for _, supervisor := range supervisorBase {
+		deptIDs = append(deptIDs, supervisor.DeptID)
+	}
+
+	affiliationBase, err := m.AffiliationService.GetByListNoPagination(&affiliationModel.Field{
+		DeptIDs: deptIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, affiliation := range affiliationBase {
+		if *affiliation.UserID != userID {
+			users[*affiliation.UserID] = true
+		}
+	}
+
+	return users, nil
+}
+
+// getEditableEntry is a helper function to get the entry and its timesheet, returning the reason when the user cannot modify the entry.
+func (m *manager) getEditableEntry(trx *gorm.DB, id, userID string) (*timesheetDB.Base, string, error) {
+	entryBase, err := m.TimesheetEntryService.WithTrx(trx).GetBySingle(&timesheetEntryModel.Field{
+		ID: id,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	timesheetBase, err := m.TimesheetService.WithTrx(trx).GetBySingle(&timesheetModel.Field{
+		ID: *entryBase.TimesheetID,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if *timesheetBase.UserID != userID {
+		return nil, "The entry does not belong to the user.", nil
+	}
+
+	if !isEditable(timesheetBase) {
+		return nil, "The timesheet has been submitted and cannot be modified.", nil
+	}
+
+	return timesheetBase, "", nil
+}
+
+// getReviewableTimesheet is a helper function to get the submitted timesheet, returning the reason when the user cannot review it.
+func (m *manager) getReviewableTimesheet(trx *gorm.DB, input *timesheetModel.Review) (*timesheetDB.Base, string, error) {
+	timesheetBase, err := m.TimesheetService.WithTrx(trx).GetBySingle(&timesheetModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if *timesheetBase.UserID == input.UserID {
+		return nil, "The user cannot review the own timesheet.", nil
+	}
+
+	users, err := m.getSupervisedUsers(input.UserID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !users[*timesheetBase.UserID] {
+		return nil, "The user is not the supervisor of the timesheet's owner.", nil
+	}
+
+	if *timesheetBase.Status != timesheetModel.StatusSubmitted {
+		return nil, "Only the submitted timesheet can be reviewed.", nil
+	}
+
+	return timesheetBase, "", nil
+}
+
+// getList is a helper function to transform the timesheets to the list with their total hours.
+func (m *manager) getList(timesheetBase []*timesheetDB.Base, output *timesheetModel.List) error {
+	timesheetByte, err := sonic.Marshal(timesheetBase)
+	if err != nil {
+		return err
+	}
+
+	err = sonic.Unmarshal(timesheetByte, &output.Timesheets)
+	if err != nil {
+		return err
+	}
+
+	if len(timesheetBase) == 0 {
+		return nil
+	}
+
+	timesheetIDs := make([]*string, 0, len(timesheetBase))
+	for _, timesheet := range timesheetBase {
+		timesheetIDs = append(timesheetIDs, timesheet.ID)
+	}
+
+	entryBase, err := m.TimesheetEntryService.GetByListNoPagination(&timesheetEntryModel.Field{
+		TimesheetIDs: timesheetIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	hours := make(map[string]float64)
+	for _, entry := range entryBase {
+		hours[*entry.TimesheetID] += *entry.Hours
+	}
+
+	for i, timesheet := range output.Timesheets {
+		timesheet.UserName = *timesheetBase[i].Users.Name
+		timesheet.TotalHours = math.Round(hours[timesheet.ID]*100) / 100
+		timesheet.CreatedBy = *timesheetBase[i].CreatedByUsers.Name
+		timesheet.UpdatedBy = *timesheetBase[i].UpdatedByUsers.Name
+		timesheet.ReviewedBy = ""
+		if timesheetBase[i].ReviewedByUsers.Name != nil {
+			timesheet.ReviewedBy = *timesheetBase[i].ReviewedByUsers.Name
+		}
+	}
+
+	return nil
+}
+
+func (m *manager) CreateEntry(trx *gorm.DB, input *timesheetEntryModel.Create) (int, any) {
+	defer trx.Rollback()
+
+	if input.ResourceUUID == "" {
+		log.Info("The user is not linked to a resource.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The user is not linked to a resource.")
+	}
+
+	// check if the task exists
+	_, err := m.TaskService.WithTrx(trx).GetBySingle(&taskModel.Field{
+		TaskUUID: input.TaskUUID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// the hours can only be logged against the tasks assigned to the user
+	taskResourceBase, err := m.TaskResourceService.WithTrx(trx).GetByListNoPagination(&taskResourceModel.Field{
+		TaskUUID:      util.PointerString(input.TaskUUID),
+		ResourceUUIDs: []*string{util.PointerString(input.ResourceUUID)},
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if len(taskResourceBase) == 0 {
+		log.Info("You are not assigned to the task.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "You are not assigned to the task.")
+	}
+
+	// get the timesheet of the week, which is created on the first entry
+	date := toDate(*input.Date)
+	start := weekStart(date)
+	timesheetBase, err := m.TimesheetService.WithTrx(trx).GetBySingle(&timesheetModel.Field{
+		UserID:    util.PointerString(input.CreatedBy),
+		WeekStart: util.PointerTime(start),
+	})
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if timesheetBase == nil {
+		timesheetBase, err = m.TimesheetService.WithTrx(trx).Create(&timesheetModel.Create{
+			UserID:       input.CreatedBy,
+			ResourceUUID: input.ResourceUUID,
+			WeekStart:    util.PointerTime(start),
+			Status:       timesheetModel.StatusDraft,
+			CreatedBy:    input.CreatedBy,
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+	}
+
+	if !isEditable(timesheetBase) {
+		log.Info("The timesheet has been submitted and cannot be modified.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The timesheet has been submitted and cannot be modified.")
+	}
+
+	hours, err := m.getDailyHours(trx, input.CreatedBy, date, "")
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if hours+input.Hours > maxDailyHours {
+		log.Info("The hours of a day cannot exceed 24.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The hours of a day cannot exceed 24.")
+	}
+
+	input.TimesheetID = *timesheetBase.ID
+	input.Date = util.PointerTime(date)
+	entryBase, err := m.TimesheetEntryService.WithTrx(trx).Create(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, entryBase.ID)
+}
+
+func (m *manager) UpdateEntry(trx *gorm.DB, input *timesheetEntryModel.Update) (int, any) {
+	defer trx.Rollback()
+
+	_, reason, err := m.getEditableEntry(trx, input.ID, *input.UpdatedBy)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if reason != "" {
+		log.Info(reason)
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
+	}
+
+	if input.Hours != nil {
+		entryBase, err := m.TimesheetEntryService.WithTrx(trx).GetBySingle(&timesheetEntryModel.Field{
+			ID: input.ID,
+		})
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		hours, err := m.getDailyHours(trx, *input.UpdatedBy, *entryBase.Date, input.ID)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		if hours+*input.Hours > maxDailyHours {
+			log.Info("The hours of a day cannot exceed 24.")
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The hours of a day cannot exceed 24.")
+		}
+	}
+
+	err = m.TimesheetEntryService.WithTrx(trx).Update(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, input.ID)
+}
+
+func (m *manager) DeleteEntry(trx *gorm.DB, input *timesheetEntryModel.Field) (int, any) {
+	defer trx.Rollback()
+
+	_, reason, err := m.getEditableEntry(trx, input.ID, *input.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if reason != "" {
+		log.Info(reason)
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
+	}
+
+	err = m.TimesheetEntryService.WithTrx(trx).Delete(&timesheetEntryModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, "Delete ok!")
+}
+
+func (m *manager) GetByList(input *timesheetModel.Fields) (int, any) {
+	output := &timesheetModel.List{}
+	output.Limit = input.Limit
+	output.Page = input.Page
+	if input.WeekStart != nil {
+		input.WeekStart = util.PointerTime(weekStart(toDate(*input.WeekStart)))
+	}
+
+	quantity, timesheetBase, err := m.TimesheetService.GetByList(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	output.Total.Total = quantity
+	output.Pages = util.Pagination(quantity, output.Limit)
+
+	err = m.getList(timesheetBase, output)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetByApprovalList(input *timesheetModel.Fields) (int, any) {
+	output := &timesheetModel.List{}
+	output.Limit = input.Limit
+	output.Page = input.Page
+	users, err := m.getSupervisedUsers(*input.UserID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if len(users) == 0 {
+		return code.Successful, code.GetCodeMessage(code.Successful, output)
+	}
+
+	// list the timesheets waiting for the approval by default
+	input.UserID = nil
+	input.UserIDs = make([]*string, 0, len(users))
+	for userID := range users {
+		input.UserIDs = append(input.UserIDs, util.PointerString(userID))
+	}
+	if input.Status == nil {
+		input.Status = util.PointerString(timesheetModel.StatusSubmitted)
+	}
+	if input.WeekStart != nil {
+		input.WeekStart = util.PointerTime(weekStart(toDate(*input.WeekStart)))
+	}
+
+	quantity, timesheetBase, err := m.TimesheetService.GetByList(input)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+	output.Total.Total = quantity
+	output.Pages = util.Pagination(quantity, output.Limit)
+
+	err = m.getList(timesheetBase, output)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) GetBySingle(input *timesheetModel.Field) (int, any) {
+	timesheetBase, err := m.TimesheetService.GetBySingle(&timesheetModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// the timesheet can be viewed by its owner, the supervisors of the owner and the admin
+	if *timesheetBase.UserID != *input.UserID && *input.Role != "admin" {
+		users, err := m.getSupervisedUsers(*input.UserID)
+		if err != nil {
+			log.Error(err)
+			return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+		}
+
+		if !users[*timesheetBase.UserID] {
+			log.Info("The user don't have permission to view the timesheet.")
+			return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The user don't have permission to view the timesheet.")
+		}
+	}
+
+	output := &timesheetModel.Single{}
+	timesheetByte, _ := sonic.Marshal(timesheetBase)
+	err = sonic.Unmarshal(timesheetByte, &output)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	entryBase, err := m.TimesheetEntryService.GetByListNoPagination(&timesheetEntryModel.Field{
+		TimesheetID: timesheetBase.ID,
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	output.Entries = make([]*timesheetModel.Entry, 0, len(entryBase))
+	for _, entry := range entryBase {
+		output.Entries = append(output.Entries, &timesheetModel.Entry{
+			ID:       *entry.ID,
+			TaskUUID: *entry.TaskUUID,
+			TaskName: *entry.Tasks.TaskName,
+			Date:     entry.Date,
+			Hours:    *entry.Hours,
+			Notes:    *entry.Notes,
+		})
+		output.TotalHours += *entry.Hours
+	}
+
+	output.TotalHours = math.Round(output.TotalHours*100) / 100
+	output.UserName = *timesheetBase.Users.Name
+	output.CreatedBy = *timesheetBase.CreatedByUsers.Name
+	output.UpdatedBy = *timesheetBase.UpdatedByUsers.Name
+	output.ReviewedBy = ""
+	if timesheetBase.ReviewedByUsers.Name != nil {
+		output.ReviewedBy = *timesheetBase.ReviewedByUsers.Name
+	}
+
+	return code.Successful, code.GetCodeMessage(code.Successful, output)
+}
+
+func (m *manager) Submit(trx *gorm.DB, input *timesheetModel.Review) (int, any) {
+	defer trx.Rollback()
+
+	timesheetBase, err := m.TimesheetService.WithTrx(trx).GetBySingle(&timesheetModel.Field{
+		ID: input.ID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if *timesheetBase.UserID != input.UserID {
+		log.Info("The timesheet does not belong to the user.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The timesheet does not belong to the user.")
+	}
+
+	if !isEditable(timesheetBase) {
+		log.Info("The timesheet has been submitted.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The timesheet has been submitted.")
+	}
+
+	quantity, err := m.TimesheetEntryService.WithTrx(trx).GetByQuantity(&timesheetEntryModel.Field{
+		TimesheetID: timesheetBase.ID,
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if quantity == 0 {
+		log.Info("The timesheet has no entries.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The timesheet has no entries.")
+	}
+
+	err = m.TimesheetService.WithTrx(trx).Update(&timesheetModel.Update{
+		ID:          input.ID,
+		Status:      util.PointerString(timesheetModel.StatusSubmitted),
+		SubmittedAt: util.PointerTime(util.NowToUTC()),
+		UpdatedBy:   util.PointerString(input.UserID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, input.ID)
+}
+
+func (m *manager) Approve(trx *gorm.DB, input *timesheetModel.Review) (int, any) {
+	defer trx.Rollback()
+
+	timesheetBase, reason, err := m.getReviewableTimesheet(trx, input)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if reason != "" {
+		log.Info(reason)
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
+	}
+
+	err = m.TimesheetService.WithTrx(trx).Update(&timesheetModel.Update{
+		ID:         input.ID,
+		Status:     util.PointerString(timesheetModel.StatusApproved),
+		ReviewedBy: util.PointerString(input.UserID),
+		ReviewedAt: util.PointerTime(util.NowToUTC()),
+		Comment:    input.Comment,
+		UpdatedBy:  util.PointerString(input.UserID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	// roll the approved hours up into the actual work of the tasks
+	entryBase, err := m.TimesheetEntryService.WithTrx(trx).GetByListNoPagination(&timesheetEntryModel.Field{
+		TimesheetID: timesheetBase.ID,
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	var taskUUIDs []*string
+	tasks := make(map[string]bool)
+	for _, entry := range entryBase {
+		if !tasks[*entry.TaskUUID] {
+			tasks[*entry.TaskUUID] = true
+			taskUUIDs = append(taskUUIDs, entry.TaskUUID)
+		}
+	}
+
+	err = m.TaskManager.SyncActualWork(trx, taskUUIDs, input.UserID)
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, input.ID)
+}
+
+func (m *manager) Reject(trx *gorm.DB, input *timesheetModel.Review) (int, any) {
+	defer trx.Rollback()
+
+	if input.Comment == nil || *input.Comment == "" {
+		log.Info("The comment is required to reject the timesheet.")
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, "The comment is required to reject the timesheet.")
+	}
+
+	_, reason, err := m.getReviewableTimesheet(trx, input)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code.DoesNotExist, code.GetCodeMessage(code.DoesNotExist, err.Error())
+		}
+
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	if reason != "" {
+		log.Info(reason)
+		return code.BadRequest, code.GetCodeMessage(code.BadRequest, reason)
+	}
+
+	err = m.TimesheetService.WithTrx(trx).Update(&timesheetModel.Update{
+		ID:         input.ID,
+		Status:     util.PointerString(timesheetModel.StatusRejected),
+		ReviewedBy: util.PointerString(input.UserID),
+		ReviewedAt: util.PointerTime(util.NowToUTC()),
+		Comment:    input.Comment,
+		UpdatedBy:  util.PointerString(input.UserID),
+	})
+	if err != nil {
+		log.Error(err)
+		return code.InternalServerError, code.GetCodeMessage(code.InternalServerError, err.Error())
+	}
+
+	trx.Commit()
+	return code.Successful, code.GetCodeMessage(code.Successful, input.ID)
+}
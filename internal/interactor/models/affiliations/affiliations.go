@@ -41,6 +41,8 @@ type Field struct {
 	UserIDs []*string `json:"user_ids,omitempty" form:"user_ids" swaggerignore:"true"`
 	// 部門ID
 	DeptID *string `json:"dept_id,omitempty" form:"dept_id"`
+	// 部門IDs (後端查詢用)
+	DeptIDs []*string `json:"dept_ids,omitempty" form:"dept_ids" swaggerignore:"true"`
 	// 職稱
 	JobTitle *string `json:"job_title,omitempty" form:"job_title"`
 	// 是否為主管
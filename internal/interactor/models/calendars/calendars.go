@@ -0,0 +1,127 @@
+package calendars
+
+import (
+	"gantt/internal/interactor/models/page"
+	"gantt/internal/interactor/models/section"
+	"gantt/internal/interactor/models/work_days"
+	"time"
+)
+
+// Create struct is used to create achieves
+type Create struct {
+	// 名稱
+	Name string `json:"name,omitempty" binding:"required" validate:"required"`
+	// 工作日
+	WorkWeeks []string `json:"workWeek,omitempty"`
+	// 工作日(陣列的字串型態)
+	WorkWeek string `json:"work_week,omitempty" swaggerignore:"true"`
+	// 工作時間
+	WorkingTimes []*work_days.WorkingTimes `json:"workingTime,omitempty"`
+	// 工作時間(陣列的字串型態)
+	WorkingTime string `json:"working_time,omitempty" swaggerignore:"true"`
+	// 是否為預設行事曆
+	IsDefault bool `json:"is_default,omitempty"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Field is structure file for search
+type Field struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 名稱
+	Name *string `json:"name,omitempty" form:"name"`
+	// 是否為預設行事曆
+	IsDefault *bool `json:"is_default,omitempty" form:"is_default"`
+}
+
+// Fields is the searched structure file (including pagination)
+type Fields struct {
+	// 搜尋結構檔
+	Field
+	// 分頁搜尋結構檔
+	page.Pagination
+}
+
+// List is multiple return structure files
+type List struct {
+	// 多筆
+	Calendars []*struct {
+		// 表ID
+		ID string `json:"id,omitempty"`
+		// 名稱
+		Name string `json:"name,omitempty"`
+		// 工作日
+		WorkWeeks []string `json:"workWeek,omitempty"`
+		// 工作時間
+		WorkingTimes []work_days.WorkingTimes `json:"workingTime,omitempty"`
+		// 是否為預設行事曆
+		IsDefault bool `json:"is_default"`
+		// 假日
+		Holidays []*Holiday `json:"holidays,omitempty"`
+		// 創建者
+		CreatedBy string `json:"created_by,omitempty"`
+		// 更新者
+		UpdatedBy string `json:"updated_by,omitempty"`
+		// 時間戳記
+		section.TimeAt
+	} `json:"calendars"`
+	// 分頁返回結構檔
+	page.Total
+}
+
+// Single return structure file
+type Single struct {
+	// 表ID
+	ID string `json:"id,omitempty"`
+	// 名稱
+	Name string `json:"name,omitempty"`
+	// 工作日
+	WorkWeeks []string `json:"workWeek,omitempty"`
+	// 工作時間
+	WorkingTimes []work_days.WorkingTimes `json:"workingTime,omitempty"`
+	// 是否為預設行事曆
+	IsDefault bool `json:"is_default"`
+	// 假日
+	Holidays []*Holiday `json:"holidays,omitempty"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty"`
+	// 更新者
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// 時間戳記
+	section.TimeAt
+}
+
+// Update struct is used to update achieves
+type Update struct {
+	// 表ID
+	ID string `json:"id,omitempty"  binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 名稱
+	Name *string `json:"name,omitempty"`
+	// 工作日
+	WorkWeeks []*string `json:"workWeek,omitempty"`
+	// 工作日(陣列的字串型態)
+	WorkWeek *string `json:"work_week,omitempty" swaggerignore:"true"`
+	// 工作時間
+	WorkingTimes []*work_days.WorkingTimes `json:"workingTime,omitempty"`
+	// 工作時間(陣列的字串型態)
+	WorkingTime *string `json:"working_time,omitempty" swaggerignore:"true"`
+	// 是否為預設行事曆
+	IsDefault *bool `json:"is_default,omitempty"`
+	// 更新者
+	UpdatedBy *string `json:"updated_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Holiday struct is the holiday of the calendar
+type Holiday struct {
+	// 表ID
+	ID string `json:"id,omitempty"`
+	// 名稱
+	Name string `json:"label,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"from,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"to,omitempty"`
+	// 前端css
+	Css string `json:"cssClass,omitempty"`
+}
@@ -16,6 +16,8 @@ type Create struct {
 	EndDate *time.Time `json:"to,omitempty"`
 	// 前端css
 	Css string `json:"cssClass,omitempty"`
+	// 行事曆UUID
+	CalendarUUID string `json:"calendar_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
 	// 創建者
 	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
 }
@@ -32,6 +34,8 @@ type Field struct {
 	EndDate *time.Time `json:"to,omitempty" form:"end_date"`
 	// 前端css
 	Css *string `json:"cssClass,omitempty" form:"css"`
+	// 行事曆UUID
+	CalendarUUID *string `json:"calendar_uuid,omitempty" form:"calendar_uuid" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
 }
 
 // Fields is the searched structure file (including pagination)
@@ -56,6 +60,8 @@ type List struct {
 		EndDate *time.Time `json:"to,omitempty"`
 		// 前端css
 		Css string `json:"cssClass,omitempty"`
+		// 行事曆UUID
+		CalendarUUID string `json:"calendar_uuid,omitempty"`
 		// 創建者
 		CreatedBy string `json:"created_by,omitempty"`
 		// 更新者
@@ -79,6 +85,8 @@ type Single struct {
 	EndDate *time.Time `json:"to,omitempty"`
 	// 前端css
 	Css string `json:"cssClass,omitempty"`
+	// 行事曆UUID
+	CalendarUUID string `json:"calendar_uuid,omitempty"`
 	// 創建者
 	CreatedBy string `json:"created_by,omitempty"`
 	// 更新者
@@ -99,6 +107,8 @@ type Update struct {
 	EndDate *time.Time `json:"to,omitempty"`
 	// 前端css
 	Css *string `json:"cssClass,omitempty"`
+	// 行事曆UUID (空字串表示移出行事曆)
+	CalendarUUID *string `json:"calendar_uuid,omitempty"`
 	// 更新者
 	UpdatedBy *string `json:"updated_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
 }
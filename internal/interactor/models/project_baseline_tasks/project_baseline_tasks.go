@@ -0,0 +1,76 @@
+package project_baseline_tasks
+
+import (
+	"gantt/internal/interactor/models/section"
+	"time"
+)
+
+// Create struct is used to create achieves
+type Create struct {
+	// 基準線UUID
+	BaselineUUID string `json:"baseline_uuid,omitempty" binding:"required,uuid4" validate:"required,uuid4"`
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty" binding:"required,uuid4" validate:"required,uuid4"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `json:"outline_number,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// 期間
+	Duration float64 `json:"duration"`
+	// 花費時間
+	Cost int64 `json:"cost"`
+	// 計畫工時 (核准工時表前的花費時間)
+	PlannedWork *float64 `json:"planned_work,omitempty"`
+	// 完成百分比
+	Progress int64 `json:"progress"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Field is structure file for search
+type Field struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 基準線UUID
+	BaselineUUID *string `json:"baseline_uuid,omitempty" form:"baseline_uuid"`
+	// 基準線UUIDs (後端批量刪除用）
+	BaselineUUIDs []*string `json:"baseline_uuids,omitempty" form:"baseline_uuids" swaggerignore:"true"`
+	// 任務UUID
+	TaskUUID *string `json:"task_uuid,omitempty" form:"task_uuid"`
+}
+
+// Single return structure file
+type Single struct {
+	// 表ID
+	ID string `json:"id,omitempty"`
+	// 基準線UUID
+	BaselineUUID string `json:"baseline_uuid,omitempty"`
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `json:"outline_number,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// 期間
+	Duration float64 `json:"duration"`
+	// 花費時間
+	Cost int64 `json:"cost"`
+	// 計畫工時 (核准工時表前的花費時間)
+	PlannedWork *float64 `json:"planned_work,omitempty"`
+	// 完成百分比
+	Progress int64 `json:"progress"`
+	// 時間戳記
+	section.TimeAt
+}
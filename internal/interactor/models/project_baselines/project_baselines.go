@@ -0,0 +1,231 @@
+package project_baselines
+
+import (
+	"gantt/internal/interactor/models/page"
+	"gantt/internal/interactor/models/project_baseline_tasks"
+	"gantt/internal/interactor/models/section"
+	"time"
+)
+
+// Create struct is used to create achieves
+type Create struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty" binding:"required,uuid4" validate:"required,uuid4"`
+	// 名稱
+	Name string `json:"name,omitempty" binding:"required" validate:"required"`
+	// 是否為選用的基準線
+	IsSelected bool `json:"is_selected,omitempty"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Field is structure file for search
+type Field struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 專案UUID
+	ProjectUUID *string `json:"project_uuid,omitempty" form:"project_uuid" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+	// 名稱
+	Name *string `json:"name,omitempty" form:"name"`
+	// 是否為選用的基準線
+	IsSelected *bool `json:"is_selected,omitempty" form:"is_selected"`
+}
+
+// Fields is the searched structure file (including pagination)
+type Fields struct {
+	// 搜尋結構檔
+	Field
+	// 分頁搜尋結構檔
+	page.Pagination
+}
+
+// List is multiple return structure files
+type List struct {
+	// 多筆
+	ProjectBaselines []*struct {
+		// 表ID
+		ID string `json:"id,omitempty"`
+		// 專案UUID
+		ProjectUUID string `json:"project_uuid,omitempty"`
+		// 名稱
+		Name string `json:"name,omitempty"`
+		// 是否為選用的基準線
+		IsSelected bool `json:"is_selected"`
+		// 創建者
+		CreatedBy string `json:"created_by,omitempty"`
+		// 更新者
+		UpdatedBy string `json:"updated_by,omitempty"`
+		// 時間戳記
+		section.TimeAt
+	} `json:"project_baselines"`
+	// 分頁返回結構檔
+	page.Total
+}
+
+// Single return structure file
+type Single struct {
+	// 表ID
+	ID string `json:"id,omitempty"`
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// 名稱
+	Name string `json:"name,omitempty"`
+	// 是否為選用的基準線
+	IsSelected bool `json:"is_selected"`
+	// 任務快照
+	Tasks []*project_baseline_tasks.Single `json:"tasks,omitempty"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty"`
+	// 更新者
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// 時間戳記
+	section.TimeAt
+}
+
+// Update struct is used to update achieves
+type Update struct {
+	// 表ID
+	ID string `json:"id,omitempty"  binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 名稱
+	Name *string `json:"name,omitempty"`
+	// 是否為選用的基準線 (選用後將填入get-by-projects的基準線欄位)
+	IsSelected *bool `json:"is_selected,omitempty"`
+	// 更新者
+	UpdatedBy *string `json:"updated_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Compare struct is used to compare the baseline with another baseline or the current schedule
+type Compare struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 比較的基準線UUID (空值表示與目前排程比較)
+	TargetUUID *string `json:"target_uuid,omitempty" form:"target_uuid" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+}
+
+// Comparison return structure file
+type Comparison struct {
+	// 基準線
+	Source *Summary `json:"source"`
+	// 比較的基準線 (空值表示目前排程)
+	Target *Summary `json:"target,omitempty"`
+	// 任務差異
+	Tasks []*TaskComparison `json:"tasks"`
+}
+
+// Summary struct is the compared baseline
+type Summary struct {
+	// 表ID
+	ID string `json:"id,omitempty"`
+	// 名稱
+	Name string `json:"name,omitempty"`
+	// 創建時間
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// TaskComparison struct is the difference of one task between the compared schedules
+type TaskComparison struct {
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `json:"outline_number,omitempty"`
+	// 狀態 (added: 僅存在於比較對象、removed: 僅存在於基準線、changed、unchanged)
+	Status string `json:"status,omitempty"`
+	// 基準線起始日期
+	SourceStartDate *time.Time `json:"source_start_date,omitempty"`
+	// 基準線結束日期
+	SourceEndDate *time.Time `json:"source_end_date,omitempty"`
+	// 基準線期間
+	SourceDuration float64 `json:"source_duration"`
+	// 基準線花費時間
+	SourceCost int64 `json:"source_cost"`
+	// 基準線完成百分比
+	SourceProgress int64 `json:"source_progress"`
+	// 比較對象起始日期
+	TargetStartDate *time.Time `json:"target_start_date,omitempty"`
+	// 比較對象結束日期
+	TargetEndDate *time.Time `json:"target_end_date,omitempty"`
+	// 比較對象期間
+	TargetDuration float64 `json:"target_duration"`
+	// 比較對象花費時間
+	TargetCost int64 `json:"target_cost"`
+	// 比較對象完成百分比
+	TargetProgress int64 `json:"target_progress"`
+	// 起始差異 (工作天，正值表示延後)
+	StartVariance *float64 `json:"start_variance,omitempty"`
+	// 完成差異 (工作天，正值表示延後)
+	FinishVariance *float64 `json:"finish_variance,omitempty"`
+	// 期間差異
+	DurationVariance float64 `json:"duration_variance"`
+	// 花費差異
+	CostVariance int64 `json:"cost_variance"`
+}
+
+// Variance struct is used to get the variance report of the project against the baseline
+type Variance struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 基準線UUID (空值表示已選取的基準線)
+	BaselineUUID *string `json:"baseline,omitempty" form:"baseline" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+	// 排序 (outline: 大綱順序、slippage: 延誤最嚴重者優先)
+	Sort *string `json:"sort,omitempty" form:"sort" binding:"omitempty,oneof=outline slippage" validate:"omitempty,oneof=outline slippage"`
+	// 使用者UUID
+	UserID *string `json:"user_id,omitempty" form:"user_id" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" form:"res_uuid" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" form:"role" swaggerignore:"true"`
+}
+
+// VarianceReport return structure file
+type VarianceReport struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// 基準線
+	Baseline *Summary `json:"baseline"`
+	// 任務差異
+	Tasks []*TaskVariance `json:"tasks"`
+}
+
+// TaskVariance struct is the variance of one task against the baseline
+type TaskVariance struct {
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `json:"outline_number,omitempty"`
+	// 是否為摘要任務 (數值由子任務彙總)
+	IsSummary bool `json:"is_summary"`
+	// 是否存在於基準線
+	InBaseline bool `json:"in_baseline"`
+	// 基準線起始日期
+	BaselineStartDate *time.Time `json:"baseline_start_date,omitempty"`
+	// 基準線結束日期
+	BaselineEndDate *time.Time `json:"baseline_end_date,omitempty"`
+	// 基準線期間
+	BaselineDuration float64 `json:"baseline_duration"`
+	// 基準線花費時間
+	BaselineCost int64 `json:"baseline_cost"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// 期間
+	Duration float64 `json:"duration"`
+	// 花費時間
+	Cost int64 `json:"cost"`
+	// 起始差異 (工作天，正值表示延後)
+	StartVariance *float64 `json:"start_variance,omitempty"`
+	// 完成差異 (工作天，正值表示延後)
+	FinishVariance *float64 `json:"finish_variance,omitempty"`
+	// 期間差異
+	DurationVariance *float64 `json:"duration_variance,omitempty"`
+	// 花費差異
+	CostVariance *int64 `json:"cost_variance,omitempty"`
+}
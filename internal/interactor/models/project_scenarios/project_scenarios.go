@@ -0,0 +1,200 @@
+package project_scenarios
+
+import (
+	"gantt/internal/interactor/models/page"
+	"gantt/internal/interactor/models/section"
+	"time"
+)
+
+// Create struct is used to create achieves
+type Create struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty" binding:"required,uuid4" validate:"required,uuid4"`
+	// 情境專案UUID
+	ScenarioProjectUUID string `json:"scenario_project_uuid,omitempty" swaggerignore:"true"`
+	// 名稱
+	Name string `json:"name,omitempty" binding:"required" validate:"required"`
+	// 描述
+	Description string `json:"description,omitempty"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Field is structure file for search
+type Field struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 專案UUID
+	ProjectUUID *string `json:"project_uuid,omitempty" form:"project_uuid" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+	// 情境專案UUID
+	ScenarioProjectUUID *string `json:"scenario_project_uuid,omitempty" form:"scenario_project_uuid" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+	// 情境專案UUIDs (後端查詢用)
+	ScenarioProjectUUIDs []*string `json:"scenario_project_uuids,omitempty" swaggerignore:"true"`
+	// 名稱
+	Name *string `json:"name,omitempty" form:"name"`
+}
+
+// Fields is the searched structure file (including pagination)
+type Fields struct {
+	// 搜尋結構檔
+	Field
+	// 分頁搜尋結構檔
+	page.Pagination
+}
+
+// List is multiple return structure files
+type List struct {
+	// 多筆
+	ProjectScenarios []*struct {
+		// 表ID
+		ID string `json:"id,omitempty"`
+		// 專案UUID
+		ProjectUUID string `json:"project_uuid,omitempty"`
+		// 情境專案UUID (以此專案UUID編輯情境的任務)
+		ScenarioProjectUUID string `json:"scenario_project_uuid,omitempty"`
+		// 名稱
+		Name string `json:"name,omitempty"`
+		// 描述
+		Description string `json:"description,omitempty"`
+		// 創建者
+		CreatedBy string `json:"created_by,omitempty"`
+		// 更新者
+		UpdatedBy string `json:"updated_by,omitempty"`
+		// 時間戳記
+		section.TimeAt
+	} `json:"project_scenarios"`
+	// 分頁返回結構檔
+	page.Total
+}
+
+// Single return structure file
+type Single struct {
+	// 表ID
+	ID string `json:"id,omitempty"`
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// 情境專案UUID (以此專案UUID編輯情境的任務)
+	ScenarioProjectUUID string `json:"scenario_project_uuid,omitempty"`
+	// 名稱
+	Name string `json:"name,omitempty"`
+	// 描述
+	Description string `json:"description,omitempty"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty"`
+	// 更新者
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// 時間戳記
+	section.TimeAt
+}
+
+// Update struct is used to update achieves
+type Update struct {
+	// 表ID
+	ID string `json:"id,omitempty"  binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 名稱
+	Name *string `json:"name,omitempty"`
+	// 描述
+	Description *string `json:"description,omitempty"`
+	// 更新者
+	UpdatedBy *string `json:"updated_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Promote struct is used to promote the scenario to the project schedule
+type Promote struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 更新者
+	UpdatedBy string `json:"updated_by,omitempty" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
+
+// Comparison return structure file
+type Comparison struct {
+	// 目前排程
+	Project *Summary `json:"project"`
+	// 情境排程
+	Scenario *Summary `json:"scenario"`
+	// 結束日期差異 (工作天，正值表示延後)
+	EndDateVariance *float64 `json:"end_date_variance,omitempty"`
+	// 期間差異
+	DurationVariance float64 `json:"duration_variance"`
+	// 花費差異
+	CostVariance int64 `json:"cost_variance"`
+	// 資源負載差異
+	Resources []*ResourceComparison `json:"resources"`
+	// 任務差異 (僅列出新增、刪除及變更的任務)
+	Tasks []*TaskComparison `json:"tasks"`
+	// 衝突任務 (建立情境後新增至目前排程的任務，合併時保留於情境任務之後)
+	Conflicts []*TaskComparison `json:"conflicts"`
+}
+
+// Summary struct is the compared schedule
+type Summary struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// 名稱
+	Name string `json:"name,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// 期間 (工作天)
+	Duration float64 `json:"duration"`
+	// 花費時間
+	Cost int64 `json:"cost"`
+}
+
+// ResourceComparison struct is the difference of the load of one resource between the compared schedules
+type ResourceComparison struct {
+	// 資源UUID
+	ResourceUUID string `json:"resource_uuid,omitempty"`
+	// 資源名稱
+	ResourceName string `json:"resource_name,omitempty"`
+	// 目前排程的工時
+	ProjectHours float64 `json:"project_hours"`
+	// 情境排程的工時
+	ScenarioHours float64 `json:"scenario_hours"`
+	// 工時差異
+	HoursVariance float64 `json:"hours_variance"`
+	// 目前排程是否過度配置
+	ProjectOverallocated bool `json:"project_overallocated"`
+	// 情境排程是否過度配置
+	ScenarioOverallocated bool `json:"scenario_overallocated"`
+}
+
+// TaskComparison struct is the difference of one task between the compared schedules
+type TaskComparison struct {
+	// 任務UUID (空值表示僅存在於情境)
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 情境任務UUID (空值表示已自情境刪除)
+	ScenarioTaskUUID string `json:"scenario_task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `json:"outline_number,omitempty"`
+	// 狀態 (added: 僅存在於情境、removed: 僅存在於目前排程、changed、conflict: 建立情境後新增至目前排程)
+	Status string `json:"status,omitempty"`
+	// 目前起始日期
+	ProjectStartDate *time.Time `json:"project_start_date,omitempty"`
+	// 目前結束日期
+	ProjectEndDate *time.Time `json:"project_end_date,omitempty"`
+	// 目前期間
+	ProjectDuration float64 `json:"project_duration"`
+	// 目前花費時間
+	ProjectCost int64 `json:"project_cost"`
+	// 情境起始日期
+	ScenarioStartDate *time.Time `json:"scenario_start_date,omitempty"`
+	// 情境結束日期
+	ScenarioEndDate *time.Time `json:"scenario_end_date,omitempty"`
+	// 情境期間
+	ScenarioDuration float64 `json:"scenario_duration"`
+	// 情境花費時間
+	ScenarioCost int64 `json:"scenario_cost"`
+	// 完成差異 (工作天，正值表示延後)
+	FinishVariance *float64 `json:"finish_variance,omitempty"`
+}
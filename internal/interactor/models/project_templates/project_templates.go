@@ -0,0 +1,210 @@
+package project_templates
+
+import (
+	"gantt/internal/interactor/models/page"
+	"gantt/internal/interactor/models/section"
+	"time"
+)
+
+// Create struct is used to save the project as a template
+type Create struct {
+	// 來源專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty" binding:"required,uuid4" validate:"required,uuid4"`
+	// 名稱
+	Name string `json:"name,omitempty" binding:"required" validate:"required"`
+	// 類別ID
+	TypeID *string `json:"type_id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+	// 描述
+	Description string `json:"description,omitempty"`
+	// 基準日期
+	StartDate *time.Time `json:"start_date,omitempty" swaggerignore:"true"`
+	// 任務(陣列的字串型態)
+	Tasks string `json:"tasks,omitempty" swaggerignore:"true"`
+	// 專案資源(陣列的字串型態)
+	Resources string `json:"resources,omitempty" swaggerignore:"true"`
+	// 事件標記(陣列的字串型態)
+	EventMarks string `json:"event_marks,omitempty" swaggerignore:"true"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
+
+// Field is structure file for search
+type Field struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 名稱
+	Name *string `json:"name,omitempty" form:"name"`
+	// 類別ID
+	TypeID *string `json:"type_id,omitempty" form:"type_id" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+}
+
+// Fields is the searched structure file (including pagination)
+type Fields struct {
+	// 搜尋結構檔
+	Field
+	// 分頁搜尋結構檔
+	page.Pagination
+}
+
+// List is multiple return structure files
+type List struct {
+	// 多筆
+	ProjectTemplates []*struct {
+		// 表ID
+		ID string `json:"id,omitempty"`
+		// 名稱
+		Name string `json:"name,omitempty"`
+		// 類別ID
+		TypeID string `json:"type_id,omitempty"`
+		// 類別名稱
+		TypeName string `json:"type_name,omitempty"`
+		// 描述
+		Description string `json:"description,omitempty"`
+		// 基準日期
+		StartDate *time.Time `json:"start_date,omitempty"`
+		// 創建者
+		CreatedBy string `json:"created_by,omitempty"`
+		// 更新者
+		UpdatedBy string `json:"updated_by,omitempty"`
+		// 時間戳記
+		section.TimeAt
+	} `json:"project_templates"`
+	// 分頁返回結構檔
+	page.Total
+}
+
+// Single return structure file
+type Single struct {
+	// 表ID
+	ID string `json:"id,omitempty"`
+	// 名稱
+	Name string `json:"name,omitempty"`
+	// 類別ID
+	TypeID string `json:"type_id,omitempty"`
+	// 類別名稱
+	TypeName string `json:"type_name,omitempty"`
+	// 描述
+	Description string `json:"description,omitempty"`
+	// 任務、專案資源及事件標記
+	Content
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty"`
+	// 更新者
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// 時間戳記
+	section.TimeAt
+}
+
+// Update struct is used to update achieves
+type Update struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 名稱
+	Name *string `json:"name,omitempty"`
+	// 類別ID
+	TypeID *string `json:"type_id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+	// 描述
+	Description *string `json:"description,omitempty"`
+	// 更新者
+	UpdatedBy *string `json:"updated_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Content struct is the tasks, resources and event marks kept in the template
+type Content struct {
+	// 基準日期 (任務日期依此日期平移)
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 任務
+	Tasks []*Task `json:"tasks"`
+	// 專案資源
+	Resources []*Resource `json:"resources"`
+	// 事件標記
+	EventMarks []*EventMark `json:"event_marks"`
+}
+
+// Task struct is the task kept in the template
+type Task struct {
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// 基準線起始日期
+	BaselineStartDate *time.Time `json:"baseline_start_date,omitempty"`
+	// 基準線結束日期
+	BaselineEndDate *time.Time `json:"baseline_end_date,omitempty"`
+	// 基準線工作天
+	BaselineDuration float64 `json:"baseline_duration,omitempty"`
+	// 期間
+	Duration float64 `json:"duration,omitempty"`
+	// 樂觀期間
+	OptimisticDuration *float64 `json:"optimistic_duration,omitempty"`
+	// 最可能期間
+	MostLikelyDuration *float64 `json:"most_likely_duration,omitempty"`
+	// 悲觀期間
+	PessimisticDuration *float64 `json:"pessimistic_duration,omitempty"`
+	// 花費時間
+	Cost int64 `json:"cost,omitempty"`
+	// 前任
+	Predecessor string `json:"predecessor,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `json:"outline_number,omitempty"`
+	// 未知
+	Assignments string `json:"assignments,omitempty"`
+	// 紀錄標的顏色
+	TaskColor string `json:"task_color,omitempty"`
+	// 預留：外部連結
+	WebLink string `json:"web_link,omitempty"`
+	// 是否為任務
+	IsSubTask bool `json:"is_subtask,omitempty"`
+	// 是否為里程碑
+	IsMilestone bool `json:"is_milestone,omitempty"`
+	// 任務分段(陣列的字串型態)
+	Segment string `json:"segment,omitempty"`
+	// 任務標示(陣列的字串型態)
+	Indicator string `json:"indicator,omitempty"`
+	// 備註
+	Notes string `json:"notes,omitempty"`
+	// 限制類型 (ASAP、ALAP、SNET、FNLT、MSO、MFO)
+	ConstraintType string `json:"constraint_type,omitempty"`
+	// 限制日期
+	ConstraintDate *time.Time `json:"constraint_date,omitempty"`
+	// 期限
+	Deadline *time.Time `json:"deadline,omitempty"`
+	// 優先順序 (0~1000)
+	Priority *int `json:"priority,omitempty"`
+	// 人力資源
+	Resources []*TaskResource `json:"resources,omitempty"`
+}
+
+// TaskResource struct is the resource assigned to the task kept in the template
+type TaskResource struct {
+	// 資源UUID
+	ResourceUUID string `json:"resource_uuid,omitempty"`
+	// 單位
+	Unit float64 `json:"unit,omitempty"`
+}
+
+// Resource struct is the project resource kept in the template
+type Resource struct {
+	// 資源UUID
+	ResourceUUID string `json:"resource_uuid,omitempty"`
+	// 專案角色
+	Role string `json:"role,omitempty"`
+	// 是否可編輯專案任務
+	IsEditable *bool `json:"is_editable,omitempty"`
+}
+
+// EventMark struct is the event mark kept in the template
+type EventMark struct {
+	// 名稱
+	Name string `json:"label,omitempty"`
+	// 日期
+	Day *time.Time `json:"day,omitempty"`
+}
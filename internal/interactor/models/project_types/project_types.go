@@ -9,6 +9,10 @@ import (
 type Create struct {
 	// 名稱
 	Name string `json:"name,omitempty"`
+	// 任務狀態轉換 (key: 目前狀態，value: 可變更的狀態，空值表示預設轉換)
+	Transitions map[string][]string `json:"transitions,omitempty"`
+	// 任務狀態轉換(JSON字串型態)
+	StatusTransitions string `json:"status_transitions,omitempty" swaggerignore:"true"`
 	// 創建者
 	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
 }
@@ -39,6 +43,8 @@ type List struct {
 		ID string `json:"id,omitempty"`
 		// 名稱
 		Name string `json:"name,omitempty"`
+		// 任務狀態轉換 (key: 目前狀態，value: 可變更的狀態)
+		Transitions map[string][]string `json:"transitions"`
 		// 創建者
 		CreatedBy string `json:"created_by,omitempty"`
 		// 更新者
@@ -56,6 +62,8 @@ type Single struct {
 	ID string `json:"id,omitempty"`
 	// 名稱
 	Name string `json:"name,omitempty"`
+	// 任務狀態轉換 (key: 目前狀態，value: 可變更的狀態)
+	Transitions map[string][]string `json:"transitions"`
 	// 創建者
 	CreatedBy string `json:"created_by,omitempty"`
 	// 更新者
@@ -70,6 +78,10 @@ type Update struct {
 	ID string `json:"id,omitempty"  binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
 	// 名稱
 	Name *string `json:"name,omitempty"`
+	// 任務狀態轉換 (key: 目前狀態，value: 可變更的狀態，空物件表示改回預設轉換)
+	Transitions map[string][]string `json:"transitions,omitempty"`
+	// 任務狀態轉換(JSON字串型態)
+	StatusTransitions *string `json:"status_transitions,omitempty" swaggerignore:"true"`
 	// 更新者
 	UpdatedBy *string `json:"updated_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
 }
@@ -22,12 +22,40 @@ type Create struct {
 	Client string `json:"client,omitempty"`
 	// 狀態
 	Status string `json:"status,omitempty" binding:"required" validate:"required"`
+	// 行事曆UUID
+	CalendarUUID string `json:"calendar_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+	// 是否依檢查清單的完成比例計算任務進度
+	ProgressFromChecklist bool `json:"progress_from_checklist,omitempty"`
 	//資源
 	Resource []*ProjectResource `json:"resource,omitempty"`
+	// 範本UUID (依範本建立任務、專案資源及事件標記，日期依起始日期平移)
+	TemplateUUID string `json:"template_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+	// 是否為情境的沙盒專案 (後端建立情境用)
+	IsScenario bool `json:"is_scenario,omitempty" swaggerignore:"true"`
 	// 創建者
 	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
 }
 
+// Clone struct is used to clone the project with a new start date
+type Clone struct {
+	// 來源專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 名稱
+	ProjectName string `json:"project_name,omitempty" binding:"required" validate:"required"`
+	// 代號
+	Code string `json:"code,omitempty"`
+	// 客戶 (未填則同來源專案)
+	Client *string `json:"client,omitempty"`
+	// 起始日期 (任務日期依此日期平移)
+	StartDate *time.Time `json:"start_date,omitempty" binding:"required" validate:"required"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
+
 // Field is structure file for search
 type Field struct {
 	// 表ID
@@ -48,6 +76,8 @@ type Field struct {
 	Client *string `json:"client,omitempty" form:"client"`
 	// 狀態
 	Status *string `json:"status,omitempty" form:"status"`
+	// 行事曆UUID
+	CalendarUUID *string `json:"calendar_uuid,omitempty" form:"calendar_uuid" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
 	// 使用者ID
 	UserID *string `json:"user_id,omitempty" form:"user_id" swaggerignore:"true"`
 	// 資源UUID
@@ -56,6 +86,8 @@ type Field struct {
 	Role *string `json:"role,omitempty" form:"role" swaggerignore:"true"`
 	// 創建者
 	CreatedBy *string `json:"created_by,omitempty" form:"created_by" swaggerignore:"true"`
+	// 是否為情境的沙盒專案 (後端查詢用)
+	IsScenario *bool `json:"is_scenario,omitempty" swaggerignore:"true"`
 	// 搜尋欄位
 	Filter `json:"filter"`
 }
@@ -116,6 +148,10 @@ type List struct {
 		Client string `json:"client,omitempty"`
 		// 狀態
 		Status string `json:"status,omitempty"`
+		// 行事曆UUID
+		CalendarUUID string `json:"calendar_uuid,omitempty"`
+		// 是否依檢查清單的完成比例計算任務進度
+		ProgressFromChecklist bool `json:"progress_from_checklist"`
 		// 專案進度
 		Progress int64 `json:"progress"`
 		// 是否可編輯或刪除專案
@@ -166,6 +202,10 @@ type Single struct {
 	Client string `json:"client,omitempty"`
 	// 狀態
 	Status string `json:"status,omitempty"`
+	// 行事曆UUID
+	CalendarUUID string `json:"calendar_uuid,omitempty"`
+	// 是否依檢查清單的完成比例計算任務進度
+	ProgressFromChecklist bool `json:"progress_from_checklist"`
 	// 創建者
 	CreatedBy string `json:"created_by,omitempty"`
 	// 更新者
@@ -192,6 +232,10 @@ type Update struct {
 	Client *string `json:"client,omitempty"`
 	// 狀態
 	Status *string `json:"status,omitempty"`
+	// 行事曆UUID (空字串表示改用預設行事曆)
+	CalendarUUID *string `json:"calendar_uuid,omitempty"`
+	// 是否依檢查清單的完成比例計算任務進度
+	ProgressFromChecklist *bool `json:"progress_from_checklist,omitempty"`
 	//資源
 	Resource []*ProjectResource `json:"resource,omitempty"`
 	// 更新者
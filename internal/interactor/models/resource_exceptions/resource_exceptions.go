@@ -0,0 +1,110 @@
+package resource_exceptions
+
+import (
+	"gantt/internal/interactor/models/page"
+	"gantt/internal/interactor/models/section"
+	"time"
+)
+
+// Create struct is used to create achieves
+type Create struct {
+	// 資源UUID
+	ResourceUUID string `json:"resource_uuid,omitempty" binding:"required,uuid4" validate:"required,uuid4"`
+	// 名稱
+	Name string `json:"name,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty" binding:"required" validate:"required"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty" binding:"required" validate:"required"`
+	// 工作日 (空值表示請假，否則為兼職期間的工作日)
+	WorkWeeks []string `json:"workWeek,omitempty"`
+	// 工作日(陣列的字串型態)
+	WorkWeek string `json:"work_week,omitempty" swaggerignore:"true"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Field is structure file for search
+type Field struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 資源UUID
+	ResourceUUID *string `json:"resource_uuid,omitempty" form:"resource_uuid" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+	// 資源UUIDs (後端查詢用)
+	ResourceUUIDs []*string `json:"resource_uuids,omitempty" form:"resource_uuids" swaggerignore:"true"`
+}
+
+// Fields is the searched structure file (including pagination)
+type Fields struct {
+	// 搜尋結構檔
+	Field
+	// 分頁搜尋結構檔
+	page.Pagination
+}
+
+// List is multiple return structure files
+type List struct {
+	// 多筆
+	ResourceExceptions []*struct {
+		// 表ID
+		ID string `json:"id,omitempty"`
+		// 資源UUID
+		ResourceUUID string `json:"resource_uuid,omitempty"`
+		// 名稱
+		Name string `json:"name,omitempty"`
+		// 起始日期
+		StartDate *time.Time `json:"start_date,omitempty"`
+		// 結束日期
+		EndDate *time.Time `json:"end_date,omitempty"`
+		// 工作日
+		WorkWeeks []string `json:"workWeek,omitempty"`
+		// 創建者
+		CreatedBy string `json:"created_by,omitempty"`
+		// 更新者
+		UpdatedBy string `json:"updated_by,omitempty"`
+		// 時間戳記
+		section.TimeAt
+	} `json:"resource_exceptions"`
+	// 分頁返回結構檔
+	page.Total
+}
+
+// Single return structure file
+type Single struct {
+	// 表ID
+	ID string `json:"id,omitempty"`
+	// 資源UUID
+	ResourceUUID string `json:"resource_uuid,omitempty"`
+	// 名稱
+	Name string `json:"name,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// 工作日
+	WorkWeeks []string `json:"workWeek,omitempty"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty"`
+	// 更新者
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// 時間戳記
+	section.TimeAt
+}
+
+// Update struct is used to update achieves
+type Update struct {
+	// 表ID
+	ID string `json:"id,omitempty"  binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 名稱
+	Name *string `json:"name,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// 工作日 (空陣列表示改為請假)
+	WorkWeeks []*string `json:"workWeek,omitempty"`
+	// 工作日(陣列的字串型態)
+	WorkWeek *string `json:"work_week,omitempty" swaggerignore:"true"`
+	// 更新者
+	UpdatedBy *string `json:"updated_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
@@ -5,6 +5,7 @@ import (
 	"gantt/internal/interactor/models/page"
 	"gantt/internal/interactor/models/section"
 	"gantt/internal/interactor/models/sort"
+	"time"
 )
 
 // Create struct is used to create achieves
@@ -21,6 +22,8 @@ type Create struct {
 	TotalCost float64 `json:"total_cost,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
 	// 總負載
 	TotalLoad float64 `json:"total_load,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
+	// 最大單位 (百分比，預設100)
+	MaxUnits float64 `json:"max_units,omitempty" binding:"omitempty,gt=0" validate:"omitempty,gt=0"`
 	// 群組(後端寫入)
 	ResourceGroup string `json:"resource_group,omitempty" swaggerignore:"true"`
 	// 群組
@@ -105,10 +108,14 @@ type List struct {
 		TotalCost float64 `json:"total_cost,omitempty"`
 		// 總負載
 		TotalLoad float64 `json:"total_load,omitempty"`
+		// 最大單位 (百分比)
+		MaxUnits float64 `json:"max_units"`
 		// 群組
 		ResourceGroups []string `json:"resource_groups,omitempty"`
 		//
 		IsExpand bool `json:"is_expand,omitempty"`
+		// 是否過度配置
+		IsOverallocated bool `json:"is_overallocated"`
 		// 是否綁定
 		IsBind bool `json:"is_bind"`
 		// 是否可編輯或刪除資源
@@ -142,10 +149,16 @@ type Single struct {
 	TotalCost float64 `json:"total_cost,omitempty"`
 	// 總負載
 	TotalLoad float64 `json:"total_load,omitempty"`
+	// 最大單位 (百分比)
+	MaxUnits float64 `json:"max_units"`
 	// 群組
 	ResourceGroups []string `json:"resource_groups,omitempty"`
 	//
 	IsExpand bool `json:"is_expand,omitempty"`
+	// 是否過度配置
+	IsOverallocated bool `json:"is_overallocated"`
+	// 過度配置的期間
+	Overallocations []*Overallocation `json:"overallocations"`
 	// 是否綁定
 	IsBind bool `json:"is_bind"`
 	// 是否可編輯或刪除資源
@@ -174,6 +187,8 @@ type Update struct {
 	TotalCost *float64 `json:"total_cost,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
 	// 總負載
 	TotalLoad *float64 `json:"total_load,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
+	// 最大單位 (百分比)
+	MaxUnits *float64 `json:"max_units,omitempty" binding:"omitempty,gt=0" validate:"omitempty,gt=0"`
 	// 群組(後端寫入)
 	ResourceGroup *string `json:"resource_group,omitempty" swaggerignore:"true"`
 	// 群組
@@ -210,8 +225,12 @@ type TaskSingle struct {
 	IsExpand bool `json:"is_expand,omitempty"`
 	// 單位
 	Unit float64 `json:"unit,omitempty"`
+	// 是否過度配置
+	IsOverallocated bool `json:"is_overallocated,omitempty"`
 	// 專案角色
 	Role string `json:"role,omitempty"`
+	// 任務期間內資源不上班的工作日
+	UnavailableDates []time.Time `json:"unavailable_dates,omitempty"`
 }
 
 // Import struct is used to import the task file
@@ -223,3 +242,85 @@ type Import struct {
 	// 創建者
 	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
 }
+
+// Load struct is used to get the time-phased load of the resources across the projects
+type Load struct {
+	// 起始日期
+	StartDate time.Time `json:"start_date" form:"start_date" time_format:"2006-01-02" binding:"required" validate:"required"`
+	// 結束日期
+	EndDate time.Time `json:"end_date" form:"end_date" time_format:"2006-01-02" binding:"required" validate:"required"`
+	// 區間 (day、week，預設day)
+	Interval *string `json:"interval,omitempty" form:"interval" binding:"omitempty,oneof=day week" validate:"omitempty,oneof=day week"`
+	// 資源UUIDs
+	ResourceUUIDs []*string `json:"resource_uuids,omitempty" form:"resource_uuids" binding:"omitempty,dive,uuid4" validate:"omitempty,dive,uuid4"`
+	// 群組
+	ResourceGroups []string `json:"resource_groups,omitempty" form:"resource_groups"`
+}
+
+// LoadList return structure file for the time-phased load of the resources
+type LoadList struct {
+	// 起始日期
+	StartDate time.Time `json:"start_date"`
+	// 結束日期
+	EndDate time.Time `json:"end_date"`
+	// 區間
+	Interval string `json:"interval"`
+	// 多筆
+	Resources []*ResourceLoad `json:"resources"`
+}
+
+// ResourceLoad struct is the time-phased load of one resource
+type ResourceLoad struct {
+	// 表ID
+	ResourceUUID string `json:"resource_uuid,omitempty"`
+	// 名字
+	ResourceName string `json:"resource_name,omitempty"`
+	// 群組
+	ResourceGroups []string `json:"resource_groups,omitempty"`
+	// 分配工時合計
+	TotalHours float64 `json:"total_hours"`
+	// 各區間負載
+	Loads []*PeriodLoad `json:"loads"`
+}
+
+// PeriodLoad struct is the load of the resource in one period
+type PeriodLoad struct {
+	// 區間起始日期
+	StartDate time.Time `json:"start_date"`
+	// 區間結束日期
+	EndDate time.Time `json:"end_date"`
+	// 分配工時 (依單位及工作行事曆計算，跨專案加總)
+	Hours float64 `json:"hours"`
+	// 可用工時 (依預設工作行事曆及資源例外計算)
+	AvailableHours float64 `json:"available_hours"`
+}
+
+// Overallocation return structure file of the period in which the resource is assigned beyond its capacity
+type Overallocation struct {
+	// 資源UUID
+	ResourceUUID string `json:"resource_uuid,omitempty"`
+	// 資源名字
+	ResourceName string `json:"resource_name,omitempty"`
+	// 起始日期
+	StartDate time.Time `json:"start_date"`
+	// 結束日期
+	EndDate time.Time `json:"end_date"`
+	// 合計單位 (百分比)
+	Units float64 `json:"units"`
+	// 最大單位 (百分比)
+	MaxUnits float64 `json:"max_units"`
+	// 衝突的任務
+	Tasks []*OverallocatedTask `json:"tasks"`
+}
+
+// OverallocatedTask return structure file of the task in the over-allocation
+type OverallocatedTask struct {
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty"`
+}
@@ -0,0 +1,126 @@
+package task_checklist_items
+
+import (
+	"gantt/internal/interactor/models/section"
+	"time"
+)
+
+// Create struct is used to create achieves
+type Create struct {
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 標題
+	Title string `json:"title,omitempty" binding:"required" validate:"required"`
+	// 是否完成
+	IsDone bool `json:"is_done,omitempty"`
+	// 負責資源UUID
+	ResourceUUID *string `json:"resource_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+	// 到期日
+	DueDate *time.Time `json:"due_date,omitempty"`
+	// 排序 (後端依項目數量產生)
+	SortOrder int64 `json:"sort_order,omitempty" swaggerignore:"true"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
+
+// Field is structure file for search
+type Field struct {
+	// 表ID
+	ID *string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 任務UUID
+	TaskUUID *string `json:"task_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 任務UUIDs (後端查詢用)
+	TaskUUIDs []*string `json:"task_uuids,omitempty" swaggerignore:"true"`
+	// 負責資源UUID
+	ResourceUUID *string `json:"resource_uuid,omitempty" form:"resource_uuid" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+	// 是否完成
+	IsDone *bool `json:"is_done,omitempty" form:"is_done"`
+	// 更新者 (後端刪除用)
+	UpdatedBy *string `json:"updated_by,omitempty" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
+
+// List is multiple return structure files
+type List struct {
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 完成項目數
+	Done int64 `json:"done"`
+	// 項目總數
+	Total int64 `json:"total"`
+	// 完成百分比
+	Progress int64 `json:"progress"`
+	// 多筆
+	Items []*Single `json:"items"`
+}
+
+// Single return structure file
+type Single struct {
+	// 表ID
+	ID string `json:"id,omitempty"`
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 標題
+	Title string `json:"title,omitempty"`
+	// 是否完成
+	IsDone bool `json:"is_done"`
+	// 負責資源UUID
+	ResourceUUID *string `json:"resource_uuid,omitempty"`
+	// 負責資源名稱
+	ResourceName string `json:"resource_name,omitempty"`
+	// 到期日
+	DueDate *time.Time `json:"due_date,omitempty"`
+	// 排序
+	SortOrder int64 `json:"sort_order"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty"`
+	// 更新者
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// 時間戳記
+	section.TimeAt
+}
+
+// Update struct is used to update achieves
+type Update struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 標題
+	Title *string `json:"title,omitempty" binding:"omitempty,min=1" validate:"omitempty,min=1"`
+	// 是否完成
+	IsDone *bool `json:"is_done,omitempty"`
+	// 負責資源UUID (空字串表示移除負責資源)
+	ResourceUUID *string `json:"resource_uuid,omitempty"`
+	// 到期日 (傳入0001-01-01T00:00:00Z可移除到期日)
+	DueDate *time.Time `json:"due_date,omitempty"`
+	// 排序 (後端重新排序用)
+	SortOrder *int64 `json:"sort_order,omitempty" swaggerignore:"true"`
+	// 更新者
+	UpdatedBy *string `json:"updated_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
+
+// Reorder struct is used to reorder the checklist of the task
+type Reorder struct {
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 項目IDs (依新順序排列，須包含任務的全部項目)
+	IDs []string `json:"ids,omitempty" binding:"required,dive,uuid4" validate:"required,dive,uuid4"`
+	// 更新者
+	UpdatedBy string `json:"updated_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
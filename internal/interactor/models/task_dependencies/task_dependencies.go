@@ -0,0 +1,63 @@
+package task_dependencies
+
+import (
+	"gantt/internal/interactor/models/section"
+)
+
+// Create struct is used to create achieves
+type Create struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty" binding:"required,uuid4" validate:"required,uuid4"`
+	// 前置任務UUID
+	PredecessorUUID string `json:"predecessor_uuid,omitempty" binding:"required,uuid4" validate:"required,uuid4"`
+	// 後續任務UUID
+	SuccessorUUID string `json:"successor_uuid,omitempty" binding:"required,uuid4" validate:"required,uuid4"`
+	// 相依類型 (FS/SS/FF/SF)
+	Type string `json:"type,omitempty" binding:"required,oneof=FS SS FF SF" validate:"required,oneof=FS SS FF SF"`
+	// 延遲
+	Lag float64 `json:"lag"`
+	// 延遲單位 (d/h/m)
+	LagUnit string `json:"lag_unit,omitempty" binding:"required,oneof=d h m" validate:"required,oneof=d h m"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Field is structure file for search
+type Field struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 專案UUID
+	ProjectUUID *string `json:"project_uuid,omitempty" form:"project_uuid"`
+	// 前置任務UUID
+	PredecessorUUID *string `json:"predecessor_uuid,omitempty" form:"predecessor_uuid"`
+	// 後續任務UUID
+	SuccessorUUID *string `json:"successor_uuid,omitempty" form:"successor_uuid"`
+	// 任務UUIDs (後端批量刪除用）
+	TaskUUIDs []*string `json:"task_uuids,omitempty" form:"task_uuids" swaggerignore:"true"`
+	// 表IDs (後端批量刪除用）
+	IDs []*string `json:"ids,omitempty" form:"ids" swaggerignore:"true"`
+}
+
+// Single return structure file
+type Single struct {
+	// 表ID
+	ID string `json:"id,omitempty"`
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// 前置任務UUID
+	PredecessorUUID string `json:"predecessor_uuid,omitempty"`
+	// 後續任務UUID
+	SuccessorUUID string `json:"successor_uuid,omitempty"`
+	// 相依類型 (FS/SS/FF/SF)
+	Type string `json:"type,omitempty"`
+	// 延遲
+	Lag float64 `json:"lag"`
+	// 延遲單位 (d/h/m)
+	LagUnit string `json:"lag_unit,omitempty"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty"`
+	// 更新者
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// 時間戳記
+	section.TimeAt
+}
@@ -30,8 +30,20 @@ type Create struct {
 	BaselineDuration float64 `json:"baseline_duration,omitempty"`
 	// 期間
 	Duration float64 `json:"duration,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
+	// 樂觀期間 (三點估計須一併帶入，樂觀 <= 最可能 <= 悲觀)
+	OptimisticDuration *float64 `json:"optimistic_duration,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
+	// 最可能期間
+	MostLikelyDuration *float64 `json:"most_likely_duration,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
+	// 悲觀期間
+	PessimisticDuration *float64 `json:"pessimistic_duration,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
 	// 完成百分比
 	Progress int64 `json:"progress,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
+	// 狀態 (not_started、in_progress、blocked、on_hold、done、cancelled，空值依完成百分比判斷)
+	Status string `json:"status,omitempty" binding:"omitempty,oneof=not_started in_progress blocked on_hold done cancelled" validate:"omitempty,oneof=not_started in_progress blocked on_hold done cancelled"`
+	// 狀態變更者
+	StatusChangedBy *string `json:"status_changed_by,omitempty" swaggerignore:"true"`
+	// 狀態變更時間
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty" swaggerignore:"true"`
 	// 花費時間
 	Cost int64 `json:"cost,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
 	// 人力資源
@@ -48,6 +60,8 @@ type Create struct {
 	WebLink string `json:"web_link,omitempty"`
 	// 是否為任務
 	IsSubTask bool `json:"is_subtask,omitempty"`
+	// 是否為里程碑
+	IsMilestone bool `json:"is_milestone,omitempty"`
 	// 專案UUID
 	ProjectUUID string `json:"project_uuid,omitempty" binding:"required,uuid4" validate:"required,uuid4"`
 	// 子任務
@@ -62,6 +76,20 @@ type Create struct {
 	Indicator string `json:"indicator,omitempty" swaggerignore:"true"`
 	// 備註
 	Notes string `json:"notes,omitempty"`
+	// 限制類型 (ASAP、ALAP、SNET、FNLT、MSO、MFO)
+	ConstraintType string `json:"constraint_type,omitempty"`
+	// 限制日期 (SNET、FNLT、MSO、MFO必填)
+	ConstraintDate *time.Time `json:"constraint_date,omitempty"`
+	// 期限
+	Deadline *time.Time `json:"deadline,omitempty"`
+	// 優先順序 (0~1000，預設500，1000表示不調配資源)
+	Priority *int `json:"priority,omitempty" binding:"omitempty,min=0,max=1000" validate:"omitempty,min=0,max=1000"`
+	// 週期規則 (帶入時此任務為摘要任務，依規則產生週期任務為其子任務，起始日期必填)
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+	// 週期規則(物件的字串型態)
+	RecurrenceRule string `json:"recurrence_rule,omitempty" swaggerignore:"true"`
+	// 來源任務UUID (情境任務所複製的專案任務)
+	SourceTaskUUID *string `json:"source_task_uuid,omitempty" swaggerignore:"true"`
 	// 創建者
 	CreatedBy string `json:"created_by,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
 	// 資源UUID
@@ -112,6 +140,8 @@ type Field struct {
 	ProjectUUID *string `json:"project_uuid,omitempty" form:"project_uuid"`
 	// 多筆刪除任務及更新專案start_date及end_date用
 	DeletedTaskUUIDs []*string `json:"task_uuids,omitempty" form:"task_uuids"`
+	// 任務UUIDs (後端查詢用)
+	TaskUUIDs []*string `json:"task_uuid_list,omitempty" swaggerignore:"true"`
 	// 搜尋欄位
 	Filter `json:"filter"`
 }
@@ -126,7 +156,7 @@ type Fields struct {
 
 // Filter struct is used to store the search field
 type Filter struct {
-	// 是否有里程被
+	// 是否為里程碑
 	FilterMilestone bool `json:"is_milestone,omitempty"`
 }
 
@@ -168,10 +198,26 @@ type Single struct {
 	BaselineDuration float64 `json:"baseline_duration,omitempty"`
 	// 期間
 	Duration float64 `json:"duration,omitempty"`
+	// 樂觀期間
+	OptimisticDuration *float64 `json:"optimistic_duration,omitempty"`
+	// 最可能期間
+	MostLikelyDuration *float64 `json:"most_likely_duration,omitempty"`
+	// 悲觀期間
+	PessimisticDuration *float64 `json:"pessimistic_duration,omitempty"`
 	// 完成百分比
 	Progress int64 `json:"progress,omitempty"`
+	// 狀態 (not_started、in_progress、blocked、on_hold、done、cancelled)
+	Status string `json:"status,omitempty"`
+	// 狀態變更者
+	StatusChangedBy string `json:"status_changed_by,omitempty"`
+	// 狀態變更時間
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty"`
 	// 花費時間
 	Cost int64 `json:"cost,omitempty"`
+	// 實際工時 (核准的工時表加總)
+	ActualWork float64 `json:"actual_work"`
+	// 計畫工時 (核准工時表前的花費時間)
+	PlannedWork *float64 `json:"planned_work,omitempty"`
 	// 前任
 	Predecessor string `json:"predecessor,omitempty"`
 	// 1.1.2、1.2、1.2.1
@@ -184,6 +230,8 @@ type Single struct {
 	WebLink string `json:"web_link,omitempty"`
 	// 是否為任務
 	IsSubTask bool `json:"is_subtask,omitempty"`
+	// 是否為里程碑
+	IsMilestone bool `json:"is_milestone"`
 	// 專案UUID
 	ProjectUUID string `json:"project_uuid,omitempty"`
 	// 備註
@@ -196,6 +244,38 @@ type Single struct {
 	IndicatorsIconClass string `json:"indicatorsClass,omitempty"`
 	// 是否可編輯或刪除任務
 	IsEditable bool `json:"is_editable,omitempty"`
+	// 最早開始日期
+	EarlyStartDate *time.Time `json:"early_start_date,omitempty"`
+	// 最早完成日期
+	EarlyFinishDate *time.Time `json:"early_finish_date,omitempty"`
+	// 最晚開始日期
+	LateStartDate *time.Time `json:"late_start_date,omitempty"`
+	// 最晚完成日期
+	LateFinishDate *time.Time `json:"late_finish_date,omitempty"`
+	// 總浮時 (天)
+	TotalFloat *float64 `json:"total_float,omitempty"`
+	// 自由浮時 (天)
+	FreeFloat *float64 `json:"free_float,omitempty"`
+	// 是否為要徑任務
+	IsCritical bool `json:"is_critical,omitempty"`
+	// 限制類型 (ASAP、ALAP、SNET、FNLT、MSO、MFO)
+	ConstraintType string `json:"constraint_type,omitempty"`
+	// 限制日期
+	ConstraintDate *time.Time `json:"constraint_date,omitempty"`
+	// 期限
+	Deadline *time.Time `json:"deadline,omitempty"`
+	// 優先順序
+	Priority int `json:"priority"`
+	// 是否違反限制
+	IsConstraintViolated bool `json:"is_constraint_violated,omitempty"`
+	// 是否錯過期限
+	IsDeadlineMissed bool `json:"is_deadline_missed,omitempty"`
+	// 是否有資源過度配置
+	IsOverallocated bool `json:"is_overallocated,omitempty"`
+	// 週期規則
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+	// 來源任務UUID (情境任務所複製的專案任務)
+	SourceTaskUUID *string `json:"source_task_uuid,omitempty"`
 	// 創建者
 	CreatedBy string `json:"created_by,omitempty"`
 	// 更新者
@@ -214,10 +294,512 @@ type Single struct {
 	Files []*s3_files.Single `json:"files,omitempty"`
 }
 
+// Result return structure file of the task mutations
+type Result struct {
+	// 表ID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 連動移動的任務
+	MovedTasks []*MovedTask `json:"moved_tasks"`
+	// 資源不上班的警告
+	Warnings []*ResourceWarning `json:"warnings"`
+	// 資源過度配置的警告
+	Overallocations []*resources.Overallocation `json:"overallocations"`
+	// 重新彙總的摘要任務
+	SummaryTasks []*SummaryTask `json:"summary_tasks"`
+	// 大綱變更的任務
+	OutlineTasks []*OutlineTask `json:"outline_tasks,omitempty"`
+	// 複製產生的任務
+	DuplicatedTasks []*DuplicatedTask `json:"duplicated_tasks,omitempty"`
+	// 週期產生的任務
+	RecurringTasks []*RecurringTask `json:"recurring_tasks,omitempty"`
+}
+
+// RecurringTask return structure file of the occurrence created by the recurrence
+type RecurringTask struct {
+	// 表ID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `json:"outline_number,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty"`
+}
+
+// Recurrence struct is the rule of the recurring task
+type Recurrence struct {
+	// 頻率 (daily: 每N個工作天、weekly: 每N週、monthly: 每N個月)
+	Frequency string `json:"frequency,omitempty" binding:"required,oneof=daily weekly monthly" validate:"required,oneof=daily weekly monthly"`
+	// 間隔 (預設1)
+	Interval int `json:"interval,omitempty" binding:"omitempty,min=1,max=99" validate:"omitempty,min=1,max=99"`
+	// 星期 (weekly: 每週的星期，預設為起始日期的星期；monthly: 搭配week_of_month的星期，例如monday)
+	Weekdays []string `json:"weekdays,omitempty"`
+	// 每月的日期 (monthly，預設為起始日期的日期，超過月底時為月底)
+	DayOfMonth int `json:"day_of_month,omitempty" binding:"omitempty,min=1,max=31" validate:"omitempty,min=1,max=31"`
+	// 每月的第幾週 (monthly，1~4，-1表示最後一週)
+	WeekOfMonth int `json:"week_of_month,omitempty" binding:"omitempty,min=-1,max=4" validate:"omitempty,min=-1,max=4"`
+	// 次數 (與結束日期擇一必填，最多999次)
+	Count int `json:"count,omitempty" binding:"omitempty,min=1,max=999" validate:"omitempty,min=1,max=999"`
+	// 結束日期 (包含當天)
+	Until *time.Time `json:"until,omitempty"`
+	// 週期起始日期
+	StartDate *time.Time `json:"start_date,omitempty" swaggerignore:"true"`
+}
+
+// UpdateRecurrence struct is used to update the future occurrences of the recurring task
+type UpdateRecurrence struct {
+	// 表ID
+	TaskUUID string `json:"task_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 週期規則 (未填則沿用原規則)
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+	// 任務名稱 (未填則沿用原名稱)
+	TaskName *string `json:"task_name,omitempty"`
+	// 每次的期間 (未填則沿用最後一次的期間)
+	Duration *float64 `json:"duration,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
+	// 人力資源 (未填則沿用最後一次的資源)
+	Resources []*resources.TaskSingle `json:"resources,omitempty"`
+	// 生效日期 (此日期起尚未開始的週期任務依新規則重新產生，預設為今天)
+	EffectiveDate *time.Time `json:"effective_date,omitempty"`
+	// 更新者
+	UpdatedBy *string `json:"updated_by,omitempty" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
+
+// DuplicatedTask return structure file of the task created by the duplication
+type DuplicatedTask struct {
+	// 來源任務UUID
+	SourceTaskUUID string `json:"source_task_uuid,omitempty"`
+	// 表ID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `json:"outline_number,omitempty"`
+}
+
+// OutlineTask return structure file of the task whose outline is changed
+type OutlineTask struct {
+	// 表ID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `json:"outline_number,omitempty"`
+	// 是否為子任務
+	IsSubTask bool `json:"is_subtask"`
+	// 前任
+	Predecessor string `json:"predecessor"`
+}
+
+// Move struct is used to indent, outdent or move the task with its subtasks
+type Move struct {
+	// 表ID
+	TaskUUID string `json:"task_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 位置 (before: 目標任務之前、after: 目標任務之後、under: 目標任務的最後一個子任務)
+	Position string `json:"position,omitempty" binding:"required,oneof=before after under" validate:"required,oneof=before after under indent outdent"`
+	// 目標任務UUID
+	TargetUUID string `json:"target_uuid,omitempty" binding:"required,uuid4" validate:"omitempty,uuid4"`
+	// 更新者
+	UpdatedBy *string `json:"updated_by,omitempty" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
+
+// Duplicate struct is used to duplicate the task with its subtasks within or across projects
+type Duplicate struct {
+	// 表ID
+	TaskUUID string `json:"task_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 目標專案UUID (未填則為原專案)
+	ProjectUUID *string `json:"project_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+	// 位置 (before: 目標任務之前、after: 目標任務之後、under: 目標任務的最後一個子任務，預設after)
+	Position string `json:"position,omitempty" binding:"omitempty,oneof=before after under" validate:"omitempty,oneof=before after under"`
+	// 目標任務UUID (未填則置於專案最後)
+	TargetUUID *string `json:"target_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
+	// 是否複製附件
+	IncludeAttachments bool `json:"include_attachments,omitempty"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
+
+// Merge struct is used to merge the tasks of a scenario into its project
+type Merge struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty" swaggerignore:"true"`
+	// 情境專案UUID
+	ScenarioProjectUUID string `json:"scenario_project_uuid,omitempty" swaggerignore:"true"`
+	// 情境建立時間 (之後新增至專案的任務於合併時保留)
+	BranchedAt *time.Time `json:"branched_at,omitempty" swaggerignore:"true"`
+	// 更新者
+	UpdatedBy string `json:"updated_by,omitempty" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
+
+// MovedTask return structure file of the task moved by the rescheduling
+type MovedTask struct {
+	// 表ID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty"`
+}
+
+// SummaryTask return structure file of the summary task rolled up from its subtasks
+type SummaryTask struct {
+	// 表ID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// 期間
+	Duration float64 `json:"duration"`
+	// 花費
+	Cost int64 `json:"cost"`
+	// 完成百分比 (依子任務期間加權)
+	Progress int64 `json:"progress"`
+}
+
+// RollUp struct is used to roll the summary tasks of the project up from their subtasks
+type RollUp struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 更新者
+	UpdatedBy *string `json:"updated_by,omitempty" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
+
+// RollUpResult return structure file of the summary tasks repair
+type RollUpResult struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// 重新彙總的摘要任務
+	SummaryTasks []*SummaryTask `json:"summary_tasks"`
+}
+
+// ResourceWarning return structure file of the resource assigned on its non-working days
+type ResourceWarning struct {
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 資源UUID
+	ResourceUUID string `json:"resource_uuid,omitempty"`
+	// 資源名字
+	ResourceName string `json:"resource_name,omitempty"`
+	// 資源不上班的工作日
+	UnavailableDates []time.Time `json:"unavailable_dates"`
+}
+
+// CriticalPath return structure file
+type CriticalPath struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// 專案最早開始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 專案最早完成日期
+	FinishDate *time.Time `json:"finish_date,omitempty"`
+	// 要徑任務UUIDs
+	CriticalTasks []string `json:"critical_tasks"`
+	// 多筆
+	Tasks []*Single `json:"tasks"`
+}
+
+// Level struct is used to level the resources of the project
+type Level struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 模式 (preview: 預覽調配結果(預設)、commit: 套用調配結果)
+	Mode string `json:"mode,omitempty" binding:"omitempty,oneof=preview commit" validate:"omitempty,oneof=preview commit"`
+	// 更新者
+	UpdatedBy *string `json:"updated_by,omitempty" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
+
+// LevelResult return structure file of the resource leveling
+type LevelResult struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// 模式 (preview、commit)
+	Mode string `json:"mode"`
+	// 調配移動的任務
+	LeveledTasks []*LeveledTask `json:"leveled_tasks"`
+	// 連動移動的任務 (套用時)
+	MovedTasks []*MovedTask `json:"moved_tasks"`
+	// 重新彙總的摘要任務 (套用時)
+	SummaryTasks []*SummaryTask `json:"summary_tasks"`
+	// 資源不上班的警告 (套用時)
+	Warnings []*ResourceWarning `json:"warnings"`
+	// 調配後仍過度配置的資源
+	Overallocations []*resources.Overallocation `json:"overallocations"`
+}
+
+// LeveledTask return structure file of the task moved by the resource leveling
+type LeveledTask struct {
+	// 表ID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 是否為摘要任務 (日期由子任務彙總)
+	IsSummary bool `json:"is_summary"`
+	// 原起始日期
+	OriginalStartDate *time.Time `json:"original_start_date,omitempty"`
+	// 原結束日期
+	OriginalEndDate *time.Time `json:"original_end_date,omitempty"`
+	// 起始日期
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// 結束日期
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// 延後天數 (工作天)
+	Delay float64 `json:"delay"`
+}
+
+// EarnedValueField struct is used to get the earned value of the project
+type EarnedValueField struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 狀態日期 (空值表示今日)
+	StatusDate *time.Time `json:"status_date,omitempty" form:"status_date" time_format:"2006-01-02"`
+	// 使用者UUID
+	UserID *string `json:"user_id,omitempty" form:"user_id" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" form:"res_uuid" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" form:"role" swaggerignore:"true"`
+}
+
+// EarnedValue return structure file
+type EarnedValue struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// 狀態日期
+	StatusDate time.Time `json:"status_date"`
+	// 專案實獲值
+	Project *EarnedValueMetrics `json:"project"`
+	// 任務實獲值
+	Tasks []*TaskEarnedValue `json:"tasks"`
+}
+
+// EarnedValueMetrics struct is the earned value metrics as of the status date
+type EarnedValueMetrics struct {
+	// 完工預算 (BAC)
+	BAC float64 `json:"bac"`
+	// 計畫值 (PV)
+	PV float64 `json:"pv"`
+	// 實獲值 (EV)
+	EV float64 `json:"ev"`
+	// 實際成本 (AC)
+	AC float64 `json:"ac"`
+	// 時程差異 (SV = EV - PV)
+	SV float64 `json:"sv"`
+	// 成本差異 (CV = EV - AC)
+	CV float64 `json:"cv"`
+	// 時程績效指標 (SPI = EV / PV，PV為0時為空值)
+	SPI *float64 `json:"spi"`
+	// 成本績效指標 (CPI = EV / AC，AC為0時為空值)
+	CPI *float64 `json:"cpi"`
+	// 完工估算 (EAC)
+	EAC float64 `json:"eac"`
+	// 完工尚需估算 (ETC = EAC - AC)
+	ETC float64 `json:"etc"`
+}
+
+// TaskEarnedValue struct is the earned value of one task
+type TaskEarnedValue struct {
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `json:"outline_number,omitempty"`
+	// 是否為摘要任務 (數值由子任務彙總)
+	IsSummary bool `json:"is_summary"`
+	// 完成百分比
+	Progress int64 `json:"progress"`
+	// 實獲值
+	EarnedValueMetrics
+}
+
+// ForecastField struct is used to run the Monte Carlo simulation of the project
+type ForecastField struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 里程碑任務UUIDs (空值表示專案全部里程碑)
+	MilestoneUUIDs []string `json:"milestone_uuids,omitempty" form:"milestone_uuids" binding:"omitempty,dive,uuid4" validate:"omitempty,dive,uuid4"`
+	// 模擬次數 (預設1000，最高10000)
+	Iterations int `json:"iterations,omitempty" form:"iterations" binding:"omitempty,min=1,max=10000" validate:"omitempty,min=1,max=10000"`
+	// 亂數種子 (帶入相同種子可重現相同結果，空值表示隨機產生)
+	Seed *int64 `json:"seed,omitempty" form:"seed"`
+	// 使用者UUID
+	UserID *string `json:"user_id,omitempty" form:"user_id" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" form:"res_uuid" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" form:"role" swaggerignore:"true"`
+}
+
+// Forecast return structure file
+type Forecast struct {
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// 模擬次數
+	Iterations int `json:"iterations"`
+	// 亂數種子 (帶入相同種子可重現此結果)
+	Seed int64 `json:"seed"`
+	// 目前排程的完成日期
+	FinishDate *time.Time `json:"finish_date,omitempty"`
+	// 專案完成日期的機率分布
+	Project ForecastDates `json:"project"`
+	// 里程碑完成日期的機率分布
+	Milestones []*MilestoneForecast `json:"milestones"`
+	// 任務的三點估計及要徑指數
+	Tasks []*TaskForecast `json:"tasks"`
+}
+
+// ForecastDates struct is the finish dates reached with the probabilities
+type ForecastDates struct {
+	// 50%機率可完成的日期
+	P50 *time.Time `json:"p50,omitempty"`
+	// 80%機率可完成的日期
+	P80 *time.Time `json:"p80,omitempty"`
+	// 95%機率可完成的日期
+	P95 *time.Time `json:"p95,omitempty"`
+}
+
+// MilestoneForecast struct is the forecast of a milestone
+type MilestoneForecast struct {
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `json:"outline_number,omitempty"`
+	// 目前排程的日期
+	Date *time.Time `json:"date,omitempty"`
+	// 完成日期的機率分布
+	ForecastDates
+}
+
+// TaskForecast struct is the forecast of a task
+type TaskForecast struct {
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `json:"outline_number,omitempty"`
+	// 期間
+	Duration float64 `json:"duration"`
+	// 樂觀期間
+	OptimisticDuration *float64 `json:"optimistic_duration,omitempty"`
+	// 最可能期間
+	MostLikelyDuration *float64 `json:"most_likely_duration,omitempty"`
+	// 悲觀期間
+	PessimisticDuration *float64 `json:"pessimistic_duration,omitempty"`
+	// 期望期間 ((樂觀 + 4 * 最可能 + 悲觀) / 6)
+	ExpectedDuration *float64 `json:"expected_duration,omitempty"`
+	// 標準差 ((悲觀 - 樂觀) / 6)
+	StandardDeviation *float64 `json:"standard_deviation,omitempty"`
+	// 要徑指數 (模擬中為要徑任務的比例，0~1)
+	CriticalityIndex float64 `json:"criticality_index"`
+}
+
+// MilestoneField struct is used to get the milestones of the projects
+type MilestoneField struct {
+	// 專案UUIDs (空值表示可存取的全部專案)
+	ProjectUUIDs []string `json:"project_uuids,omitempty" form:"project_uuids" binding:"omitempty,dive,uuid4" validate:"omitempty,dive,uuid4"`
+	// 起始日期 (里程碑日期不早於此日)
+	StartDate *time.Time `json:"start_date,omitempty" form:"start_date" time_format:"2006-01-02"`
+	// 結束日期 (里程碑日期不晚於此日)
+	EndDate *time.Time `json:"end_date,omitempty" form:"end_date" time_format:"2006-01-02"`
+	// 狀態 due:未到期 achieved:已達成 late:逾期
+	Status *string `json:"status,omitempty" form:"status" binding:"omitempty,oneof=due achieved late" validate:"omitempty,oneof=due achieved late"`
+	// 使用者ID
+	UserID *string `json:"user_id,omitempty" form:"user_id" swaggerignore:"true"`
+	// 資源UUID
+	ResUUID *string `json:"res_uuid,omitempty" form:"res_uuid" swaggerignore:"true"`
+	// 角色
+	Role *string `json:"role,omitempty" form:"role" swaggerignore:"true"`
+}
+
+// MilestoneList return structure file
+type MilestoneList struct {
+	// 未到期數量
+	Due int `json:"due"`
+	// 已達成數量
+	Achieved int `json:"achieved"`
+	// 逾期數量
+	Late int `json:"late"`
+	// 里程碑 (依日期排序)
+	Milestones []*Milestone `json:"milestones"`
+}
+
+// Milestone struct is a milestone of the project and its status
+type Milestone struct {
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 前端編號 (非表ID)
+	TaskID string `json:"task_id,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string `json:"outline_number,omitempty"`
+	// 專案UUID
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// 專案名稱
+	ProjectName string `json:"project_name,omitempty"`
+	// 里程碑日期
+	Date *time.Time `json:"date,omitempty"`
+	// 基準線日期
+	BaselineDate *time.Time `json:"baseline_date,omitempty"`
+	// 期限
+	Deadline *time.Time `json:"deadline,omitempty"`
+	// 完成百分比
+	Progress int64 `json:"progress"`
+	// 狀態 due:未到期 achieved:已達成 late:逾期
+	Status string `json:"status"`
+}
+
 // Update struct is used to update achieves
 type Update struct {
 	// 表ID
 	TaskUUID string `json:"task_uuid,omitempty"  binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+	// 前端編號 (非表ID)
+	TaskID *string `json:"task_id,omitempty"`
 	// 任務名稱
 	TaskName *string `json:"task_name,omitempty"`
 	// 起始日期
@@ -232,10 +814,26 @@ type Update struct {
 	BaselineDuration *float64 `json:"baseline_duration,omitempty"`
 	// 期間
 	Duration *float64 `json:"duration,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
+	// 樂觀期間 (三點估計皆帶入0可移除估計)
+	OptimisticDuration *float64 `json:"optimistic_duration,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
+	// 最可能期間
+	MostLikelyDuration *float64 `json:"most_likely_duration,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
+	// 悲觀期間
+	PessimisticDuration *float64 `json:"pessimistic_duration,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
 	// 完成百分比
 	Progress *int64 `json:"progress,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
+	// 狀態 (not_started、in_progress、blocked、on_hold、done、cancelled)
+	Status *string `json:"status,omitempty" binding:"omitempty,oneof=not_started in_progress blocked on_hold done cancelled" validate:"omitempty,oneof=not_started in_progress blocked on_hold done cancelled"`
+	// 狀態變更者
+	StatusChangedBy *string `json:"status_changed_by,omitempty" swaggerignore:"true"`
+	// 狀態變更時間
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty" swaggerignore:"true"`
 	// 花費時間
 	Cost *int64 `json:"cost,omitempty" binding:"omitempty,gte=0" validate:"omitempty,gte=0"`
+	// 實際工時 (核准的工時表加總)
+	ActualWork *float64 `json:"actual_work,omitempty" swaggerignore:"true"`
+	// 計畫工時 (核准工時表前的花費時間)
+	PlannedWork *float64 `json:"planned_work,omitempty" swaggerignore:"true"`
 	// 人力資源
 	Resources []*resources.TaskSingle `json:"resources,omitempty"`
 	// 前任
@@ -250,20 +848,36 @@ type Update struct {
 	WebLink *string `json:"web_link,omitempty"`
 	// 是否為任務
 	IsSubTask *bool `json:"is_subtask,omitempty"`
+	// 是否為里程碑
+	IsMilestone *bool `json:"is_milestone,omitempty"`
 	// 專案UUID
 	ProjectUUID *string `json:"project_uuid,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4"`
 	// 備註
 	Notes *string `json:"notes,omitempty"`
+	// 限制類型 (ASAP、ALAP、SNET、FNLT、MSO、MFO)
+	ConstraintType *string `json:"constraint_type,omitempty"`
+	// 限制日期 (SNET、FNLT、MSO、MFO必填)
+	ConstraintDate *time.Time `json:"constraint_date,omitempty"`
+	// 期限 (傳入0001-01-01T00:00:00Z可移除期限)
+	Deadline *time.Time `json:"deadline,omitempty"`
+	// 優先順序 (0~1000，1000表示不調配資源)
+	Priority *int `json:"priority,omitempty" binding:"omitempty,min=0,max=1000" validate:"omitempty,min=0,max=1000"`
 	// 子任務
 	Subtask []*Update `json:"subtasks,omitempty"`
 	// 任務分段
 	Segments []*Segments `json:"segments,omitempty"`
 	// 任務分段(陣列的字串型態)
 	Segment *string `json:"segment,omitempty" swaggerignore:"true"`
+	// 是否移除任務分段 (後端更新用，未帶入segments時移除)
+	ClearSegment *bool `json:"clear_segment,omitempty" swaggerignore:"true"`
 	// 任務標示
 	Indicators []*Indicators `json:"indicators,omitempty"`
 	// 任務標示(陣列的字串型態)
 	Indicator *string `json:"indicator,omitempty" swaggerignore:"true"`
+	// 是否移除任務標示 (後端更新用，未帶入indicators時移除)
+	ClearIndicator *bool `json:"clear_indicator,omitempty" swaggerignore:"true"`
+	// 週期規則(物件的字串型態)
+	RecurrenceRule *string `json:"recurrence_rule,omitempty" swaggerignore:"true"`
 	// 更新者
 	UpdatedBy *string `json:"updated_by,omitempty" swaggerignore:"true"`
 	// 資源UUID
@@ -330,6 +944,8 @@ type DeletedTaskUUIDs struct {
 	Tasks []*string `json:"tasks,omitempty"`
 	// 專案UUID
 	ProjectUUID *string `json:"project_uuid,omitempty" binding:"required,uuid4" validate:"required,uuid4"`
+	// 更新者
+	UpdatedBy *string `json:"updated_by,omitempty" swaggerignore:"true"`
 	// 資源UUID
 	ResUUID *string `json:"res_uuid,omitempty" swaggerignore:"true"`
 	// 角色
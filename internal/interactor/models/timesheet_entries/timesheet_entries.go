@@ -0,0 +1,55 @@
+package timesheet_entries
+
+import (
+	"time"
+)
+
+// Create struct is used to create achieves
+type Create struct {
+	// 工時表ID
+	TimesheetID string `json:"timesheet_id,omitempty" swaggerignore:"true"`
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty" binding:"required,uuid4" validate:"required,uuid4"`
+	// 資源UUID
+	ResourceUUID string `json:"resource_uuid,omitempty" swaggerignore:"true"`
+	// 日期
+	Date *time.Time `json:"date,omitempty" binding:"required" validate:"required"`
+	// 工時
+	Hours float64 `json:"hours,omitempty" binding:"required,gt=0,lte=24" validate:"required,gt=0,lte=24"`
+	// 備註
+	Notes string `json:"notes,omitempty"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Field is structure file for search
+type Field struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 工時表ID
+	TimesheetID *string `json:"timesheet_id,omitempty" swaggerignore:"true"`
+	// 工時表IDs (後端查詢用)
+	TimesheetIDs []*string `json:"timesheet_ids,omitempty" swaggerignore:"true"`
+	// 工時表狀態 (後端查詢用)
+	TimesheetStatus *string `json:"timesheet_status,omitempty" swaggerignore:"true"`
+	// 使用者ID (後端查詢用)
+	UserID *string `json:"user_id,omitempty" swaggerignore:"true"`
+	// 任務UUID
+	TaskUUID *string `json:"task_uuid,omitempty" swaggerignore:"true"`
+	// 任務UUIDs (後端查詢用)
+	TaskUUIDs []*string `json:"task_uuids,omitempty" swaggerignore:"true"`
+	// 日期
+	Date *time.Time `json:"date,omitempty" swaggerignore:"true"`
+}
+
+// Update struct is used to update achieves
+type Update struct {
+	// 表ID
+	ID string `json:"id,omitempty"  binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 工時
+	Hours *float64 `json:"hours,omitempty" binding:"omitempty,gt=0,lte=24" validate:"omitempty,gt=0,lte=24"`
+	// 備註
+	Notes *string `json:"notes,omitempty"`
+	// 更新者
+	UpdatedBy *string `json:"updated_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
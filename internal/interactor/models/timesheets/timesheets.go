@@ -0,0 +1,174 @@
+package timesheets
+
+import (
+	"gantt/internal/interactor/models/page"
+	"gantt/internal/interactor/models/section"
+	"time"
+)
+
+// statuses of the timesheet
+const (
+	// 草稿
+	StatusDraft = "draft"
+	// 已送出
+	StatusSubmitted = "submitted"
+	// 已核准
+	StatusApproved = "approved"
+	// 已退回
+	StatusRejected = "rejected"
+)
+
+// Create struct is used to create achieves
+type Create struct {
+	// 使用者ID
+	UserID string `json:"user_id,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+	// 資源UUID
+	ResourceUUID string `json:"resource_uuid,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+	// 週起始日 (週一)
+	WeekStart *time.Time `json:"week_start,omitempty" binding:"required" validate:"required" swaggerignore:"true"`
+	// 狀態
+	Status string `json:"status,omitempty" swaggerignore:"true"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Field is structure file for search
+type Field struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 使用者ID
+	UserID *string `json:"user_id,omitempty" swaggerignore:"true"`
+	// 使用者IDs (後端查詢用)
+	UserIDs []*string `json:"user_ids,omitempty" swaggerignore:"true"`
+	// 週起始日 (週一)
+	WeekStart *time.Time `json:"week_start,omitempty" form:"week_start" time_format:"2006-01-02"`
+	// 狀態 (draft、submitted、approved、rejected)
+	Status *string `json:"status,omitempty" form:"status" binding:"omitempty,oneof=draft submitted approved rejected" validate:"omitempty,oneof=draft submitted approved rejected"`
+	// 角色
+	Role *string `json:"role,omitempty" swaggerignore:"true"`
+}
+
+// Fields is the searched structure file (including pagination)
+type Fields struct {
+	// 搜尋結構檔
+	Field
+	// 分頁搜尋結構檔
+	page.Pagination
+}
+
+// List is multiple return structure files
+type List struct {
+	// 多筆
+	Timesheets []*struct {
+		// 表ID
+		ID string `json:"id,omitempty"`
+		// 使用者ID
+		UserID string `json:"user_id,omitempty"`
+		// 使用者名稱
+		UserName string `json:"user_name,omitempty"`
+		// 資源UUID
+		ResourceUUID string `json:"resource_uuid,omitempty"`
+		// 週起始日 (週一)
+		WeekStart *time.Time `json:"week_start,omitempty"`
+		// 狀態 (draft、submitted、approved、rejected)
+		Status string `json:"status,omitempty"`
+		// 總工時
+		TotalHours float64 `json:"total_hours"`
+		// 送出時間
+		SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+		// 審核者
+		ReviewedBy string `json:"reviewed_by,omitempty"`
+		// 審核時間
+		ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+		// 審核意見
+		Comment string `json:"comment,omitempty"`
+		// 創建者
+		CreatedBy string `json:"created_by,omitempty"`
+		// 更新者
+		UpdatedBy string `json:"updated_by,omitempty"`
+		// 時間戳記
+		section.TimeAt
+	} `json:"timesheets"`
+	// 分頁返回結構檔
+	page.Total
+}
+
+// Single return structure file
+type Single struct {
+	// 表ID
+	ID string `json:"id,omitempty"`
+	// 使用者ID
+	UserID string `json:"user_id,omitempty"`
+	// 使用者名稱
+	UserName string `json:"user_name,omitempty"`
+	// 資源UUID
+	ResourceUUID string `json:"resource_uuid,omitempty"`
+	// 週起始日 (週一)
+	WeekStart *time.Time `json:"week_start,omitempty"`
+	// 狀態 (draft、submitted、approved、rejected)
+	Status string `json:"status,omitempty"`
+	// 總工時
+	TotalHours float64 `json:"total_hours"`
+	// 送出時間
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	// 審核者
+	ReviewedBy string `json:"reviewed_by,omitempty"`
+	// 審核時間
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// 審核意見
+	Comment string `json:"comment,omitempty"`
+	// 工時明細
+	Entries []*Entry `json:"entries"`
+	// 創建者
+	CreatedBy string `json:"created_by,omitempty"`
+	// 更新者
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// 時間戳記
+	section.TimeAt
+}
+
+// Entry struct is one entry of the timesheet
+type Entry struct {
+	// 表ID
+	ID string `json:"id,omitempty"`
+	// 任務UUID
+	TaskUUID string `json:"task_uuid,omitempty"`
+	// 任務名稱
+	TaskName string `json:"task_name,omitempty"`
+	// 日期
+	Date *time.Time `json:"date,omitempty"`
+	// 工時
+	Hours float64 `json:"hours"`
+	// 備註
+	Notes string `json:"notes,omitempty"`
+}
+
+// Update struct is used to update achieves
+type Update struct {
+	// 表ID
+	ID string `json:"id,omitempty"  binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 狀態
+	Status *string `json:"status,omitempty" swaggerignore:"true"`
+	// 送出時間
+	SubmittedAt *time.Time `json:"submitted_at,omitempty" swaggerignore:"true"`
+	// 審核者
+	ReviewedBy *string `json:"reviewed_by,omitempty" swaggerignore:"true"`
+	// 審核時間
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty" swaggerignore:"true"`
+	// 審核意見
+	Comment *string `json:"comment,omitempty" swaggerignore:"true"`
+	// 更新者
+	UpdatedBy *string `json:"updated_by,omitempty" binding:"required,uuid4" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Review struct is used to submit, approve or reject the timesheet
+type Review struct {
+	// 表ID
+	ID string `json:"id,omitempty" binding:"omitempty,uuid4" validate:"omitempty,uuid4" swaggerignore:"true"`
+	// 審核意見 (退回時必填)
+	Comment *string `json:"comment,omitempty"`
+	// 使用者ID
+	UserID string `json:"user_id,omitempty" swaggerignore:"true"`
+	// 角色
+	Role string `json:"role,omitempty" swaggerignore:"true"`
+}
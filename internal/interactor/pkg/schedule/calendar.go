@@ -0,0 +1,44 @@
+package schedule
+
+import (
+	"time"
+)
+
+// Calendar converts between dates and durations (in days) of the schedule.
+type Calendar interface {
+	// Add returns the date after the duration (negative to go backwards) from t.
+	Add(t time.Time, days float64) time.Time
+	// Days returns the duration between start and end, negative when end is before start.
+	Days(start, end time.Time) float64
+	// HoursPerDay returns the hours of one day, used to convert the lag in hours or minutes.
+	HoursPerDay() float64
+}
+
+// Continuous is the calendar where every day has 24 hours.
+var Continuous Calendar = continuous{}
+
+type continuous struct{}
+
+func (continuous) Add(t time.Time, days float64) time.Time {
+	return t.Add(time.Duration(days * float64(24*time.Hour)))
+}
+
+func (continuous) Days(start, end time.Time) float64 {
+	return end.Sub(start).Hours() / 24
+}
+
+func (continuous) HoursPerDay() float64 {
+	return 24
+}
+
+// LagDays returns the lag of the dependency in days of the calendar.
+func LagDays(calendar Calendar, lag float64, unit string) float64 {
+	switch unit {
+	case LagHour:
+		return lag / calendar.HoursPerDay()
+	case LagMinute:
+		return lag / calendar.HoursPerDay() / 60
+	default:
+		return lag
+	}
+}
@@ -0,0 +1,53 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var ErrInvalidChecklist = errors.New("invalid checklist")
+
+// ChecklistProgress returns the percent complete of a checklist with the done items out of the total ones,
+// rounded to the nearest percent. An empty checklist has no progress.
+func ChecklistProgress(done, total int64) int64 {
+	if total <= 0 {
+		return 0
+	}
+
+	return int64(math.Round(float64(done) * 100 / float64(total)))
+}
+
+// ResolveChecklistStatus returns the status and the progress of a task derived from the done items out of the total ones of its checklist,
+// going through the transitions as the progress changed by hand. The done or cancelled task is closed and keeps its status and progress
+// until it is reopened, as does the task without a checklist. The returned error wraps ErrInvalidStatus.
+func ResolveChecklistStatus(current string, currentProgress, done, total int64, transitions Transitions) (string, int64, error) {
+	if total <= 0 || current == StatusDone || current == StatusCancelled {
+		return current, currentProgress, nil
+	}
+
+	progress := ChecklistProgress(done, total)
+	return ResolveStatus(current, currentProgress, nil, &progress, transitions)
+}
+
+// CheckOrder checks the new order of the items of a checklist, which must list each of its items exactly once.
+// The returned error wraps ErrInvalidChecklist.
+func CheckOrder(items, order []string) error {
+	if len(order) != len(items) {
+		return fmt.Errorf("%w: the order must list the %d items of the checklist, got %d", ErrInvalidChecklist, len(items), len(order))
+	}
+
+	remaining := make(map[string]bool, len(items))
+	for _, item := range items {
+		remaining[item] = true
+	}
+
+	for _, item := range order {
+		if !remaining[item] {
+			return fmt.Errorf("%w: the item %s is not in the checklist or is listed twice", ErrInvalidChecklist, item)
+		}
+		delete(remaining, item)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestChecklistProgress(t *testing.T) {
+	tests := []struct {
+		done  int64
+		total int64
+		want  int64
+	}{
+		{done: 0, total: 0, want: 0},
+		{done: 0, total: 4, want: 0},
+		{done: 1, total: 3, want: 33},
+		{done: 2, total: 3, want: 67},
+		{done: 4, total: 4, want: 100},
+	}
+	for _, tt := range tests {
+		if got := ChecklistProgress(tt.done, tt.total); got != tt.want {
+			t.Errorf("ChecklistProgress(%d, %d) = %d, want %d", tt.done, tt.total, got, tt.want)
+		}
+	}
+}
+
+func TestResolveChecklistStatus(t *testing.T) {
+	tests := []struct {
+		name            string
+		current         string
+		currentProgress int64
+		done            int64
+		total           int64
+		transitions     map[string][]string
+		want            string
+		wantProgress    int64
+		wantErr         bool
+	}{
+		{name: "started by its first item", current: StatusNotStarted, done: 1, total: 4, want: StatusInProgress, wantProgress: 25},
+		{name: "blocked keeps its status", current: StatusBlocked, currentProgress: 25, done: 2, total: 4, want: StatusBlocked, wantProgress: 50},
+		{name: "all items done", current: StatusInProgress, currentProgress: 75, done: 4, total: 4, want: StatusInProgress, wantProgress: 100},
+		{name: "done keeps its progress", current: StatusDone, currentProgress: 100, done: 3, total: 4, want: StatusDone, wantProgress: 100},
+		{name: "cancelled keeps its progress", current: StatusCancelled, currentProgress: 20, done: 1, total: 4, want: StatusCancelled, wantProgress: 20},
+		{name: "without a checklist", current: StatusInProgress, currentProgress: 40, want: StatusInProgress, wantProgress: 40},
+		{name: "new task", done: 1, total: 2, want: StatusInProgress, wantProgress: 50},
+		{name: "start not allowed", current: StatusNotStarted, done: 1, total: 4, transitions: map[string][]string{StatusNotStarted: {StatusDone}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transitions, err := NewTransitions(tt.transitions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, gotProgress, err := ResolveChecklistStatus(tt.current, tt.currentProgress, tt.done, tt.total, transitions)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidStatus) {
+					t.Errorf("ResolveChecklistStatus() error = %v, want %v", err, ErrInvalidStatus)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want || gotProgress != tt.wantProgress {
+				t.Errorf("ResolveChecklistStatus() = %s, %d, want %s, %d", got, gotProgress, tt.want, tt.wantProgress)
+			}
+		})
+	}
+}
+
+func TestCheckOrder(t *testing.T) {
+	items := []string{"a", "b", "c"}
+	tests := []struct {
+		name    string
+		order   []string
+		wantErr bool
+	}{
+		{name: "same order", order: []string{"a", "b", "c"}},
+		{name: "reordered", order: []string{"c", "a", "b"}},
+		{name: "missing item", order: []string{"a", "b"}, wantErr: true},
+		{name: "unknown item", order: []string{"a", "b", "d"}, wantErr: true},
+		{name: "duplicated item", order: []string{"a", "a", "b"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckOrder(items, tt.order)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidChecklist) {
+					t.Errorf("CheckOrder() error = %v, want %v", err, ErrInvalidChecklist)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CheckOrder() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package schedule
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// constraint types
+const (
+	AsSoonAsPossible   = "ASAP"
+	AsLateAsPossible   = "ALAP"
+	StartNoEarlierThan = "SNET"
+	FinishNoLaterThan  = "FNLT"
+	MustStartOn        = "MSO"
+	MustFinishOn       = "MFO"
+)
+
+var (
+	ErrInvalidConstraint     = errors.New("invalid constraint type")
+	ErrMissingConstraintDate = errors.New("constraint date is required")
+)
+
+// constraintNames maps the abbreviations and the full names of the constraint types to their abbreviations.
+var constraintNames = map[string]string{
+	"asap":               AsSoonAsPossible,
+	"assoonaspossible":   AsSoonAsPossible,
+	"alap":               AsLateAsPossible,
+	"aslateaspossible":   AsLateAsPossible,
+	"snet":               StartNoEarlierThan,
+	"startnoearlierthan": StartNoEarlierThan,
+	"fnlt":               FinishNoLaterThan,
+	"finishnolaterthan":  FinishNoLaterThan,
+	"mso":                MustStartOn,
+	"muststarton":        MustStartOn,
+	"mfo":                MustFinishOn,
+	"mustfinishon":       MustFinishOn,
+}
+
+// ParseConstraint normalizes a constraint type (e.g. "snet" or "Start No Earlier Than") to its abbreviation.
+// An empty constraint type is As Soon As Possible.
+func ParseConstraint(constraintType string) (string, error) {
+	key := strings.ToLower(strings.Join(strings.Fields(constraintType), ""))
+	if key == "" {
+		return AsSoonAsPossible, nil
+	}
+
+	output, ok := constraintNames[key]
+	if !ok {
+		return "", ErrInvalidConstraint
+	}
+
+	return output, nil
+}
+
+// RequiresDate reports whether the constraint type needs a constraint date.
+func RequiresDate(constraintType string) bool {
+	switch constraintType {
+	case StartNoEarlierThan, FinishNoLaterThan, MustStartOn, MustFinishOn:
+		return true
+	default:
+		return false
+	}
+}
+
+// ConstraintViolated reports whether the dates of the activity break its constraint.
+func (a *Activity) ConstraintViolated() bool {
+	if a.ConstraintDate.IsZero() {
+		return false
+	}
+
+	switch a.ConstraintType {
+	case StartNoEarlierThan:
+		return !a.Start.IsZero() && a.Start.Before(a.ConstraintDate)
+	case FinishNoLaterThan:
+		return !a.End.IsZero() && a.End.After(a.ConstraintDate)
+	case MustStartOn:
+		return !a.Start.IsZero() && !a.Start.Equal(a.ConstraintDate)
+	case MustFinishOn:
+		return !a.End.IsZero() && !a.End.Equal(a.ConstraintDate)
+	default:
+		return false
+	}
+}
+
+// DeadlineMissed reports whether the activity finishes after its deadline.
+func (a *Activity) DeadlineMissed() bool {
+	return !a.Deadline.IsZero() && !a.End.IsZero() && a.End.After(a.Deadline)
+}
+
+// constrainStart is a helper function to apply the constraint of the activity to the earliest start allowed by its predecessors.
+func constrainStart(activity *Activity, start time.Time, span float64, calendar Calendar) time.Time {
+	if activity.ConstraintDate.IsZero() {
+		return start
+	}
+
+	switch activity.ConstraintType {
+	case StartNoEarlierThan:
+		if start.IsZero() || start.Before(activity.ConstraintDate) {
+			return activity.ConstraintDate
+		}
+	case MustStartOn:
+		return activity.ConstraintDate
+	case MustFinishOn:
+		return calendar.Add(activity.ConstraintDate, -span)
+	}
+
+	return start
+}
+
+// constrainFinish is a helper function to apply the constraint and the deadline of the activity to the latest finish allowed by its successors.
+func constrainFinish(activity *Activity, finish time.Time, span float64, calendar Calendar) time.Time {
+	if !activity.ConstraintDate.IsZero() {
+		switch activity.ConstraintType {
+		case FinishNoLaterThan:
+			if activity.ConstraintDate.Before(finish) {
+				finish = activity.ConstraintDate
+			}
+		case MustFinishOn:
+			finish = activity.ConstraintDate
+		case MustStartOn:
+			finish = calendar.Add(activity.ConstraintDate, span)
+		}
+	}
+
+	if !activity.Deadline.IsZero() && activity.Deadline.Before(finish) {
+		finish = activity.Deadline
+	}
+
+	return finish
+}
@@ -0,0 +1,133 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		err   error
+	}{
+		{input: "", want: AsSoonAsPossible},
+		{input: "snet", want: StartNoEarlierThan},
+		{input: "Finish No Later Than", want: FinishNoLaterThan},
+		{input: " MSO ", want: MustStartOn},
+		{input: "must finish on", want: MustFinishOn},
+		{input: "As Late As Possible", want: AsLateAsPossible},
+		{input: "soon", err: ErrInvalidConstraint},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseConstraint(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseConstraint(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseConstraint(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestActivityViolations(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n float64) time.Time {
+		return Continuous.Add(start, n)
+	}
+
+	tests := []struct {
+		name      string
+		activity  *Activity
+		violated  bool
+		deadlined bool
+	}{
+		{name: "snet kept", activity: &Activity{Start: day(2), End: day(3), ConstraintType: StartNoEarlierThan, ConstraintDate: day(2)}},
+		{name: "snet broken", activity: &Activity{Start: day(1), End: day(3), ConstraintType: StartNoEarlierThan, ConstraintDate: day(2)}, violated: true},
+		{name: "fnlt broken", activity: &Activity{Start: day(1), End: day(4), ConstraintType: FinishNoLaterThan, ConstraintDate: day(3)}, violated: true},
+		{name: "mso broken", activity: &Activity{Start: day(1), End: day(2), ConstraintType: MustStartOn, ConstraintDate: day(2)}, violated: true},
+		{name: "mfo kept", activity: &Activity{Start: day(1), End: day(2), ConstraintType: MustFinishOn, ConstraintDate: day(2)}},
+		{name: "alap ignores date", activity: &Activity{Start: day(1), End: day(2), ConstraintType: AsLateAsPossible, ConstraintDate: day(0)}},
+		{name: "deadline missed", activity: &Activity{Start: day(1), End: day(5), Deadline: day(4)}, deadlined: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.activity.ConstraintViolated(); got != tt.violated {
+				t.Errorf("ConstraintViolated() = %v, want %v", got, tt.violated)
+			}
+			if got := tt.activity.DeadlineMissed(); got != tt.deadlined {
+				t.Errorf("DeadlineMissed() = %v, want %v", got, tt.deadlined)
+			}
+		})
+	}
+}
+
+func TestCriticalPathConstraints(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n float64) time.Time {
+		return Continuous.Add(start, n)
+	}
+
+	activities := []*Activity{
+		{UUID: "a", TaskID: "1", OutlineNumber: "1", Start: day(0), End: day(2)},
+		{UUID: "b", TaskID: "2", OutlineNumber: "2", Predecessor: "1", Start: day(4), End: day(5), ConstraintType: StartNoEarlierThan, ConstraintDate: day(4)},
+		{UUID: "c", TaskID: "3", OutlineNumber: "3", Predecessor: "1", Start: day(2), End: day(5), Deadline: day(4)},
+	}
+
+	dependencies, err := Link(activities)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := CriticalPath(activities, dependencies, Continuous)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := result.Timings["b"].EarlyStart; !got.Equal(day(4)) {
+		t.Errorf("b EarlyStart = %v, want %v", got, day(4))
+	}
+
+	// the deadline of c is one day before its early finish
+	if got := result.Timings["c"].TotalFloat; got != -1 {
+		t.Errorf("c TotalFloat = %v, want -1", got)
+	}
+	if !result.Timings["c"].IsCritical {
+		t.Errorf("c IsCritical = false, want true")
+	}
+}
+
+func TestRescheduleConstraints(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n float64) time.Time {
+		return Continuous.Add(start, n)
+	}
+
+	// task 1 has been extended by 2 days
+	activities := []*Activity{
+		{UUID: "a", TaskID: "1", OutlineNumber: "1", Start: day(0), End: day(4)},
+		{UUID: "b", TaskID: "2", OutlineNumber: "2", Predecessor: "1", Start: day(2), End: day(3), ConstraintType: MustStartOn, ConstraintDate: day(2)},
+		{UUID: "c", TaskID: "3", OutlineNumber: "3", Predecessor: "1", Start: day(2), End: day(3), ConstraintType: AsLateAsPossible},
+		{UUID: "d", TaskID: "4", OutlineNumber: "4", Predecessor: "3", Start: day(6), End: day(7), ConstraintType: StartNoEarlierThan, ConstraintDate: day(6)},
+	}
+
+	dependencies, err := Link(activities)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	moved, err := Reschedule(activities, dependencies, []string{"a"}, Continuous)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(moved) != 1 || moved[0].UUID != "c" {
+		t.Fatalf("Reschedule() moved %v, want only c", moved)
+	}
+	if !moved[0].Start.Equal(day(5)) || !moved[0].End.Equal(day(6)) {
+		t.Errorf("Reschedule() moved c to %v - %v, want %v - %v", moved[0].Start, moved[0].End, day(5), day(6))
+	}
+}
@@ -0,0 +1,234 @@
+package schedule
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// epsilon is the tolerance (in days) under which a float is considered as zero.
+const epsilon = 1e-6
+
+// Timing is the result of the critical path method for one activity.
+type Timing struct {
+	// 最早開始日期
+	EarlyStart time.Time
+	// 最早完成日期
+	EarlyFinish time.Time
+	// 最晚開始日期
+	LateStart time.Time
+	// 最晚完成日期
+	LateFinish time.Time
+	// 總浮時 (天)
+	TotalFloat float64
+	// 自由浮時 (天)
+	FreeFloat float64
+	// 是否為要徑任務
+	IsCritical bool
+}
+
+// Result is the result of the critical path method for a project.
+type Result struct {
+	// 專案最早開始日期
+	Start time.Time
+	// 專案最早完成日期
+	Finish time.Time
+	// 各任務的計算結果 (key: 任務UUID)
+	Timings map[string]*Timing
+}
+
+// CriticalPath runs a forward and a backward pass over the activities and their dependencies.
+// Summary activities are scheduled through their leaves, and their timings are rolled up from their children.
+// The constraints and deadlines of the activities bound their early and late dates, so a negative total float means a conflict.
+func CriticalPath(activities []*Activity, dependencies []*Dependency, calendar Calendar) (*Result, error) {
+	result := &Result{
+		Timings: make(map[string]*Timing),
+	}
+
+	o := newOutline(activities)
+	activityMap := make(map[string]*Activity)
+	var leaves []string
+	for _, activity := range activities {
+		activityMap[activity.UUID] = activity
+		if !o.isSummary(activity.UUID) {
+			leaves = append(leaves, activity.UUID)
+		}
+
+		if !activity.Start.IsZero() && (result.Start.IsZero() || activity.Start.Before(result.Start)) {
+			result.Start = activity.Start
+		}
+	}
+
+	if result.Start.IsZero() {
+		return result, nil
+	}
+
+	graph := NewGraph(leaves, o.expand(dependencies))
+	order, err := graph.Sort()
+	if err != nil {
+		return nil, err
+	}
+
+	durations := make(map[string]float64)
+	for _, uuid := range order {
+		durations[uuid] = duration(activityMap[uuid], calendar)
+	}
+
+	// forward pass
+	for _, uuid := range order {
+		activity := activityMap[uuid]
+		timing := &Timing{}
+		predecessors := graph.Predecessors(uuid)
+		if len(predecessors) == 0 {
+			timing.EarlyStart = activity.Start
+			if timing.EarlyStart.IsZero() {
+				timing.EarlyStart = result.Start
+			}
+		}
+
+		for i, dep := range predecessors {
+			predecessor := result.Timings[dep.PredecessorUUID]
+			lag := LagDays(calendar, dep.Lag, dep.LagUnit)
+			var start time.Time
+			switch dep.Type {
+			case StartToStart:
+				start = calendar.Add(predecessor.EarlyStart, lag)
+			case FinishToFinish:
+				start = calendar.Add(calendar.Add(predecessor.EarlyFinish, lag), -durations[uuid])
+			case StartToFinish:
+				start = calendar.Add(calendar.Add(predecessor.EarlyStart, lag), -durations[uuid])
+			default:
+				start = calendar.Add(predecessor.EarlyFinish, lag)
+			}
+
+			if i == 0 || start.After(timing.EarlyStart) {
+				timing.EarlyStart = start
+			}
+		}
+
+		timing.EarlyStart = constrainStart(activity, timing.EarlyStart, durations[uuid], calendar)
+		timing.EarlyFinish = calendar.Add(timing.EarlyStart, durations[uuid])
+		if timing.EarlyFinish.After(result.Finish) {
+			result.Finish = timing.EarlyFinish
+		}
+
+		result.Timings[uuid] = timing
+	}
+
+	// backward pass
+	for i := len(order) - 1; i >= 0; i-- {
+		uuid := order[i]
+		timing := result.Timings[uuid]
+		successors := graph.Successors(uuid)
+		if len(successors) == 0 {
+			timing.LateFinish = result.Finish
+			timing.FreeFloat = calendar.Days(timing.EarlyFinish, result.Finish)
+		}
+
+		for j, dep := range successors {
+			successor := result.Timings[dep.SuccessorUUID]
+			lag := LagDays(calendar, dep.Lag, dep.LagUnit)
+			var finish time.Time
+			var free float64
+			switch dep.Type {
+			case StartToStart:
+				finish = calendar.Add(calendar.Add(successor.LateStart, -lag), durations[uuid])
+				free = calendar.Days(calendar.Add(timing.EarlyStart, lag), successor.EarlyStart)
+			case FinishToFinish:
+				finish = calendar.Add(successor.LateFinish, -lag)
+				free = calendar.Days(calendar.Add(timing.EarlyFinish, lag), successor.EarlyFinish)
+			case StartToFinish:
+				finish = calendar.Add(calendar.Add(successor.LateFinish, -lag), durations[uuid])
+				free = calendar.Days(calendar.Add(timing.EarlyStart, lag), successor.EarlyFinish)
+			default:
+				finish = calendar.Add(successor.LateStart, -lag)
+				free = calendar.Days(calendar.Add(timing.EarlyFinish, lag), successor.EarlyStart)
+			}
+
+			if j == 0 || finish.Before(timing.LateFinish) {
+				timing.LateFinish = finish
+			}
+
+			if j == 0 || free < timing.FreeFloat {
+				timing.FreeFloat = free
+			}
+		}
+
+		timing.LateFinish = constrainFinish(activityMap[uuid], timing.LateFinish, durations[uuid], calendar)
+		timing.LateStart = calendar.Add(timing.LateFinish, -durations[uuid])
+		timing.TotalFloat = round(calendar.Days(timing.EarlyStart, timing.LateStart))
+		timing.FreeFloat = round(timing.FreeFloat)
+		timing.IsCritical = timing.TotalFloat <= epsilon
+	}
+
+	rollUp(activities, o, result.Timings)
+	return result, nil
+}
+
+// duration is a helper function to get the duration of the activity from its dates, or from its duration when the dates are missing.
+func duration(activity *Activity, calendar Calendar) float64 {
+	if !activity.Start.IsZero() && !activity.End.IsZero() && activity.End.After(activity.Start) {
+		return calendar.Days(activity.Start, activity.End)
+	}
+
+	if activity.Duration > 0 && (activity.Start.IsZero() || activity.End.IsZero()) {
+		return activity.Duration
+	}
+
+	return 0
+}
+
+// rollUp is a helper function to derive the timings of the summary activities from their children, from the deepest level up.
+func rollUp(activities []*Activity, o *outline, timings map[string]*Timing) {
+	var summaries []string
+	for _, activity := range activities {
+		if o.isSummary(activity.UUID) {
+			summaries = append(summaries, activity.UUID)
+		}
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return o.depth[summaries[i]] > o.depth[summaries[j]]
+	})
+
+	for _, uuid := range summaries {
+		var timing *Timing
+		for _, child := range o.children[uuid] {
+			childTiming, ok := timings[child]
+			if !ok {
+				continue
+			}
+
+			if timing == nil {
+				copied := *childTiming
+				timing = &copied
+				continue
+			}
+
+			if childTiming.EarlyStart.Before(timing.EarlyStart) {
+				timing.EarlyStart = childTiming.EarlyStart
+			}
+			if childTiming.EarlyFinish.After(timing.EarlyFinish) {
+				timing.EarlyFinish = childTiming.EarlyFinish
+			}
+			if childTiming.LateStart.Before(timing.LateStart) {
+				timing.LateStart = childTiming.LateStart
+			}
+			if childTiming.LateFinish.After(timing.LateFinish) {
+				timing.LateFinish = childTiming.LateFinish
+			}
+			timing.TotalFloat = math.Min(timing.TotalFloat, childTiming.TotalFloat)
+			timing.FreeFloat = math.Min(timing.FreeFloat, childTiming.FreeFloat)
+			timing.IsCritical = timing.IsCritical || childTiming.IsCritical
+		}
+
+		if timing != nil {
+			timings[uuid] = timing
+		}
+	}
+}
+
+// round is a helper function to round the float to avoid noises of the date arithmetic.
+func round(days float64) float64 {
+	return math.Round(days*1e4) / 1e4
+}
@@ -0,0 +1,56 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCriticalPath(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n float64) time.Time {
+		return Continuous.Add(start, n)
+	}
+
+	activities := []*Activity{
+		{UUID: "a", TaskID: "1", OutlineNumber: "1", Start: day(0), End: day(2)},
+		{UUID: "s", TaskID: "2", OutlineNumber: "2", Start: day(2), End: day(5)},
+		{UUID: "b", TaskID: "3", OutlineNumber: "2.1", Predecessor: "1", Start: day(2), End: day(5)},
+		{UUID: "c", TaskID: "4", OutlineNumber: "2.2", Predecessor: "1FS+0.5d", Start: day(2), End: day(3)},
+		{UUID: "d", TaskID: "5", OutlineNumber: "3", Predecessor: "3,4", Start: day(5), End: day(6)},
+	}
+
+	dependencies, err := Link(activities)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := CriticalPath(activities, dependencies, Continuous)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !result.Finish.Equal(day(6)) {
+		t.Errorf("Finish = %v, want %v", result.Finish, day(6))
+	}
+
+	tests := []struct {
+		uuid       string
+		totalFloat float64
+		freeFloat  float64
+		isCritical bool
+	}{
+		{uuid: "a", isCritical: true},
+		{uuid: "b", isCritical: true},
+		{uuid: "c", totalFloat: 1.5, freeFloat: 1.5},
+		{uuid: "d", isCritical: true},
+		{uuid: "s", isCritical: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uuid, func(t *testing.T) {
+			timing := result.Timings[tt.uuid]
+			if timing.TotalFloat != tt.totalFloat || timing.FreeFloat != tt.freeFloat || timing.IsCritical != tt.isCritical {
+				t.Errorf("CriticalPath() = %+v, want total float %v, free float %v, critical %v", timing, tt.totalFloat, tt.freeFloat, tt.isCritical)
+			}
+		})
+	}
+}
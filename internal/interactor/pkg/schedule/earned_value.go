@@ -0,0 +1,203 @@
+package schedule
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// EarnedValue is the earned value of an activity as of the status date.
+type EarnedValue struct {
+	UUID          string
+	OutlineNumber string
+	// BaselineStart and BaselineFinish are the planned dates the budget is spread over.
+	BaselineStart  time.Time
+	BaselineFinish time.Time
+	// Budget is the planned cost of the activity in the baseline.
+	Budget float64
+	// ActualCost is the cost of the work actually performed on the activity.
+	ActualCost float64
+	// Progress is the percentage of the activity which is complete.
+	Progress  float64
+	IsSummary bool
+	// BAC is the budget at completion.
+	BAC float64
+	// PV is the planned value (BCWS).
+	PV float64
+	// EV is the earned value (BCWP).
+	EV float64
+	// AC is the actual cost (ACWP).
+	AC float64
+	// SV is the schedule variance.
+	SV float64
+	// CV is the cost variance.
+	CV float64
+	// SPI and CPI are missing when their denominator is zero.
+	SPI *float64
+	CPI *float64
+	// EAC is the estimate at completion.
+	EAC float64
+	// ETC is the estimate to complete.
+	ETC float64
+}
+
+// EarnedValues computes the earned values of the activities as of the status date, rolling the values of
+// the summary activities up from their children, and returns the earned value of the whole project.
+func EarnedValues(values []*EarnedValue, status time.Time, calendar Calendar) *EarnedValue {
+	if calendar == nil {
+		calendar = Continuous
+	}
+
+	activities := make([]*Activity, len(values))
+	valueMap := make(map[string]*EarnedValue, len(values))
+	for i, value := range values {
+		activities[i] = &Activity{UUID: value.UUID, OutlineNumber: value.OutlineNumber}
+		valueMap[value.UUID] = value
+	}
+
+	o := newOutline(activities)
+	project := &EarnedValue{IsSummary: true}
+	summaries := make([]*EarnedValue, 0)
+	for _, value := range values {
+		if o.isSummary(value.UUID) {
+			summaries = append(summaries, value)
+			continue
+		}
+
+		value.IsSummary = false
+		value.BAC = value.Budget
+		value.PV = value.Budget * plannedRatio(value, status, calendar)
+		value.EV = value.Budget * clampRatio(value.Progress/100)
+		value.AC = value.ActualCost
+		value.assemble()
+
+		project.BAC += value.BAC
+		project.PV += value.PV
+		project.EV += value.EV
+		project.AC += value.AC
+	}
+
+	// the deepest summaries first, so that every child has been rolled up before its parent
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return o.depth[summaries[i].UUID] > o.depth[summaries[j].UUID]
+	})
+
+	for _, summary := range summaries {
+		summary.IsSummary = true
+		summary.BAC, summary.PV, summary.EV, summary.AC = 0, 0, 0, 0
+		for _, uuid := range o.children[summary.UUID] {
+			child := valueMap[uuid]
+			summary.BAC += child.BAC
+			summary.PV += child.PV
+			summary.EV += child.EV
+			summary.AC += child.AC
+		}
+
+		summary.assemble()
+	}
+
+	project.assemble()
+	return project
+}
+
+// ResourceRate is a resource assigned to an activity, with its hourly standard cost and its units in percent.
+type ResourceRate struct {
+	StandardCost float64
+	Unit         float64
+}
+
+// Pricing prices the hours of work on an activity with the standard costs of its resources.
+type Pricing struct {
+	// Rate is the average cost of an hour of work, weighted by the units of the resources.
+	Rate float64
+	// Units is the number of full-time resources working on the activity.
+	Units       float64
+	HoursPerDay float64
+}
+
+// NewPricing returns the pricing of the work on an activity with the rates of its resources.
+func NewPricing(rates []ResourceRate, hoursPerDay float64) Pricing {
+	pricing := Pricing{HoursPerDay: hoursPerDay}
+	var cost float64
+	for _, rate := range rates {
+		pricing.Units += rate.Unit / 100
+		cost += rate.StandardCost * rate.Unit / 100
+	}
+
+	if pricing.Units > 0 {
+		pricing.Rate = cost / pricing.Units
+	}
+
+	return pricing
+}
+
+// Budget returns the cost of the planned work, which is the planned hours when they are recorded and
+// the duration in days worked by the resources otherwise.
+func (p Pricing) Budget(hours, duration float64) float64 {
+	if hours <= 0 {
+		hours = duration * p.HoursPerDay * p.Units
+	}
+
+	return hours * p.Rate
+}
+
+// ActualCost returns the cost of the hours of work actually performed.
+func (p Pricing) ActualCost(actualWork float64) float64 {
+	return actualWork * p.Rate
+}
+
+// assemble is a helper function to derive the variances, indexes and estimates from the planned value, earned value and actual cost.
+func (v *EarnedValue) assemble() {
+	v.BAC, v.PV, v.EV, v.AC = roundValue(v.BAC), roundValue(v.PV), roundValue(v.EV), roundValue(v.AC)
+	v.SV = roundValue(v.EV - v.PV)
+	v.CV = roundValue(v.EV - v.AC)
+	v.SPI, v.CPI = nil, nil
+	if v.PV > 0 {
+		spi := roundValue(v.EV / v.PV)
+		v.SPI = &spi
+	}
+
+	// the remaining work is assumed to be performed at the cost efficiency so far
+	cpi := 1.0
+	if v.AC > 0 {
+		cpi = v.EV / v.AC
+		rounded := roundValue(cpi)
+		v.CPI = &rounded
+	}
+
+	v.EAC = v.AC + v.BAC - v.EV
+	if cpi > 0 {
+		v.EAC = v.AC + (v.BAC-v.EV)/cpi
+	}
+
+	v.EAC = roundValue(v.EAC)
+	v.ETC = roundValue(v.EAC - v.AC)
+}
+
+// plannedRatio is a helper function to get the ratio of the baseline which should be complete as of the status date.
+func plannedRatio(value *EarnedValue, status time.Time, calendar Calendar) float64 {
+	if value.BaselineStart.IsZero() || value.BaselineFinish.IsZero() || !status.After(value.BaselineStart) {
+		return 0
+	}
+
+	if !status.Before(value.BaselineFinish) {
+		return 1
+	}
+
+	total := calendar.Days(value.BaselineStart, value.BaselineFinish)
+	if total <= 0 {
+		return 1
+	}
+
+	return clampRatio(calendar.Days(value.BaselineStart, status) / total)
+}
+
+// clampRatio is a helper function to keep the ratio between 0 and 1.
+func clampRatio(ratio float64) float64 {
+	return math.Max(0, math.Min(1, ratio))
+}
+
+// roundValue is a helper function to round the value to 2 decimal places.
+func roundValue(value float64) float64 {
+	return math.Round(value*100) / 100
+}
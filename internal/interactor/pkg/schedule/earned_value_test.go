@@ -0,0 +1,84 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEarnedValues(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n float64) time.Time {
+		return Continuous.Add(start, n)
+	}
+
+	values := []*EarnedValue{
+		{UUID: "s", OutlineNumber: "1"},
+		{UUID: "a", OutlineNumber: "1.1", BaselineStart: day(0), BaselineFinish: day(4), Budget: 400, ActualCost: 500, Progress: 100},
+		{UUID: "b", OutlineNumber: "1.2", BaselineStart: day(4), BaselineFinish: day(8), Budget: 200, ActualCost: 50, Progress: 25},
+		{UUID: "c", OutlineNumber: "2", BaselineStart: day(10), BaselineFinish: day(12), Budget: 100},
+	}
+	project := EarnedValues(values, day(6), Continuous)
+
+	tests := []struct {
+		name  string
+		value *EarnedValue
+		want  EarnedValue
+		spi   *float64
+		cpi   *float64
+	}{
+		{name: "finished over budget", value: values[1], want: EarnedValue{BAC: 400, PV: 400, EV: 400, AC: 500, SV: 0, CV: -100, EAC: 500, ETC: 0}, spi: ptr(1), cpi: ptr(0.8)},
+		{name: "behind schedule", value: values[2], want: EarnedValue{BAC: 200, PV: 100, EV: 50, AC: 50, SV: -50, CV: 0, EAC: 200, ETC: 150}, spi: ptr(0.5), cpi: ptr(1)},
+		{name: "not started", value: values[3], want: EarnedValue{BAC: 100, EAC: 100, ETC: 100}},
+		{name: "summary", value: values[0], want: EarnedValue{BAC: 600, PV: 500, EV: 450, AC: 550, SV: -50, CV: -100, EAC: 733.33, ETC: 183.33}, spi: ptr(0.9), cpi: ptr(0.82)},
+		{name: "project", value: project, want: EarnedValue{BAC: 700, PV: 500, EV: 450, AC: 550, SV: -50, CV: -100, EAC: 855.56, ETC: 305.56}, spi: ptr(0.9), cpi: ptr(0.82)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.value
+			if got.BAC != tt.want.BAC || got.PV != tt.want.PV || got.EV != tt.want.EV || got.AC != tt.want.AC {
+				t.Errorf("BAC, PV, EV, AC = %v, %v, %v, %v, want %v, %v, %v, %v", got.BAC, got.PV, got.EV, got.AC, tt.want.BAC, tt.want.PV, tt.want.EV, tt.want.AC)
+			}
+			if got.SV != tt.want.SV || got.CV != tt.want.CV {
+				t.Errorf("SV, CV = %v, %v, want %v, %v", got.SV, got.CV, tt.want.SV, tt.want.CV)
+			}
+			if got.EAC != tt.want.EAC || got.ETC != tt.want.ETC {
+				t.Errorf("EAC, ETC = %v, %v, want %v, %v", got.EAC, got.ETC, tt.want.EAC, tt.want.ETC)
+			}
+			assertVariance(t, "SPI", got.SPI, tt.spi)
+			assertVariance(t, "CPI", got.CPI, tt.cpi)
+		})
+	}
+}
+
+func TestPricing(t *testing.T) {
+	// a full-time resource at 100 an hour and a half-time one at 40 an hour
+	pricing := NewPricing([]ResourceRate{{StandardCost: 100, Unit: 100}, {StandardCost: 40, Unit: 50}}, 8)
+
+	tests := []struct {
+		name       string
+		hours      float64
+		duration   float64
+		actualWork float64
+		budget     float64
+		actualCost float64
+	}{
+		{name: "recorded hours", hours: 30, duration: 10, actualWork: 12, budget: 2400, actualCost: 960},
+		{name: "hours from the duration", duration: 2, budget: 1920},
+		{name: "nothing planned"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roundValue(pricing.Budget(tt.hours, tt.duration)); got != tt.budget {
+				t.Errorf("Budget(%v, %v) = %v, want %v", tt.hours, tt.duration, got, tt.budget)
+			}
+			if got := roundValue(pricing.ActualCost(tt.actualWork)); got != tt.actualCost {
+				t.Errorf("ActualCost(%v) = %v, want %v", tt.actualWork, got, tt.actualCost)
+			}
+		})
+	}
+
+	// the task without resources has no cost
+	if got := NewPricing(nil, 8).Budget(30, 10); got != 0 {
+		t.Errorf("Budget() without resources = %v, want 0", got)
+	}
+}
@@ -0,0 +1,205 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// limits of the iterations of the simulation
+const (
+	DefaultIterations = 1000
+	MaxIterations     = 10000
+)
+
+var ErrInvalidEstimate = errors.New("invalid estimate")
+
+// Estimate is the three-point (PERT) estimate of the duration of an activity, in days of the calendar.
+type Estimate struct {
+	// 樂觀期間
+	Optimistic float64
+	// 最可能期間
+	MostLikely float64
+	// 悲觀期間
+	Pessimistic float64
+}
+
+// NewEstimate checks the three durations of an estimate. It returns nil when none of them is given or all of them are zero,
+// and an error wrapping ErrInvalidEstimate when they are not given together, negative, or not ordered from the optimistic to the pessimistic one.
+func NewEstimate(optimistic, mostLikely, pessimistic *float64) (*Estimate, error) {
+	if optimistic == nil && mostLikely == nil && pessimistic == nil {
+		return nil, nil
+	}
+
+	if optimistic == nil || mostLikely == nil || pessimistic == nil {
+		return nil, fmt.Errorf("%w: the optimistic, most likely and pessimistic durations must be given together", ErrInvalidEstimate)
+	}
+
+	estimate := &Estimate{
+		Optimistic:  *optimistic,
+		MostLikely:  *mostLikely,
+		Pessimistic: *pessimistic,
+	}
+	if estimate.IsZero() {
+		return nil, nil
+	}
+
+	if estimate.Optimistic < 0 || estimate.MostLikely < 0 || estimate.Pessimistic < 0 {
+		return nil, fmt.Errorf("%w: the durations must not be negative", ErrInvalidEstimate)
+	}
+
+	if estimate.Optimistic > estimate.MostLikely || estimate.MostLikely > estimate.Pessimistic {
+		return nil, fmt.Errorf("%w: the durations must be ordered optimistic <= most likely <= pessimistic, got %g, %g, %g",
+			ErrInvalidEstimate, estimate.Optimistic, estimate.MostLikely, estimate.Pessimistic)
+	}
+
+	return estimate, nil
+}
+
+// IsZero reports whether the three durations are zero, which means no estimate.
+func (e Estimate) IsZero() bool {
+	return e.Optimistic == 0 && e.MostLikely == 0 && e.Pessimistic == 0
+}
+
+// Mean returns the expected duration (O + 4M + P) / 6.
+func (e Estimate) Mean() float64 {
+	return round((e.Optimistic + 4*e.MostLikely + e.Pessimistic) / 6)
+}
+
+// StandardDeviation returns the standard deviation (P - O) / 6.
+func (e Estimate) StandardDeviation() float64 {
+	return round((e.Pessimistic - e.Optimistic) / 6)
+}
+
+// sample is a helper function to draw a duration from the beta-PERT distribution of the estimate.
+func (e Estimate) sample(r *rand.Rand) float64 {
+	spread := e.Pessimistic - e.Optimistic
+	if spread <= epsilon {
+		return e.MostLikely
+	}
+
+	alpha := 1 + 4*(e.MostLikely-e.Optimistic)/spread
+	beta := 1 + 4*(e.Pessimistic-e.MostLikely)/spread
+	x, y := gamma(r, alpha), gamma(r, beta)
+	return e.Optimistic + spread*x/(x+y)
+}
+
+// gamma is a helper function to draw from the gamma distribution of the shape (at least 1) and a unit scale (Marsaglia and Tsang).
+func gamma(r *rand.Rand, shape float64) float64 {
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := r.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+
+		v = v * v * v
+		u := r.Float64()
+		if u < 1-0.0331*x*x*x*x || math.Log(u) < 0.5*x*x+d*(1-v+math.Log(v)) {
+			return d * v
+		}
+	}
+}
+
+// Forecast is the result of the Monte Carlo simulation of a project.
+type Forecast struct {
+	// 模擬次數
+	Iterations int
+	// 各次模擬的專案完成日期 (由早至晚)
+	Finishes []time.Time
+	// 各次模擬的追蹤任務完成日期 (由早至晚，key: 任務UUID)
+	TaskFinishes map[string][]time.Time
+	// 要徑指數 (任務為要徑任務的次數比例，key: 任務UUID)
+	Criticality map[string]float64
+}
+
+// Simulate runs the critical path method over the activities for the iterations, drawing the durations of the activities with an estimate
+// from their beta-PERT distributions and keeping the durations of the others. The random numbers come from the seed, so the same seed
+// gives the same forecast. The finishes are kept for the project and the tracked activities (e.g. the milestones).
+func Simulate(activities []*Activity, dependencies []*Dependency, estimates map[string]*Estimate, calendar Calendar, tracked []string, iterations int, seed int64) (*Forecast, error) {
+	if iterations <= 0 {
+		iterations = DefaultIterations
+	}
+
+	forecast := &Forecast{
+		Iterations:   iterations,
+		TaskFinishes: make(map[string][]time.Time),
+		Criticality:  make(map[string]float64),
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	sampled := make([]*Activity, len(activities))
+	for i := 0; i < iterations; i++ {
+		// the activities are drawn in their order to keep the forecast reproducible
+		for j, activity := range activities {
+			estimate, ok := estimates[activity.UUID]
+			if !ok || estimate == nil {
+				sampled[j] = activity
+				continue
+			}
+
+			copied := *activity
+			copied.End = time.Time{}
+			copied.Duration = estimate.sample(r)
+			sampled[j] = &copied
+		}
+
+		result, err := CriticalPath(sampled, dependencies, calendar)
+		if err != nil {
+			return nil, err
+		}
+
+		forecast.Finishes = append(forecast.Finishes, result.Finish)
+		for _, uuid := range tracked {
+			if timing, ok := result.Timings[uuid]; ok {
+				forecast.TaskFinishes[uuid] = append(forecast.TaskFinishes[uuid], timing.EarlyFinish)
+			}
+		}
+
+		for uuid, timing := range result.Timings {
+			if timing.IsCritical {
+				forecast.Criticality[uuid]++
+			}
+		}
+	}
+
+	sortDates(forecast.Finishes)
+	for _, finishes := range forecast.TaskFinishes {
+		sortDates(finishes)
+	}
+
+	for uuid, count := range forecast.Criticality {
+		forecast.Criticality[uuid] = round(count / float64(iterations))
+	}
+
+	return forecast, nil
+}
+
+// Percentile returns the date under which the percentage (0~100) of the sorted dates fall (nearest rank), or a zero date without dates.
+func Percentile(dates []time.Time, percentage float64) time.Time {
+	if len(dates) == 0 {
+		return time.Time{}
+	}
+
+	rank := int(math.Ceil(percentage / 100 * float64(len(dates))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(dates) {
+		rank = len(dates)
+	}
+
+	return dates[rank-1]
+}
+
+// sortDates is a helper function to sort the dates from the earliest.
+func sortDates(dates []time.Time) {
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+}
@@ -0,0 +1,176 @@
+package schedule
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewEstimate(t *testing.T) {
+	tests := []struct {
+		name        string
+		optimistic  *float64
+		mostLikely  *float64
+		pessimistic *float64
+		want        *Estimate
+		wantErr     bool
+	}{
+		{name: "no estimate"},
+		{name: "all zero", optimistic: ptr(0), mostLikely: ptr(0), pessimistic: ptr(0)},
+		{name: "ordered", optimistic: ptr(2), mostLikely: ptr(4), pessimistic: ptr(12), want: &Estimate{Optimistic: 2, MostLikely: 4, Pessimistic: 12}},
+		{name: "equal", optimistic: ptr(3), mostLikely: ptr(3), pessimistic: ptr(3), want: &Estimate{Optimistic: 3, MostLikely: 3, Pessimistic: 3}},
+		{name: "partial", optimistic: ptr(2), pessimistic: ptr(5), wantErr: true},
+		{name: "negative", optimistic: ptr(-1), mostLikely: ptr(2), pessimistic: ptr(5), wantErr: true},
+		{name: "unordered", optimistic: ptr(2), mostLikely: ptr(6), pessimistic: ptr(5), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEstimate(tt.optimistic, tt.mostLikely, tt.pessimistic)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidEstimate) {
+					t.Errorf("NewEstimate() error = %v, want %v", err, ErrInvalidEstimate)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewEstimate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	estimate := Estimate{Optimistic: 2, MostLikely: 4, Pessimistic: 12}
+	if estimate.Mean() != 5 || estimate.StandardDeviation() != 1.6667 {
+		t.Errorf("Mean(), StandardDeviation() = %v, %v, want 5, 1.6667", estimate.Mean(), estimate.StandardDeviation())
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n float64) time.Time {
+		return Continuous.Add(start, n)
+	}
+
+	// a precedes the parallel b and c, which both precede the milestone m
+	activities := []*Activity{
+		{UUID: "a", TaskID: "1", OutlineNumber: "1", Start: day(0), End: day(2)},
+		{UUID: "b", TaskID: "2", OutlineNumber: "2", Predecessor: "1", Start: day(2), End: day(6)},
+		{UUID: "c", TaskID: "3", OutlineNumber: "3", Predecessor: "1", Start: day(2), End: day(5)},
+		{UUID: "m", TaskID: "4", OutlineNumber: "4", Predecessor: "2,3", Start: day(6), End: day(6)},
+	}
+	dependencies, err := Link(activities)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		estimates map[string]*Estimate
+		// wantMin and wantMax bound the finishes of the project
+		wantMin float64
+		wantMax float64
+		// wantCritical are the tasks always critical, wantShared the tasks critical in some iterations only
+		wantCritical []string
+		wantShared   []string
+	}{
+		{
+			name:         "without estimates",
+			wantMin:      6,
+			wantMax:      6,
+			wantCritical: []string{"a", "b", "m"},
+		},
+		{
+			name:         "equal durations",
+			estimates:    map[string]*Estimate{"a": {Optimistic: 3, MostLikely: 3, Pessimistic: 3}},
+			wantMin:      7,
+			wantMax:      7,
+			wantCritical: []string{"a", "b", "m"},
+		},
+		{
+			name: "competing paths",
+			estimates: map[string]*Estimate{
+				"b": {Optimistic: 2, MostLikely: 4, Pessimistic: 6},
+				"c": {Optimistic: 1, MostLikely: 3, Pessimistic: 9},
+			},
+			wantMin:      4,
+			wantMax:      11,
+			wantCritical: []string{"a", "m"},
+			wantShared:   []string{"b", "c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Simulate(activities, dependencies, tt.estimates, Continuous, []string{"m"}, 500, 42)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			again, err := Simulate(activities, dependencies, tt.estimates, Continuous, []string{"m"}, 500, 42)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, again) {
+				t.Errorf("Simulate() is not reproducible with the same seed")
+			}
+
+			if len(got.Finishes) != 500 || len(got.TaskFinishes["m"]) != 500 {
+				t.Fatalf("Simulate() has %d finishes and %d milestone finishes, want 500", len(got.Finishes), len(got.TaskFinishes["m"]))
+			}
+
+			p50, p80, p95 := Percentile(got.Finishes, 50), Percentile(got.Finishes, 80), Percentile(got.Finishes, 95)
+			if p50.Before(day(tt.wantMin)) || p95.After(day(tt.wantMax)) || p80.Before(p50) || p95.Before(p80) {
+				t.Errorf("P50, P80, P95 = %v, %v, %v, want ordered within %v and %v", p50, p80, p95, day(tt.wantMin), day(tt.wantMax))
+			}
+			if !Percentile(got.TaskFinishes["m"], 80).Equal(p80) {
+				t.Errorf("P80 of the milestone = %v, want %v", Percentile(got.TaskFinishes["m"], 80), p80)
+			}
+
+			for _, uuid := range tt.wantCritical {
+				if got.Criticality[uuid] != 1 {
+					t.Errorf("Criticality[%s] = %v, want 1", uuid, got.Criticality[uuid])
+				}
+			}
+			for _, uuid := range tt.wantShared {
+				if got.Criticality[uuid] <= 0 || got.Criticality[uuid] >= 1 {
+					t.Errorf("Criticality[%s] = %v, want between 0 and 1", uuid, got.Criticality[uuid])
+				}
+			}
+		})
+	}
+
+	other, err := Simulate(activities, dependencies, map[string]*Estimate{"c": {Optimistic: 1, MostLikely: 3, Pessimistic: 9}}, Continuous, nil, 500, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seeded, _ := Simulate(activities, dependencies, map[string]*Estimate{"c": {Optimistic: 1, MostLikely: 3, Pessimistic: 9}}, Continuous, nil, 500, 42); reflect.DeepEqual(other.Finishes, seeded.Finishes) {
+		t.Errorf("Simulate() gives the same finishes with different seeds")
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dates := []time.Time{start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 2), start.AddDate(0, 0, 3)}
+
+	tests := []struct {
+		percentage float64
+		want       time.Time
+	}{
+		{percentage: 0, want: dates[0]},
+		{percentage: 50, want: dates[1]},
+		{percentage: 80, want: dates[3]},
+		{percentage: 100, want: dates[3]},
+	}
+	for _, tt := range tests {
+		if got := Percentile(dates, tt.percentage); !got.Equal(tt.want) {
+			t.Errorf("Percentile(%v) = %v, want %v", tt.percentage, got, tt.want)
+		}
+	}
+
+	if got := Percentile(nil, 50); !got.IsZero() {
+		t.Errorf("Percentile() without dates = %v, want a zero date", got)
+	}
+}
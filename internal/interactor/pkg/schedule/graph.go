@@ -0,0 +1,155 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dependency is an edge between two tasks of the dependency graph.
+type Dependency struct {
+	// 前任任務UUID
+	PredecessorUUID string
+	// 後續任務UUID
+	SuccessorUUID string
+	// 相依類型
+	Type string
+	// 延遲
+	Lag float64
+	// 延遲單位
+	LagUnit string
+}
+
+// Graph is the dependency graph of the tasks of a project.
+type Graph struct {
+	nodes        []string
+	labels       map[string]string
+	successors   map[string][]*Dependency
+	predecessors map[string][]*Dependency
+}
+
+// NewGraph builds a dependency graph from the task UUIDs and their dependencies.
+func NewGraph(nodes []string, dependencies []*Dependency) *Graph {
+	g := &Graph{
+		nodes:        nodes,
+		successors:   make(map[string][]*Dependency),
+		predecessors: make(map[string][]*Dependency),
+	}
+
+	for _, dep := range dependencies {
+		g.successors[dep.PredecessorUUID] = append(g.successors[dep.PredecessorUUID], dep)
+		g.predecessors[dep.SuccessorUUID] = append(g.predecessors[dep.SuccessorUUID], dep)
+	}
+
+	return g
+}
+
+// WithLabels sets the names (e.g. task_id) used for the tasks in error messages.
+func (g *Graph) WithLabels(labels map[string]string) *Graph {
+	g.labels = labels
+	return g
+}
+
+// Nodes returns the task UUIDs of the graph.
+func (g *Graph) Nodes() []string {
+	return g.nodes
+}
+
+// Successors returns the dependencies leaving the task.
+func (g *Graph) Successors(taskUUID string) []*Dependency {
+	return g.successors[taskUUID]
+}
+
+// Predecessors returns the dependencies entering the task.
+func (g *Graph) Predecessors(taskUUID string) []*Dependency {
+	return g.predecessors[taskUUID]
+}
+
+// Sort returns the task UUIDs in topological order, or ErrCyclicDependency when the graph has a cycle.
+func (g *Graph) Sort() ([]string, error) {
+	inDegree := make(map[string]int, len(g.nodes))
+	for _, node := range g.nodes {
+		inDegree[node] = len(g.predecessors[node])
+	}
+
+	var queue, order []string
+	for _, node := range g.nodes {
+		if inDegree[node] == 0 {
+			queue = append(queue, node)
+		}
+	}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		order = append(order, node)
+		for _, dep := range g.successors[node] {
+			inDegree[dep.SuccessorUUID]--
+			if inDegree[dep.SuccessorUUID] == 0 {
+				queue = append(queue, dep.SuccessorUUID)
+			}
+		}
+	}
+
+	if len(order) != len(g.nodes) {
+		return nil, fmt.Errorf("%w: %s", ErrCyclicDependency, strings.Join(g.findCycle(inDegree), " -> "))
+	}
+
+	return order, nil
+}
+
+// findCycle is a helper function to find one cycle among the nodes that could not be sorted.
+func (g *Graph) findCycle(inDegree map[string]int) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int)
+	var stack []string
+	var cycle []string
+
+	var visit func(node string) bool
+	visit = func(node string) bool {
+		state[node] = visiting
+		stack = append(stack, node)
+		for _, dep := range g.successors[node] {
+			next := dep.SuccessorUUID
+			if inDegree[next] == 0 {
+				continue
+			}
+			if state[next] == visiting {
+				for i, item := range stack {
+					if item == next {
+						for _, member := range append(stack[i:], next) {
+							cycle = append(cycle, g.label(member))
+						}
+						return true
+					}
+				}
+			}
+			if state[next] == unvisited && visit(next) {
+				return true
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[node] = visited
+		return false
+	}
+
+	for _, node := range g.nodes {
+		if inDegree[node] > 0 && state[node] == unvisited && visit(node) {
+			break
+		}
+	}
+
+	return cycle
+}
+
+// label is a helper function to get the display name of a task.
+func (g *Graph) label(node string) string {
+	if label, ok := g.labels[node]; ok && label != "" {
+		return label
+	}
+	return node
+}
@@ -0,0 +1,198 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// DefaultPriority is the priority of the activities when none is given.
+	DefaultPriority = 500
+	// DoNotLevel is the priority of the activities which are never delayed by the resource leveling.
+	DoNotLevel = 1000
+)
+
+// Level delays the activities within their total float to remove the over-allocations of their resources.
+// The activities are placed one after another by their start, their priority (the highest first) and their total float,
+// so that the activities with the lowest priority are delayed first; each one is moved to the earliest date, no later than its late start,
+// at which its resources are not over-allocated, or kept at the earliest date allowed by its predecessors when there is none.
+// The critical, Do Not Level, As Late As Possible, Must Start On and Must Finish On activities are never delayed, and
+// the assignments of the tasks which are not among the activities (e.g. of the other projects) are kept as they are.
+// The activities are updated in place and the moved ones are returned in schedule order, followed by their summary activities.
+func Level(activities []*Activity, dependencies []*Dependency, assignments []*Assignment, capacities map[string]float64, calendar Calendar) ([]*Activity, error) {
+	result, err := CriticalPath(activities, dependencies, calendar)
+	if err != nil {
+		return nil, err
+	}
+
+	o := newOutline(activities)
+	activityMap := make(map[string]*Activity)
+	indexMap := make(map[string]int)
+	var leaves []string
+	for i, activity := range activities {
+		activityMap[activity.UUID] = activity
+		indexMap[activity.UUID] = i
+		if !o.isSummary(activity.UUID) {
+			leaves = append(leaves, activity.UUID)
+		}
+	}
+
+	graph := NewGraph(leaves, o.expand(dependencies))
+	order, err := graph.Sort()
+	if err != nil {
+		return nil, err
+	}
+
+	// the assignments of the leaves are placed with them, the other ones are already placed
+	assignmentMap := make(map[string][]*Assignment)
+	placed := make(map[string][]*Assignment)
+	for _, assignment := range assignments {
+		copied := *assignment
+		if _, ok := activityMap[copied.TaskUUID]; ok && !o.isSummary(copied.TaskUUID) {
+			assignmentMap[copied.TaskUUID] = append(assignmentMap[copied.TaskUUID], &copied)
+			continue
+		}
+
+		placed[copied.ResourceUUID] = append(placed[copied.ResourceUUID], &copied)
+	}
+
+	remaining := make(map[string]int)
+	var ready []string
+	for _, uuid := range leaves {
+		remaining[uuid] = len(graph.Predecessors(uuid))
+		if remaining[uuid] == 0 {
+			ready = append(ready, uuid)
+		}
+	}
+
+	moved := make(map[string]bool)
+	for len(ready) > 0 {
+		sort.SliceStable(ready, func(i, j int) bool {
+			a, b := activityMap[ready[i]], activityMap[ready[j]]
+			if !a.Start.Equal(b.Start) {
+				return b.Start.IsZero() || (!a.Start.IsZero() && a.Start.Before(b.Start))
+			}
+			if a.Priority != b.Priority {
+				return a.Priority > b.Priority
+			}
+			if fa, fb := result.Timings[a.UUID].TotalFloat, result.Timings[b.UUID].TotalFloat; fa != fb {
+				return fa < fb
+			}
+
+			return indexMap[a.UUID] < indexMap[b.UUID]
+		})
+
+		uuid := ready[0]
+		ready = ready[1:]
+		for _, dep := range graph.Successors(uuid) {
+			remaining[dep.SuccessorUUID]--
+			if remaining[dep.SuccessorUUID] == 0 {
+				ready = append(ready, dep.SuccessorUUID)
+			}
+		}
+
+		activity := activityMap[uuid]
+		if activity.Start.IsZero() {
+			continue
+		}
+
+		span := duration(activity, calendar)
+		start := activity.Start
+		if earliest := earliestStart(uuid, graph, activityMap, span, calendar); earliest.After(start) {
+			start = earliest
+		}
+
+		if canLevel(activity, result.Timings[uuid]) {
+			start = levelStart(uuid, start, result.Timings[uuid].LateStart, span, assignmentMap[uuid], placed, capacities, calendar)
+		}
+
+		if !start.Equal(activity.Start) {
+			activity.Start = start
+			activity.End = calendar.Add(start, span)
+			moved[uuid] = true
+		}
+
+		for _, assignment := range assignmentMap[uuid] {
+			assignment.Start, assignment.End = activity.Start, activity.End
+			placed[assignment.ResourceUUID] = append(placed[assignment.ResourceUUID], assignment)
+		}
+	}
+
+	var output []*Activity
+	for _, uuid := range order {
+		if moved[uuid] {
+			output = append(output, activityMap[uuid])
+		}
+	}
+
+	output = append(output, rollUpDates(activities, o, moved, nil)...)
+	return output, nil
+}
+
+// canLevel is a helper function to report whether the activity may be delayed by the resource leveling.
+func canLevel(activity *Activity, timing *Timing) bool {
+	if activity.Priority >= DoNotLevel || timing == nil || timing.TotalFloat <= epsilon {
+		return false
+	}
+
+	switch activity.ConstraintType {
+	case AsLateAsPossible, MustStartOn, MustFinishOn:
+		return false
+	default:
+		return true
+	}
+}
+
+// levelStart is a helper function to find the earliest start between the earliest and the latest starts at which
+// the assignments of the activity do not over-allocate their resources, trying the ends of the placed assignments in turn.
+func levelStart(uuid string, earliest, latest time.Time, span float64, assignments []*Assignment, placed map[string][]*Assignment,
+	capacities map[string]float64, calendar Calendar) time.Time {
+	candidates := []time.Time{earliest}
+	for _, assignment := range assignments {
+		for _, item := range placed[assignment.ResourceUUID] {
+			if item.End.After(earliest) && !item.End.After(latest) {
+				candidates = append(candidates, item.End)
+			}
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Before(candidates[j])
+	})
+
+	for _, start := range candidates {
+		if fits(uuid, start, calendar.Add(start, span), assignments, placed, capacities, calendar) {
+			return start
+		}
+	}
+
+	return earliest
+}
+
+// fits is a helper function to report whether the assignments of the activity, moved to the dates, stay within the capacities of their resources.
+func fits(uuid string, start, end time.Time, assignments []*Assignment, placed map[string][]*Assignment, capacities map[string]float64, calendar Calendar) bool {
+	for _, assignment := range assignments {
+		var check []*Assignment
+		for _, item := range assignments {
+			if item.ResourceUUID == assignment.ResourceUUID {
+				check = append(check, &Assignment{TaskUUID: uuid, ResourceUUID: item.ResourceUUID, Start: start, End: end, Units: item.Units})
+			}
+		}
+
+		for _, item := range placed[assignment.ResourceUUID] {
+			if item.Start.Before(end) && item.End.After(start) {
+				check = append(check, item)
+			}
+		}
+
+		for _, overallocation := range Overallocations(check, capacities, calendar) {
+			for _, taskUUID := range overallocation.TaskUUIDs {
+				if taskUUID == uuid {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
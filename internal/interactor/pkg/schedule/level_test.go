@@ -0,0 +1,154 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLevel(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n float64) time.Time {
+		return Continuous.Add(start, n)
+	}
+
+	tests := []struct {
+		name        string
+		activities  []*Activity
+		assignments []*Assignment
+		// want is the start day of the moved activities
+		want map[string]float64
+	}{
+		{
+			name: "non-critical task delayed within its float",
+			activities: []*Activity{
+				{UUID: "a", TaskID: "1", OutlineNumber: "1", Start: day(0), End: day(2)},
+				{UUID: "b", TaskID: "2", OutlineNumber: "2", Start: day(0), End: day(1)},
+				{UUID: "c", TaskID: "3", OutlineNumber: "3", Predecessor: "1", Start: day(2), End: day(4)},
+			},
+			assignments: []*Assignment{
+				{TaskUUID: "a", ResourceUUID: "r", Units: 100},
+				{TaskUUID: "b", ResourceUUID: "r", Units: 100},
+			},
+			want: map[string]float64{"b": 2},
+		},
+		{
+			name: "lower priority delayed first",
+			activities: []*Activity{
+				{UUID: "x", TaskID: "1", OutlineNumber: "1", Start: day(0), End: day(5)},
+				{UUID: "y", TaskID: "2", OutlineNumber: "2", Start: day(0), End: day(1), Priority: 500},
+				{UUID: "z", TaskID: "3", OutlineNumber: "3", Start: day(0), End: day(1), Priority: 800},
+			},
+			assignments: []*Assignment{
+				{TaskUUID: "y", ResourceUUID: "r", Units: 100},
+				{TaskUUID: "z", ResourceUUID: "r", Units: 100},
+			},
+			want: map[string]float64{"y": 1},
+		},
+		{
+			name: "successor pushed with its delayed predecessor",
+			activities: []*Activity{
+				{UUID: "x", TaskID: "1", OutlineNumber: "1", Start: day(0), End: day(6)},
+				{UUID: "a", TaskID: "2", OutlineNumber: "2", Start: day(0), End: day(2)},
+				{UUID: "b", TaskID: "3", OutlineNumber: "3", Start: day(0), End: day(1)},
+				{UUID: "c", TaskID: "4", OutlineNumber: "4", Predecessor: "3", Start: day(1), End: day(2)},
+			},
+			assignments: []*Assignment{
+				{TaskUUID: "a", ResourceUUID: "r", Units: 100},
+				{TaskUUID: "b", ResourceUUID: "r", Units: 100},
+			},
+			want: map[string]float64{"b": 2, "c": 3},
+		},
+		{
+			name: "critical tasks kept in place",
+			activities: []*Activity{
+				{UUID: "a", TaskID: "1", OutlineNumber: "1", Start: day(0), End: day(2)},
+				{UUID: "b", TaskID: "2", OutlineNumber: "2", Start: day(0), End: day(2)},
+			},
+			assignments: []*Assignment{
+				{TaskUUID: "a", ResourceUUID: "r", Units: 100},
+				{TaskUUID: "b", ResourceUUID: "r", Units: 100},
+			},
+			want: map[string]float64{},
+		},
+		{
+			name: "do not level and must start on kept in place",
+			activities: []*Activity{
+				{UUID: "x", TaskID: "1", OutlineNumber: "1", Start: day(0), End: day(5)},
+				{UUID: "a", TaskID: "2", OutlineNumber: "2", Start: day(0), End: day(1), Priority: DoNotLevel},
+				{UUID: "b", TaskID: "3", OutlineNumber: "3", Start: day(0), End: day(1), ConstraintType: MustStartOn, ConstraintDate: day(0)},
+			},
+			assignments: []*Assignment{
+				{TaskUUID: "a", ResourceUUID: "r", Units: 100},
+				{TaskUUID: "b", ResourceUUID: "r", Units: 100},
+			},
+			want: map[string]float64{},
+		},
+		{
+			name: "assignment of another project kept in place",
+			activities: []*Activity{
+				{UUID: "x", TaskID: "1", OutlineNumber: "1", Start: day(0), End: day(5)},
+				{UUID: "b", TaskID: "2", OutlineNumber: "2", Start: day(0), End: day(1)},
+			},
+			assignments: []*Assignment{
+				{TaskUUID: "other", ResourceUUID: "r", Start: day(0), End: day(2), Units: 60},
+				{TaskUUID: "b", ResourceUUID: "r", Units: 50},
+			},
+			want: map[string]float64{"b": 2},
+		},
+		{
+			name: "summary rolled up with its children",
+			activities: []*Activity{
+				{UUID: "x", TaskID: "1", OutlineNumber: "1", Start: day(0), End: day(5)},
+				{UUID: "s", TaskID: "2", OutlineNumber: "2", Start: day(0), End: day(1)},
+				{UUID: "a", TaskID: "3", OutlineNumber: "2.1", Start: day(0), End: day(1), Priority: 600},
+				{UUID: "b", TaskID: "4", OutlineNumber: "2.2", Start: day(0), End: day(1)},
+			},
+			assignments: []*Assignment{
+				{TaskUUID: "a", ResourceUUID: "r", Units: 100},
+				{TaskUUID: "b", ResourceUUID: "r", Units: 100},
+			},
+			want: map[string]float64{"b": 1, "s": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			activityMap := make(map[string]*Activity)
+			for _, activity := range tt.activities {
+				activityMap[activity.UUID] = activity
+			}
+
+			// the assignments of the activities follow their dates
+			for _, assignment := range tt.assignments {
+				if activity, ok := activityMap[assignment.TaskUUID]; ok {
+					assignment.Start, assignment.End = activity.Start, activity.End
+				}
+			}
+
+			dependencies, err := Link(tt.activities)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			moved, err := Level(tt.activities, dependencies, tt.assignments, nil, Continuous)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(moved) != len(tt.want) {
+				t.Fatalf("Level() moved %d activities, want %d", len(moved), len(tt.want))
+			}
+
+			for _, activity := range moved {
+				want, ok := tt.want[activity.UUID]
+				if !ok {
+					t.Errorf("Level() moved %s", activity.UUID)
+					continue
+				}
+
+				if !activity.Start.Equal(day(want)) {
+					t.Errorf("Level() moved %s to %v, want %v", activity.UUID, activity.Start, day(want))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package schedule
+
+import "time"
+
+// statuses of the milestones
+const (
+	MilestoneDue      = "due"
+	MilestoneAchieved = "achieved"
+	MilestoneLate     = "late"
+)
+
+// MilestoneStatus returns the status of a milestone on the date with the progress, as of today:
+// achieved when it is complete, late when its day has passed or falls after the day of the deadline, due otherwise.
+// The days are compared in the location of today.
+func MilestoneStatus(date time.Time, progress int64, deadline *time.Time, today time.Time) string {
+	if progress >= 100 {
+		return MilestoneAchieved
+	}
+
+	day := date.In(today.Location()).Format(time.DateOnly)
+	if day < today.Format(time.DateOnly) {
+		return MilestoneLate
+	}
+	if deadline != nil && day > deadline.In(today.Location()).Format(time.DateOnly) {
+		return MilestoneLate
+	}
+
+	return MilestoneDue
+}
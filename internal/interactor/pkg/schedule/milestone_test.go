@@ -0,0 +1,36 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMilestoneStatus(t *testing.T) {
+	location := time.FixedZone("UTC+8", 8*60*60)
+	today := time.Date(2024, 4, 15, 10, 0, 0, 0, location)
+	deadline := time.Date(2024, 4, 20, 0, 0, 0, 0, location)
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		progress int64
+		deadline *time.Time
+		want     string
+	}{
+		{name: "due today", date: time.Date(2024, 4, 15, 18, 0, 0, 0, location), want: MilestoneDue},
+		{name: "due before the deadline", date: time.Date(2024, 4, 20, 12, 0, 0, 0, location), deadline: &deadline, want: MilestoneDue},
+		{name: "achieved in the past", date: time.Date(2024, 4, 1, 12, 0, 0, 0, location), progress: 100, want: MilestoneAchieved},
+		{name: "achieved after the deadline", date: time.Date(2024, 4, 25, 12, 0, 0, 0, location), progress: 100, deadline: &deadline, want: MilestoneAchieved},
+		{name: "late when the day has passed", date: time.Date(2024, 4, 14, 12, 0, 0, 0, location), progress: 50, want: MilestoneLate},
+		{name: "late after the deadline", date: time.Date(2024, 4, 21, 12, 0, 0, 0, location), deadline: &deadline, want: MilestoneLate},
+		// 2024-04-14 20:00 UTC is 2024-04-15 04:00 in UTC+8
+		{name: "days in the location of today", date: time.Date(2024, 4, 14, 20, 0, 0, 0, time.UTC), want: MilestoneDue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MilestoneStatus(tt.date, tt.progress, tt.deadline, today); got != tt.want {
+				t.Errorf("MilestoneStatus() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Activity is a task taking part in the schedule network.
+type Activity struct {
+	// 任務UUID
+	UUID string
+	// 前端編號
+	TaskID string
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string
+	// 前任
+	Predecessor string
+	// 起始日期
+	Start time.Time
+	// 結束日期
+	End time.Time
+	// 期間
+	Duration float64
+	// 限制類型
+	ConstraintType string
+	// 限制日期
+	ConstraintDate time.Time
+	// 期限
+	Deadline time.Time
+	// 優先順序 (0~1000)
+	Priority int
+}
+
+// Link resolves the predecessors of the activities into dependencies,
+// rejecting invalid, dangling, self and cyclic references.
+func Link(activities []*Activity) ([]*Dependency, error) {
+	nodes := make([]string, 0, len(activities))
+	taskIDMap := make(map[string]string)
+	labels := make(map[string]string)
+	for _, activity := range activities {
+		nodes = append(nodes, activity.UUID)
+		taskIDMap[activity.TaskID] = activity.UUID
+		labels[activity.UUID] = activity.TaskID
+	}
+
+	var dependencies []*Dependency
+	for _, activity := range activities {
+		predecessors, err := ParsePredecessors(activity.Predecessor)
+		if err != nil {
+			return nil, fmt.Errorf("task %s: %w", activity.TaskID, err)
+		}
+
+		for _, predecessor := range predecessors {
+			predecessorUUID, ok := taskIDMap[predecessor.TaskID]
+			if !ok {
+				return nil, fmt.Errorf("task %s: %w: %s", activity.TaskID, ErrDanglingPredecessor, predecessor.TaskID)
+			}
+
+			if predecessorUUID == activity.UUID {
+				return nil, fmt.Errorf("%w: %s -> %s", ErrCyclicDependency, activity.TaskID, activity.TaskID)
+			}
+
+			dependencies = append(dependencies, &Dependency{
+				PredecessorUUID: predecessorUUID,
+				SuccessorUUID:   activity.UUID,
+				Type:            predecessor.Type,
+				Lag:             predecessor.Lag,
+				LagUnit:         predecessor.LagUnit,
+			})
+		}
+	}
+
+	_, err := NewGraph(nodes, dependencies).WithLabels(labels).Sort()
+	if err != nil {
+		return nil, err
+	}
+
+	return dependencies, nil
+}
+
+// outline is the hierarchy of the activities derived from their outline numbers.
+type outline struct {
+	parent   map[string]string
+	children map[string][]string
+	depth    map[string]int
+}
+
+// newOutline is a helper function to build the hierarchy of the activities.
+func newOutline(activities []*Activity) *outline {
+	o := &outline{
+		parent:   make(map[string]string),
+		children: make(map[string][]string),
+		depth:    make(map[string]int),
+	}
+
+	outlineMap := make(map[string]string)
+	for _, activity := range activities {
+		if activity.OutlineNumber != "" {
+			outlineMap[activity.OutlineNumber] = activity.UUID
+		}
+	}
+
+	for _, activity := range activities {
+		o.depth[activity.UUID] = strings.Count(activity.OutlineNumber, ".")
+		index := strings.LastIndex(activity.OutlineNumber, ".")
+		if index < 0 {
+			continue
+		}
+
+		if parentUUID, ok := outlineMap[activity.OutlineNumber[:index]]; ok {
+			o.parent[activity.UUID] = parentUUID
+			o.children[parentUUID] = append(o.children[parentUUID], activity.UUID)
+		}
+	}
+
+	return o
+}
+
+// isSummary reports whether the activity has children.
+func (o *outline) isSummary(uuid string) bool {
+	return len(o.children[uuid]) > 0
+}
+
+// leaves returns the activity itself, or its lowest descendants when it is a summary.
+func (o *outline) leaves(uuid string) []string {
+	if !o.isSummary(uuid) {
+		return []string{uuid}
+	}
+
+	var output []string
+	for _, child := range o.children[uuid] {
+		output = append(output, o.leaves(child)...)
+	}
+
+	return output
+}
+
+// expand is a helper function to replace the dependencies of summary activities by the ones of their leaves.
+func (o *outline) expand(dependencies []*Dependency) []*Dependency {
+	var output []*Dependency
+	for _, dep := range dependencies {
+		if !o.isSummary(dep.PredecessorUUID) && !o.isSummary(dep.SuccessorUUID) {
+			output = append(output, dep)
+			continue
+		}
+
+		for _, predecessor := range o.leaves(dep.PredecessorUUID) {
+			for _, successor := range o.leaves(dep.SuccessorUUID) {
+				if predecessor == successor {
+					continue
+				}
+
+				output = append(output, &Dependency{
+					PredecessorUUID: predecessor,
+					SuccessorUUID:   successor,
+					Type:            dep.Type,
+					Lag:             dep.Lag,
+					LagUnit:         dep.LagUnit,
+				})
+			}
+		}
+	}
+
+	return output
+}
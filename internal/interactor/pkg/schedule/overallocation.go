@@ -0,0 +1,144 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+)
+
+// DefaultCapacity is the units (in percent) a resource can work when no capacity is given.
+const DefaultCapacity = 100
+
+// Assignment is a resource assigned to an activity.
+type Assignment struct {
+	TaskUUID     string
+	ResourceUUID string
+	Start        time.Time
+	End          time.Time
+	// Units is the percentage of the resource assigned to the activity.
+	Units float64
+}
+
+// Overallocation is a period in which the combined units of a resource exceed its capacity.
+type Overallocation struct {
+	ResourceUUID string
+	Start        time.Time
+	End          time.Time
+	// Units is the highest combined units of the resource in the period.
+	Units float64
+	// Capacity is the units the resource can work.
+	Capacity  float64
+	TaskUUIDs []string
+}
+
+type assignmentEvent struct {
+	at         time.Time
+	assignment *Assignment
+	start      bool
+}
+
+// Overallocations finds the periods in which the combined units of each resource exceed its capacity,
+// ignoring the periods without working time of the calendar (when it is given).
+func Overallocations(assignments []*Assignment, capacities map[string]float64, calendar Calendar) []*Overallocation {
+	eventMap := make(map[string][]assignmentEvent)
+	var resourceUUIDs []string
+	for _, assignment := range assignments {
+		if assignment.Units <= 0 || !assignment.End.After(assignment.Start) {
+			continue
+		}
+
+		if _, ok := eventMap[assignment.ResourceUUID]; !ok {
+			resourceUUIDs = append(resourceUUIDs, assignment.ResourceUUID)
+		}
+		eventMap[assignment.ResourceUUID] = append(eventMap[assignment.ResourceUUID],
+			assignmentEvent{at: assignment.Start, assignment: assignment, start: true},
+			assignmentEvent{at: assignment.End, assignment: assignment},
+		)
+	}
+
+	var output []*Overallocation
+	for _, resourceUUID := range resourceUUIDs {
+		capacity, ok := capacities[resourceUUID]
+		if !ok {
+			capacity = DefaultCapacity
+		}
+
+		output = append(output, overallocations(resourceUUID, eventMap[resourceUUID], capacity, calendar)...)
+	}
+
+	return output
+}
+
+// overallocations is a helper function to sweep the assignments of one resource in time order.
+func overallocations(resourceUUID string, events []assignmentEvent, capacity float64, calendar Calendar) []*Overallocation {
+	// the assignment ending at the time is released before the one starting at the same time
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].at.Equal(events[j].at) {
+			return events[i].at.Before(events[j].at)
+		}
+
+		return !events[i].start && events[j].start
+	})
+
+	var (
+		output []*Overallocation
+		units  float64
+	)
+	active := make(map[string]float64)
+	for i, event := range events {
+		if event.start {
+			active[event.assignment.TaskUUID] += event.assignment.Units
+			units += event.assignment.Units
+		} else {
+			active[event.assignment.TaskUUID] -= event.assignment.Units
+			units -= event.assignment.Units
+			if active[event.assignment.TaskUUID] <= 1e-9 {
+				delete(active, event.assignment.TaskUUID)
+			}
+		}
+
+		if i+1 == len(events) || units <= capacity+1e-9 || !events[i+1].at.After(event.at) {
+			continue
+		}
+
+		start, end := event.at, events[i+1].at
+		if calendar != nil && calendar.Days(start, end) <= 0 {
+			continue
+		}
+
+		// extend the previous period when they are adjacent
+		if last := len(output) - 1; last >= 0 && output[last].End.Equal(start) {
+			output[last].End = end
+			output[last].Units = max(output[last].Units, roundValue(units))
+			output[last].TaskUUIDs = mergeTaskUUIDs(output[last].TaskUUIDs, active)
+			continue
+		}
+
+		output = append(output, &Overallocation{
+			ResourceUUID: resourceUUID,
+			Start:        start,
+			End:          end,
+			Units:        roundValue(units),
+			Capacity:     capacity,
+			TaskUUIDs:    mergeTaskUUIDs(nil, active),
+		})
+	}
+
+	return output
+}
+
+// mergeTaskUUIDs is a helper function to add the active tasks to the sorted task UUIDs.
+func mergeTaskUUIDs(taskUUIDs []string, active map[string]float64) []string {
+	seen := make(map[string]bool, len(taskUUIDs))
+	for _, taskUUID := range taskUUIDs {
+		seen[taskUUID] = true
+	}
+
+	for taskUUID := range active {
+		if !seen[taskUUID] {
+			taskUUIDs = append(taskUUIDs, taskUUID)
+		}
+	}
+
+	sort.Strings(taskUUIDs)
+	return taskUUIDs
+}
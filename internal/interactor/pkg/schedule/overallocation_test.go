@@ -0,0 +1,98 @@
+package schedule
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOverallocations(t *testing.T) {
+	location := time.FixedZone("CST", 8*60*60)
+	date := func(day, hour int) time.Time {
+		return time.Date(2024, 2, day, hour, 0, 0, 0, location)
+	}
+
+	// 2024-02-05 is a Monday
+	calendar := NewWorkCalendar(nil, nil, nil, location)
+	tests := []struct {
+		name        string
+		assignments []*Assignment
+		capacities  map[string]float64
+		calendar    Calendar
+		want        []*Overallocation
+	}{
+		{
+			name: "overlapping full-time tasks",
+			assignments: []*Assignment{
+				{TaskUUID: "a", ResourceUUID: "r", Start: date(5, 8), End: date(7, 17), Units: 100},
+				{TaskUUID: "b", ResourceUUID: "r", Start: date(6, 8), End: date(8, 17), Units: 100},
+			},
+			want: []*Overallocation{
+				{ResourceUUID: "r", Start: date(6, 8), End: date(7, 17), Units: 200, Capacity: 100, TaskUUIDs: []string{"a", "b"}},
+			},
+		},
+		{
+			name: "adjacent tasks",
+			assignments: []*Assignment{
+				{TaskUUID: "a", ResourceUUID: "r", Start: date(5, 8), End: date(6, 8), Units: 100},
+				{TaskUUID: "b", ResourceUUID: "r", Start: date(6, 8), End: date(7, 8), Units: 100},
+			},
+		},
+		{
+			name: "half-time tasks within capacity",
+			assignments: []*Assignment{
+				{TaskUUID: "a", ResourceUUID: "r", Start: date(5, 8), End: date(9, 17), Units: 50},
+				{TaskUUID: "b", ResourceUUID: "r", Start: date(5, 8), End: date(9, 17), Units: 50},
+			},
+		},
+		{
+			name: "personal capacity",
+			assignments: []*Assignment{
+				{TaskUUID: "a", ResourceUUID: "r", Start: date(5, 8), End: date(5, 17), Units: 75},
+			},
+			capacities: map[string]float64{"r": 50},
+			want: []*Overallocation{
+				{ResourceUUID: "r", Start: date(5, 8), End: date(5, 17), Units: 75, Capacity: 50, TaskUUIDs: []string{"a"}},
+			},
+		},
+		{
+			name: "adjacent periods merged",
+			assignments: []*Assignment{
+				{TaskUUID: "a", ResourceUUID: "r", Start: date(5, 8), End: date(9, 17), Units: 100},
+				{TaskUUID: "b", ResourceUUID: "r", Start: date(5, 8), End: date(6, 17), Units: 50},
+				{TaskUUID: "c", ResourceUUID: "r", Start: date(6, 17), End: date(7, 17), Units: 100},
+			},
+			want: []*Overallocation{
+				{ResourceUUID: "r", Start: date(5, 8), End: date(7, 17), Units: 200, Capacity: 100, TaskUUIDs: []string{"a", "b", "c"}},
+			},
+		},
+		{
+			name: "overlap on the weekend only",
+			assignments: []*Assignment{
+				{TaskUUID: "a", ResourceUUID: "r", Start: date(9, 8), End: date(10, 17), Units: 100},
+				{TaskUUID: "b", ResourceUUID: "r", Start: date(10, 8), End: date(12, 8), Units: 100},
+			},
+			calendar: calendar,
+		},
+		{
+			name: "different resources",
+			assignments: []*Assignment{
+				{TaskUUID: "a", ResourceUUID: "r", Start: date(5, 8), End: date(5, 17), Units: 100},
+				{TaskUUID: "b", ResourceUUID: "s", Start: date(5, 8), End: date(5, 17), Units: 100},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Overallocations(tt.assignments, tt.capacities, tt.calendar)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Overallocations() returned %d periods, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if !reflect.DeepEqual(got[i], tt.want[i]) {
+					t.Errorf("Overallocations()[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package schedule
+
+import (
+	"time"
+)
+
+const (
+	// IntervalDay splits the dates into days.
+	IntervalDay = "day"
+	// IntervalWeek splits the dates into weeks starting on Monday.
+	IntervalWeek = "week"
+)
+
+// Period is a time-phased bucket, from its start (included) to its end (excluded).
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Periods splits the days from start to end (both included) into periods of the interval.
+// The first and the last weeks are cut at start and end.
+func Periods(start, end time.Time, interval string) []Period {
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, start.Location()).AddDate(0, 0, 1)
+
+	var periods []Period
+	for from := start; from.Before(end); {
+		to := from.AddDate(0, 0, 1)
+		if interval == IntervalWeek {
+			// the days until the next Monday
+			to = from.AddDate(0, 0, 7-(int(from.Weekday())+6)%7)
+		}
+
+		if to.After(end) {
+			to = end
+		}
+
+		periods = append(periods, Period{Start: from, End: to})
+		from = to
+	}
+
+	return periods
+}
@@ -0,0 +1,43 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeriods(t *testing.T) {
+	date := func(day int) time.Time {
+		return time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		interval string
+		want     []Period
+	}{
+		{name: "days", start: date(1), end: date(3), interval: IntervalDay,
+			want: []Period{{date(1), date(2)}, {date(2), date(3)}, {date(3), date(4)}}},
+		{name: "single day with time", start: date(5).Add(10 * time.Hour), end: date(5).Add(15 * time.Hour), interval: IntervalDay,
+			want: []Period{{date(5), date(6)}}},
+		{name: "weeks cut at both ends", start: date(3), end: date(16), interval: IntervalWeek,
+			want: []Period{{date(3), date(8)}, {date(8), date(15)}, {date(15), date(17)}}},
+		{name: "week from monday", start: date(1), end: date(7), interval: IntervalWeek,
+			want: []Period{{date(1), date(8)}}},
+		{name: "end before start", start: date(3), end: date(1), interval: IntervalDay},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Periods(tt.start, tt.end, tt.interval)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Periods() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Start.Equal(tt.want[i].Start) || !got[i].End.Equal(tt.want[i].End) {
+					t.Errorf("Periods()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// dependency types
+const (
+	FinishToStart  = "FS"
+	StartToStart   = "SS"
+	FinishToFinish = "FF"
+	StartToFinish  = "SF"
+)
+
+// lag units
+const (
+	LagDay    = "d"
+	LagHour   = "h"
+	LagMinute = "m"
+)
+
+var (
+	ErrInvalidPredecessor  = errors.New("invalid predecessor")
+	ErrDanglingPredecessor = errors.New("predecessor does not exist")
+	ErrCyclicDependency    = errors.New("cyclic dependency")
+)
+
+// predecessorPattern matches a single predecessor such as "3", "3FS", "5SS+2d" or "7FF-1.5h".
+var predecessorPattern = regexp.MustCompile(`(?i)^([^\s,;+-]+?)\s*(FS|SS|FF|SF)?\s*(?:([+-])\s*(\d+(?:\.\d+)?)\s*(d|days?|h|hours?|m|mins?|minutes?)?)?$`)
+
+// Predecessor is a parsed predecessor reference of a task.
+type Predecessor struct {
+	// 前任任務的前端編號
+	TaskID string
+	// 相依類型
+	Type string
+	// 延遲
+	Lag float64
+	// 延遲單位
+	LagUnit string
+}
+
+// ParsePredecessors parses a predecessor string (e.g. "3FS+2d,5SS") into its references.
+func ParsePredecessors(predecessor string) ([]*Predecessor, error) {
+	var output []*Predecessor
+	fields := strings.FieldsFunc(predecessor, func(r rune) bool {
+		return r == ',' || r == ';'
+	})
+
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		matches := predecessorPattern.FindStringSubmatch(field)
+		if matches == nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidPredecessor, field)
+		}
+
+		item := &Predecessor{
+			TaskID:  matches[1],
+			Type:    strings.ToUpper(matches[2]),
+			LagUnit: LagDay,
+		}
+		if item.Type == "" {
+			item.Type = FinishToStart
+		}
+
+		if matches[4] != "" {
+			lag, err := strconv.ParseFloat(matches[4], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidPredecessor, field)
+			}
+			if matches[3] == "-" {
+				lag = -lag
+			}
+			item.Lag = lag
+			if matches[5] != "" {
+				item.LagUnit = strings.ToLower(matches[5][:1])
+			}
+		}
+
+		output = append(output, item)
+	}
+
+	return output, nil
+}
+
+// FormatPredecessors transforms predecessor references back into the string stored on tasks.
+func FormatPredecessors(predecessors []*Predecessor) string {
+	parts := make([]string, 0, len(predecessors))
+	for _, item := range predecessors {
+		part := item.TaskID + item.Type
+		if item.Lag != 0 {
+			unit := item.LagUnit
+			if unit == "" {
+				unit = LagDay
+			}
+			part += fmt.Sprintf("%+g%s", item.Lag, unit)
+		}
+		parts = append(parts, part)
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// IsInvalid reports whether the error is caused by an invalid predecessor setting of the tasks.
+func IsInvalid(err error) bool {
+	return errors.Is(err, ErrInvalidPredecessor) || errors.Is(err, ErrDanglingPredecessor) || errors.Is(err, ErrCyclicDependency)
+}
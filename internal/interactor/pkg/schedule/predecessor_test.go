@@ -0,0 +1,104 @@
+package schedule
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParsePredecessors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    []*Predecessor
+		wantErr error
+	}{
+		{
+			name: "empty",
+			args: "",
+			want: nil,
+		},
+		{
+			name: "default finish to start",
+			args: "3",
+			want: []*Predecessor{{TaskID: "3", Type: FinishToStart, LagUnit: LagDay}},
+		},
+		{
+			name: "multiple with lag",
+			args: "3FS+2d, 5SS;7ff-1.5h,12SF+30minutes",
+			want: []*Predecessor{
+				{TaskID: "3", Type: FinishToStart, Lag: 2, LagUnit: LagDay},
+				{TaskID: "5", Type: StartToStart, LagUnit: LagDay},
+				{TaskID: "7", Type: FinishToFinish, Lag: -1.5, LagUnit: LagHour},
+				{TaskID: "12", Type: StartToFinish, Lag: 30, LagUnit: LagMinute},
+			},
+		},
+		{
+			name:    "invalid lag",
+			args:    "3FS+2w",
+			wantErr: ErrInvalidPredecessor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePredecessors(tt.args)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParsePredecessors() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParsePredecessors() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatPredecessors(t *testing.T) {
+	input := "3FS+2d,5SS,7FF-1.5h"
+	predecessors, err := ParsePredecessors(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := FormatPredecessors(predecessors); got != input {
+		t.Errorf("FormatPredecessors() = %v, want %v", got, input)
+	}
+}
+
+func TestGraphSort(t *testing.T) {
+	nodes := []string{"a", "b", "c"}
+	tests := []struct {
+		name         string
+		dependencies []*Dependency
+		want         []string
+		wantErr      error
+	}{
+		{
+			name: "chain",
+			dependencies: []*Dependency{
+				{PredecessorUUID: "b", SuccessorUUID: "c"},
+				{PredecessorUUID: "a", SuccessorUUID: "b"},
+			},
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "cycle",
+			dependencies: []*Dependency{
+				{PredecessorUUID: "a", SuccessorUUID: "b"},
+				{PredecessorUUID: "b", SuccessorUUID: "c"},
+				{PredecessorUUID: "c", SuccessorUUID: "b"},
+			},
+			wantErr: ErrCyclicDependency,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewGraph(nodes, tt.dependencies).Sort()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Sort() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Sort() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
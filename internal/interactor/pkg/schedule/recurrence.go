@@ -0,0 +1,209 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// frequencies of the recurrence
+const (
+	Daily   = "daily"
+	Weekly  = "weekly"
+	Monthly = "monthly"
+)
+
+// MaxOccurrences is the maximum number of occurrences of a recurring task.
+const MaxOccurrences = 999
+
+// LastWeek is the week of the month for the last weekday of the month (e.g. the last Friday).
+const LastWeek = -1
+
+var ErrInvalidRecurrence = errors.New("invalid recurrence")
+
+// Recurrence is the rule of a recurring task.
+type Recurrence struct {
+	// Frequency is daily, weekly or monthly.
+	Frequency string
+	// Interval is the number of working days, weeks or months between the occurrences, 1 by default.
+	Interval int
+	// Weekdays are the days of the weekly occurrences, or the weekday of the monthly ones with WeekOfMonth.
+	Weekdays []time.Weekday
+	// DayOfMonth is the day of the monthly occurrences, the last day of the shorter months is used instead.
+	DayOfMonth int
+	// WeekOfMonth is the week (1 to 4, or LastWeek) of the monthly occurrences on a weekday.
+	WeekOfMonth int
+	// Count is the number of occurrences.
+	Count int
+	// Until is the last date of the occurrences, included.
+	Until time.Time
+}
+
+// ParseWeekdays parses the names of the weekdays, e.g. "Monday" or "mon".
+func ParseWeekdays(names []string) ([]time.Weekday, error) {
+	var output []time.Weekday
+	for _, name := range names {
+		days := parseWorkWeek([]string{name})
+		if len(days) != 1 {
+			return nil, fmt.Errorf("%w: unknown weekday %q", ErrInvalidRecurrence, name)
+		}
+
+		for weekday := range days {
+			output = append(output, weekday)
+		}
+	}
+
+	return output, nil
+}
+
+// FormatWeekday returns the lower case name of the weekday, e.g. "monday".
+func FormatWeekday(weekday time.Weekday) string {
+	return strings.ToLower(weekday.String())
+}
+
+// Occurrences returns the start dates of the occurrences of the rule, from the first one on or after start.
+// The daily occurrences fall on every Interval-th working day of the calendar, the weekly and monthly ones on the days
+// of the rule, moved to the next working day when they fall on a non-working day (the occurrences moved onto another one are dropped).
+// The occurrences keep the time of the day of start and stop after Count occurrences or after the day of Until.
+func Occurrences(rule *Recurrence, start time.Time, calendar *WorkCalendar) ([]time.Time, error) {
+	if rule.Count <= 0 && rule.Until.IsZero() {
+		return nil, fmt.Errorf("%w: the count or the until date is required", ErrInvalidRecurrence)
+	}
+	if rule.Count > MaxOccurrences {
+		return nil, fmt.Errorf("%w: the count cannot exceed %d", ErrInvalidRecurrence, MaxOccurrences)
+	}
+	if rule.Interval < 0 || rule.DayOfMonth < 0 || rule.DayOfMonth > 31 || rule.WeekOfMonth < LastWeek || rule.WeekOfMonth > 4 {
+		return nil, fmt.Errorf("%w: the interval, the day or the week of the month is out of range", ErrInvalidRecurrence)
+	}
+
+	if calendar == nil {
+		calendar = NewWorkCalendar(nil, nil, nil, start.Location())
+	}
+
+	interval := rule.Interval
+	if interval == 0 {
+		interval = 1
+	}
+
+	first := calendar.dayStart(start)
+	timeOfDay := start.Sub(first)
+	var until time.Time
+	if !rule.Until.IsZero() {
+		until = calendar.dayStart(rule.Until)
+	}
+
+	var (
+		output []time.Time
+		last   time.Time
+	)
+	// add is a helper function to append the occurrence on the day, reporting whether the occurrences continue.
+	add := func(day time.Time) bool {
+		for i := 0; !calendar.IsWorkingDay(day) && i < maxCalendarDays; i++ {
+			day = day.AddDate(0, 0, 1)
+		}
+		if !until.IsZero() && day.After(until) {
+			return false
+		}
+		if !last.IsZero() && !day.After(last) {
+			return true
+		}
+
+		last = day
+		output = append(output, day.Add(timeOfDay))
+		return rule.Count <= 0 || len(output) < rule.Count
+	}
+
+	switch rule.Frequency {
+	case Daily:
+		day := first
+		for i := 0; i < maxCalendarDays && len(output) <= MaxOccurrences; i++ {
+			if !calendar.IsWorkingDay(day) {
+				day = day.AddDate(0, 0, 1)
+				continue
+			}
+
+			if !add(day) {
+				break
+			}
+
+			// skip to the Interval-th next working day
+			for skipped := 0; skipped < interval && i < maxCalendarDays; i++ {
+				day = day.AddDate(0, 0, 1)
+				if calendar.IsWorkingDay(day) {
+					skipped++
+				}
+			}
+		}
+	case Weekly:
+		weekdays := make(map[time.Weekday]bool)
+		for _, weekday := range rule.Weekdays {
+			weekdays[weekday] = true
+		}
+		if len(weekdays) == 0 {
+			weekdays[first.Weekday()] = true
+		}
+
+		// the weeks start on Sunday
+		week := first.AddDate(0, 0, -int(first.Weekday()))
+	weeks:
+		for i := 0; i*7 < maxCalendarDays && len(output) <= MaxOccurrences; i += interval {
+			for d := 0; d < 7; d++ {
+				day := week.AddDate(0, 0, i*7+d)
+				if day.Before(first) || !weekdays[day.Weekday()] {
+					continue
+				}
+
+				if !add(day) {
+					break weeks
+				}
+			}
+		}
+	case Monthly:
+		weekday := first.Weekday()
+		if len(rule.Weekdays) > 0 {
+			weekday = rule.Weekdays[0]
+		}
+		dayOfMonth := rule.DayOfMonth
+		if dayOfMonth == 0 {
+			dayOfMonth = first.Day()
+		}
+
+		month := time.Date(first.Year(), first.Month(), 1, 0, 0, 0, 0, first.Location())
+		for i := 0; i < maxCalendarDays/28 && len(output) <= MaxOccurrences; i += interval {
+			day := monthDay(month.AddDate(0, i, 0), dayOfMonth, rule.WeekOfMonth, weekday)
+			if day.Before(first) {
+				continue
+			}
+
+			if !add(day) {
+				break
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown frequency %q", ErrInvalidRecurrence, rule.Frequency)
+	}
+
+	if len(output) > MaxOccurrences {
+		return nil, fmt.Errorf("%w: the occurrences cannot exceed %d", ErrInvalidRecurrence, MaxOccurrences)
+	}
+
+	return output, nil
+}
+
+// monthDay is a helper function to get the day of the month, either the day of the month (the last day of the shorter months)
+// or the weekday of the week of the month when the week is given.
+func monthDay(month time.Time, dayOfMonth, weekOfMonth int, weekday time.Weekday) time.Time {
+	lastDay := month.AddDate(0, 1, -1)
+	switch {
+	case weekOfMonth == LastWeek:
+		return lastDay.AddDate(0, 0, -((int(lastDay.Weekday()) - int(weekday) + 7) % 7))
+	case weekOfMonth > 0:
+		firstWeekday := month.AddDate(0, 0, (int(weekday)-int(month.Weekday())+7)%7)
+		return firstWeekday.AddDate(0, 0, (weekOfMonth-1)*7)
+	case dayOfMonth > lastDay.Day():
+		return lastDay
+	default:
+		return month.AddDate(0, 0, dayOfMonth-1)
+	}
+}
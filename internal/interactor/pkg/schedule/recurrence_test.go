@@ -0,0 +1,98 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestOccurrences(t *testing.T) {
+	// 2024-01-01 is a Monday, 2024-01-03 is a holiday
+	calendar := NewWorkCalendar(nil, nil, []Holiday{
+		{Start: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)},
+	}, time.UTC)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		rule *Recurrence
+		// want is the dates of the occurrences
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "daily on working days",
+			rule: &Recurrence{Frequency: Daily, Count: 4},
+			want: []string{"2024-01-01", "2024-01-02", "2024-01-04", "2024-01-05"},
+		},
+		{
+			name: "every other working day until a date",
+			rule: &Recurrence{Frequency: Daily, Interval: 2, Until: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
+			want: []string{"2024-01-01", "2024-01-04", "2024-01-08", "2024-01-10"},
+		},
+		{
+			name: "weekly by weekdays with a holiday moved",
+			rule: &Recurrence{Frequency: Weekly, Weekdays: []time.Weekday{time.Wednesday, time.Friday}, Count: 4},
+			want: []string{"2024-01-04", "2024-01-05", "2024-01-10", "2024-01-12"},
+		},
+		{
+			name: "every other week on the weekday of the start",
+			rule: &Recurrence{Frequency: Weekly, Interval: 2, Count: 3},
+			want: []string{"2024-01-01", "2024-01-15", "2024-01-29"},
+		},
+		{
+			name: "monthly on a day moved off the weekend",
+			rule: &Recurrence{Frequency: Monthly, DayOfMonth: 31, Count: 3},
+			want: []string{"2024-01-31", "2024-02-29", "2024-04-01"},
+		},
+		{
+			name: "monthly on the last friday",
+			rule: &Recurrence{Frequency: Monthly, Weekdays: []time.Weekday{time.Friday}, WeekOfMonth: LastWeek, Count: 2},
+			want: []string{"2024-01-26", "2024-02-23"},
+		},
+		{
+			name: "monthly on the first monday",
+			rule: &Recurrence{Frequency: Monthly, Weekdays: []time.Weekday{time.Monday}, WeekOfMonth: 1, Until: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+			want: []string{"2024-01-01", "2024-02-05", "2024-03-04"},
+		},
+		{
+			name:    "missing count and until",
+			rule:    &Recurrence{Frequency: Daily},
+			wantErr: true,
+		},
+		{
+			name:    "unknown frequency",
+			rule:    &Recurrence{Frequency: "yearly", Count: 1},
+			wantErr: true,
+		},
+		{
+			name:    "too many occurrences",
+			rule:    &Recurrence{Frequency: Daily, Until: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Occurrences(tt.rule, start, calendar)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidRecurrence) {
+					t.Fatalf("Occurrences() error = %v, want ErrInvalidRecurrence", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("Occurrences() = %v, want %v", got, tt.want)
+			}
+
+			for i, date := range got {
+				if date.Format(time.DateOnly) != tt.want[i] || date.Hour() != 9 {
+					t.Errorf("occurrence %d = %v, want %s 09:00", i, date, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+)
+
+// Reschedule moves the successors of the changed activities to the earliest dates allowed by
+// their dependencies and constraints, pushes the As Late As Possible ones against their successors,
+// then rolls the dates of their summary activities up.
+// The activities are updated in place and the moved ones are returned in schedule order.
+func Reschedule(activities []*Activity, dependencies []*Dependency, changed []string, calendar Calendar) ([]*Activity, error) {
+	o := newOutline(activities)
+	activityMap := make(map[string]*Activity)
+	var leaves []string
+	for _, activity := range activities {
+		activityMap[activity.UUID] = activity
+		if !o.isSummary(activity.UUID) {
+			leaves = append(leaves, activity.UUID)
+		}
+	}
+
+	graph := NewGraph(leaves, o.expand(dependencies))
+	order, err := graph.Sort()
+	if err != nil {
+		return nil, err
+	}
+
+	// find the activities which are reachable from the changed ones
+	changedLeaves := make(map[string]bool)
+	for _, uuid := range changed {
+		if _, ok := activityMap[uuid]; !ok {
+			continue
+		}
+		for _, leaf := range o.leaves(uuid) {
+			changedLeaves[leaf] = true
+		}
+	}
+
+	affected := make(map[string]bool)
+	var queue []string
+	for uuid := range changedLeaves {
+		queue = append(queue, uuid)
+	}
+	for len(queue) > 0 {
+		uuid := queue[0]
+		queue = queue[1:]
+		for _, dep := range graph.Successors(uuid) {
+			if !affected[dep.SuccessorUUID] && !changedLeaves[dep.SuccessorUUID] {
+				affected[dep.SuccessorUUID] = true
+				queue = append(queue, dep.SuccessorUUID)
+			}
+		}
+	}
+
+	moved := make(map[string]bool)
+	for _, uuid := range order {
+		if !affected[uuid] {
+			continue
+		}
+
+		activity := activityMap[uuid]
+		span := duration(activity, calendar)
+		start := constrainStart(activity, earliestStart(uuid, graph, activityMap, span, calendar), span, calendar)
+		if start.IsZero() || start.Equal(activity.Start) {
+			continue
+		}
+
+		activity.Start = start
+		activity.End = calendar.Add(start, span)
+		moved[uuid] = true
+	}
+
+	// push the As Late As Possible activities to the latest dates allowed by their successors
+	for i := len(order) - 1; i >= 0; i-- {
+		uuid := order[i]
+		activity := activityMap[uuid]
+		if activity.ConstraintType != AsLateAsPossible || !(affected[uuid] || changedLeaves[uuid] || successorMoved(uuid, graph, moved)) {
+			continue
+		}
+
+		span := duration(activity, calendar)
+		finish := latestFinish(uuid, graph, activityMap, span, calendar)
+		if finish.IsZero() {
+			continue
+		}
+
+		start := calendar.Add(finish, -span)
+		if earliest := earliestStart(uuid, graph, activityMap, span, calendar); !earliest.IsZero() && start.Before(earliest) {
+			start = earliest
+		}
+
+		if start.Equal(activity.Start) {
+			continue
+		}
+
+		activity.Start = start
+		activity.End = calendar.Add(start, span)
+		moved[uuid] = true
+	}
+
+	var output []*Activity
+	for _, uuid := range order {
+		if moved[uuid] {
+			output = append(output, activityMap[uuid])
+		}
+	}
+
+	output = append(output, rollUpDates(activities, o, moved, changedLeaves)...)
+	return output, nil
+}
+
+// earliestStart is a helper function to get the earliest start of the activity allowed by its dated predecessors.
+func earliestStart(uuid string, graph *Graph, activityMap map[string]*Activity, span float64, calendar Calendar) time.Time {
+	var start time.Time
+	for _, dep := range graph.Predecessors(uuid) {
+		predecessor := activityMap[dep.PredecessorUUID]
+		if predecessor.Start.IsZero() || predecessor.End.IsZero() {
+			continue
+		}
+
+		lag := LagDays(calendar, dep.Lag, dep.LagUnit)
+		var earliest time.Time
+		switch dep.Type {
+		case StartToStart:
+			earliest = calendar.Add(predecessor.Start, lag)
+		case FinishToFinish:
+			earliest = calendar.Add(calendar.Add(predecessor.End, lag), -span)
+		case StartToFinish:
+			earliest = calendar.Add(calendar.Add(predecessor.Start, lag), -span)
+		default:
+			earliest = calendar.Add(predecessor.End, lag)
+		}
+
+		if start.IsZero() || earliest.After(start) {
+			start = earliest
+		}
+	}
+
+	return start
+}
+
+// latestFinish is a helper function to get the latest finish of the activity allowed by its dated successors.
+func latestFinish(uuid string, graph *Graph, activityMap map[string]*Activity, span float64, calendar Calendar) time.Time {
+	var finish time.Time
+	for _, dep := range graph.Successors(uuid) {
+		successor := activityMap[dep.SuccessorUUID]
+		if successor.Start.IsZero() || successor.End.IsZero() {
+			continue
+		}
+
+		lag := LagDays(calendar, dep.Lag, dep.LagUnit)
+		var latest time.Time
+		switch dep.Type {
+		case StartToStart:
+			latest = calendar.Add(calendar.Add(successor.Start, -lag), span)
+		case FinishToFinish:
+			latest = calendar.Add(successor.End, -lag)
+		case StartToFinish:
+			latest = calendar.Add(calendar.Add(successor.End, -lag), span)
+		default:
+			latest = calendar.Add(successor.Start, -lag)
+		}
+
+		if finish.IsZero() || latest.Before(finish) {
+			finish = latest
+		}
+	}
+
+	return finish
+}
+
+// successorMoved is a helper function to report whether one of the successors of the activity has been moved.
+func successorMoved(uuid string, graph *Graph, moved map[string]bool) bool {
+	for _, dep := range graph.Successors(uuid) {
+		if moved[dep.SuccessorUUID] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rollUpDates is a helper function to move the summary activities containing the moved or changed ones to span their children.
+func rollUpDates(activities []*Activity, o *outline, moved, changed map[string]bool) []*Activity {
+	touched := make(map[string]bool)
+	for _, set := range []map[string]bool{moved, changed} {
+		for uuid := range set {
+			for parent, ok := o.parent[uuid]; ok; parent, ok = o.parent[parent] {
+				touched[parent] = true
+			}
+		}
+	}
+
+	activityMap := make(map[string]*Activity)
+	var summaries []*Activity
+	for _, activity := range activities {
+		activityMap[activity.UUID] = activity
+		if touched[activity.UUID] {
+			summaries = append(summaries, activity)
+		}
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return o.depth[summaries[i].UUID] > o.depth[summaries[j].UUID]
+	})
+
+	var output []*Activity
+	for _, summary := range summaries {
+		var start, end time.Time
+		for _, child := range o.children[summary.UUID] {
+			activity := activityMap[child]
+			if !activity.Start.IsZero() && (start.IsZero() || activity.Start.Before(start)) {
+				start = activity.Start
+			}
+			if !activity.End.IsZero() && (end.IsZero() || activity.End.After(end)) {
+				end = activity.End
+			}
+		}
+
+		if start.IsZero() || end.IsZero() || (start.Equal(summary.Start) && end.Equal(summary.End)) {
+			continue
+		}
+
+		summary.Start = start
+		summary.End = end
+		output = append(output, summary)
+	}
+
+	return output
+}
@@ -0,0 +1,46 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReschedule(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n float64) time.Time {
+		return Continuous.Add(start, n)
+	}
+
+	// task 1 has been extended by 2 days
+	activities := []*Activity{
+		{UUID: "a", TaskID: "1", OutlineNumber: "1", Start: day(0), End: day(4)},
+		{UUID: "s", TaskID: "2", OutlineNumber: "2", Start: day(2), End: day(5)},
+		{UUID: "b", TaskID: "3", OutlineNumber: "2.1", Predecessor: "1", Start: day(2), End: day(5)},
+		{UUID: "c", TaskID: "4", OutlineNumber: "2.2", Predecessor: "1SS+1d", Start: day(1), End: day(2)},
+		{UUID: "d", TaskID: "5", OutlineNumber: "3", Start: day(0), End: day(1)},
+	}
+
+	dependencies, err := Link(activities)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	moved, err := Reschedule(activities, dependencies, []string{"a"}, Continuous)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][2]time.Time{
+		"b": {day(4), day(7)},
+		"s": {day(1), day(7)},
+	}
+	if len(moved) != len(want) {
+		t.Fatalf("Reschedule() moved %d activities, want %d", len(moved), len(want))
+	}
+	for _, activity := range moved {
+		dates, ok := want[activity.UUID]
+		if !ok || !activity.Start.Equal(dates[0]) || !activity.End.Equal(dates[1]) {
+			t.Errorf("Reschedule() moved %s to %v - %v", activity.UUID, activity.Start, activity.End)
+		}
+	}
+}
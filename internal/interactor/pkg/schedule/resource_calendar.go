@@ -0,0 +1,114 @@
+package schedule
+
+import (
+	"time"
+)
+
+// Exception is a period in which a resource does not follow the working calendar, both dates included.
+// A leave has no work week; a part-time period only works on the days of its work week.
+type Exception struct {
+	// 起始日期
+	Start time.Time
+	// 結束日期
+	End time.Time
+	// 工作日 (空值表示請假)
+	WorkWeek []string
+}
+
+// ResourceCalendar is the working calendar of a resource, with its exceptions layered over the project calendar.
+type ResourceCalendar struct {
+	calendar   *WorkCalendar
+	exceptions []exception
+}
+
+type exception struct {
+	start    string
+	end      string
+	workWeek map[time.Weekday]bool
+}
+
+// NewResourceCalendar builds the working calendar of a resource.
+func NewResourceCalendar(calendar *WorkCalendar, exceptions []Exception) *ResourceCalendar {
+	c := &ResourceCalendar{calendar: calendar}
+	for _, item := range exceptions {
+		if item.Start.IsZero() {
+			continue
+		}
+
+		end := item.End
+		if end.IsZero() || end.Before(item.Start) {
+			end = item.Start
+		}
+
+		c.exceptions = append(c.exceptions, exception{
+			start:    calendar.dayStart(item.Start).Format(time.DateOnly),
+			end:      calendar.dayStart(end).Format(time.DateOnly),
+			workWeek: parseWorkWeek(item.WorkWeek),
+		})
+	}
+
+	return c
+}
+
+// IsWorkingDay reports whether the resource works on the date.
+func (c *ResourceCalendar) IsWorkingDay(t time.Time) bool {
+	if !c.calendar.IsWorkingDay(t) {
+		return false
+	}
+
+	day := c.calendar.dayStart(t)
+	date := day.Format(time.DateOnly)
+	for _, item := range c.exceptions {
+		if date >= item.start && date <= item.end && !item.workWeek[day.Weekday()] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Unavailable returns the working days of the calendar between start and end on which the resource does not work.
+func (c *ResourceCalendar) Unavailable(start, end time.Time) []time.Time {
+	var days []time.Time
+	if len(c.exceptions) == 0 || end.Before(start) {
+		return days
+	}
+
+	for day := c.calendar.dayStart(start); !day.After(end); day = day.AddDate(0, 0, 1) {
+		if c.calendar.IsWorkingDay(day) && !c.IsWorkingDay(day) {
+			days = append(days, day)
+		}
+	}
+
+	return days
+}
+
+// WorkingHours returns the working hours of the resource between start and end.
+func (c *ResourceCalendar) WorkingHours(start, end time.Time) float64 {
+	var hours float64
+	for day := c.calendar.dayStart(start); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if !c.IsWorkingDay(day) {
+			continue
+		}
+
+		from, to := later(day, start), earlier(day.AddDate(0, 0, 1), end)
+		if to.After(from) {
+			hours += c.calendar.Days(from, to) * c.calendar.hoursPerDay
+		}
+	}
+
+	return hours
+}
+
+// Allocate returns the working hours of the assignment from start to end in each of the periods, at the units (in percent) of the resource.
+func (c *ResourceCalendar) Allocate(start, end time.Time, units float64, periods []Period) []float64 {
+	hours := make([]float64, len(periods))
+	for i, period := range periods {
+		from, to := later(period.Start, start), earlier(period.End, end)
+		if to.After(from) {
+			hours[i] = c.WorkingHours(from, to) * units / 100
+		}
+	}
+
+	return hours
+}
@@ -0,0 +1,108 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResourceCalendar(t *testing.T) {
+	location := time.FixedZone("CST", 8*60*60)
+	date := func(day, hour int) time.Time {
+		return time.Date(2024, 2, day, hour, 0, 0, 0, location)
+	}
+
+	// 2024-02-05 is a Monday, and 2024-02-08 is a holiday of the project
+	calendar := NewWorkCalendar(nil, nil, []Holiday{{Start: date(8, 0)}}, location)
+	resource := NewResourceCalendar(calendar, []Exception{
+		// leave on Tuesday
+		{Start: date(6, 0), End: date(6, 0)},
+		// part-time on Mondays and Wednesdays in the next two weeks
+		{Start: date(12, 0), End: date(23, 0), WorkWeek: []string{"Monday", "Wednesday"}},
+	})
+
+	tests := []struct {
+		name        string
+		start       time.Time
+		end         time.Time
+		unavailable []int
+	}{
+		{name: "no exception", start: date(5, 8), end: date(5, 17)},
+		{name: "leave and project holiday", start: date(5, 8), end: date(9, 17), unavailable: []int{6}},
+		{name: "part-time week", start: date(12, 8), end: date(16, 17), unavailable: []int{13, 15, 16}},
+		{name: "end before start", start: date(16, 8), end: date(12, 17)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resource.Unavailable(tt.start, tt.end)
+			if len(got) != len(tt.unavailable) {
+				t.Fatalf("Unavailable() = %v, want days %v", got, tt.unavailable)
+			}
+			for i, day := range tt.unavailable {
+				if !got[i].Equal(date(day, 0)) {
+					t.Errorf("Unavailable()[%d] = %v, want %v", i, got[i], date(day, 0))
+				}
+			}
+		})
+	}
+
+	if !resource.IsWorkingDay(date(14, 10)) {
+		t.Errorf("IsWorkingDay() = false on a part-time working day")
+	}
+}
+
+func TestResourceCalendarWorkingHours(t *testing.T) {
+	location := time.FixedZone("CST", 8*60*60)
+	date := func(day, hour int) time.Time {
+		return time.Date(2024, 2, day, hour, 0, 0, 0, location)
+	}
+
+	// 2024-02-05 is a Monday, and 2024-02-08 is a holiday of the project
+	calendar := NewWorkCalendar(nil, nil, []Holiday{{Start: date(8, 0)}}, location)
+	resource := NewResourceCalendar(calendar, []Exception{
+		{Start: date(6, 0), End: date(6, 0)},
+		{Start: date(12, 0), End: date(23, 0), WorkWeek: []string{"Monday", "Wednesday"}},
+	})
+
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		want  float64
+	}{
+		{name: "one day", start: date(5, 8), end: date(5, 17), want: 9},
+		{name: "leave and project holiday", start: date(5, 0), end: date(10, 0), want: 27},
+		{name: "partial days", start: date(5, 12), end: date(7, 10), want: 7},
+		{name: "part-time week", start: date(12, 0), end: date(17, 0), want: 18},
+		{name: "end before start", start: date(16, 8), end: date(12, 17)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resource.WorkingHours(tt.start, tt.end); got != tt.want {
+				t.Errorf("WorkingHours() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResourceCalendarAllocate(t *testing.T) {
+	location := time.FixedZone("CST", 8*60*60)
+	date := func(day, hour int) time.Time {
+		return time.Date(2024, 2, day, hour, 0, 0, 0, location)
+	}
+
+	calendar := NewWorkCalendar(nil, nil, []Holiday{{Start: date(8, 0)}}, location)
+	resource := NewResourceCalendar(calendar, []Exception{{Start: date(6, 0), End: date(6, 0)}})
+	periods := Periods(date(5, 0), date(12, 0), IntervalWeek)
+
+	// half-time from Monday noon to the next Monday noon
+	got := resource.Allocate(date(5, 12), date(12, 12), 50, periods)
+	want := []float64{(5 + 9 + 9) / 2.0, 4 / 2.0}
+	if len(got) != len(want) {
+		t.Fatalf("Allocate() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Allocate()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
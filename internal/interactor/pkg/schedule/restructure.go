@@ -0,0 +1,150 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// structural operations
+const (
+	Indent     = "indent"
+	Outdent    = "outdent"
+	MoveBefore = "before"
+	MoveAfter  = "after"
+	MoveUnder  = "under"
+)
+
+var ErrInvalidMove = errors.New("invalid move")
+
+// Node is a task in the outline of a project.
+type Node struct {
+	// 任務UUID
+	UUID string
+	// 1.1.2、1.2、1.2.1
+	OutlineNumber string
+}
+
+// Restructure moves the task with its whole subtree and renumbers the outline of the project.
+// Indent makes the task the last child of its previous sibling, Outdent places it right after its parent,
+// and MoveBefore, MoveAfter and MoveUnder place it before or after the target, or as the last child of the target.
+// The nodes must be in outline order; the nodes are returned with their new outline numbers in the new outline order.
+func Restructure(nodes []*Node, uuid, operation, targetUUID string) ([]*Node, error) {
+	activities := make([]*Activity, len(nodes))
+	nodeMap := make(map[string]*Node, len(nodes))
+	for i, node := range nodes {
+		activities[i] = &Activity{UUID: node.UUID, OutlineNumber: node.OutlineNumber}
+		nodeMap[node.UUID] = node
+	}
+
+	if _, ok := nodeMap[uuid]; !ok {
+		return nil, fmt.Errorf("%w: task %s does not exist", ErrInvalidMove, uuid)
+	}
+
+	o := newOutline(activities)
+	var roots []string
+	for _, node := range nodes {
+		if _, ok := o.parent[node.UUID]; !ok {
+			roots = append(roots, node.UUID)
+		}
+	}
+
+	// siblings is a helper function to get the children of the parent, or the top level tasks when the parent is empty.
+	siblings := func(parent string) []string {
+		if parent == "" {
+			return roots
+		}
+		return o.children[parent]
+	}
+
+	setSiblings := func(parent string, list []string) {
+		if parent == "" {
+			roots = list
+			return
+		}
+		o.children[parent] = list
+	}
+
+	parent := o.parent[uuid]
+	index := indexOf(siblings(parent), uuid)
+	var newParent string
+	var position int
+	switch operation {
+	case Indent:
+		if index == 0 {
+			return nil, fmt.Errorf("%w: the first task of its level cannot be indented", ErrInvalidMove)
+		}
+
+		newParent = siblings(parent)[index-1]
+		position = len(o.children[newParent])
+	case Outdent:
+		if parent == "" {
+			return nil, fmt.Errorf("%w: the top level task cannot be outdented", ErrInvalidMove)
+		}
+
+		newParent = o.parent[parent]
+		position = indexOf(siblings(newParent), parent) + 1
+	case MoveBefore, MoveAfter, MoveUnder:
+		if _, ok := nodeMap[targetUUID]; !ok {
+			return nil, fmt.Errorf("%w: target task %s does not exist", ErrInvalidMove, targetUUID)
+		}
+
+		// the task cannot be moved into its own subtree
+		for ancestor := targetUUID; ancestor != ""; ancestor = o.parent[ancestor] {
+			if ancestor == uuid {
+				return nil, fmt.Errorf("%w: the task cannot be moved into its own subtree", ErrInvalidMove)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown operation %q", ErrInvalidMove, operation)
+	}
+
+	// detach the subtree from its parent
+	list := siblings(parent)
+	setSiblings(parent, append(append([]string{}, list[:index]...), list[index+1:]...))
+	delete(o.parent, uuid)
+
+	switch operation {
+	case MoveBefore, MoveAfter:
+		newParent = o.parent[targetUUID]
+		position = indexOf(siblings(newParent), targetUUID)
+		if operation == MoveAfter {
+			position++
+		}
+	case MoveUnder:
+		newParent = targetUUID
+		position = len(o.children[newParent])
+	}
+
+	list = siblings(newParent)
+	list = append(append(append([]string{}, list[:position]...), uuid), list[position:]...)
+	setSiblings(newParent, list)
+	if newParent != "" {
+		o.parent[uuid] = newParent
+	}
+
+	// renumber the outline from the top level down
+	output := make([]*Node, 0, len(nodes))
+	var renumber func(uuids []string, prefix string)
+	renumber = func(uuids []string, prefix string) {
+		for i, item := range uuids {
+			outlineNumber := prefix + strconv.Itoa(i+1)
+			output = append(output, &Node{UUID: item, OutlineNumber: outlineNumber})
+			renumber(o.children[item], outlineNumber+".")
+		}
+	}
+	renumber(roots, "")
+
+	return output, nil
+}
+
+// indexOf is a helper function to get the index of the item in the list, or -1 when it is missing.
+func indexOf(list []string, item string) int {
+	for i, value := range list {
+		if value == item {
+			return i
+		}
+	}
+
+	return -1
+}
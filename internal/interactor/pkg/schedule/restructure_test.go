@@ -0,0 +1,65 @@
+package schedule
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRestructure(t *testing.T) {
+	// a, b (b1, b2 (b21)), c
+	nodes := func() []*Node {
+		return []*Node{
+			{UUID: "a", OutlineNumber: "1"},
+			{UUID: "b", OutlineNumber: "2"},
+			{UUID: "b1", OutlineNumber: "2.1"},
+			{UUID: "b2", OutlineNumber: "2.2"},
+			{UUID: "b21", OutlineNumber: "2.2.1"},
+			{UUID: "c", OutlineNumber: "3"},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		uuid      string
+		operation string
+		target    string
+		want      string
+		wantErr   bool
+	}{
+		{name: "indent", uuid: "b", operation: Indent, want: "a=1 b=1.1 b1=1.1.1 b2=1.1.2 b21=1.1.2.1 c=2"},
+		{name: "indent under a summary", uuid: "c", operation: Indent, want: "a=1 b=2 b1=2.1 b2=2.2 b21=2.2.1 c=2.3"},
+		{name: "indent the first task", uuid: "b1", operation: Indent, wantErr: true},
+		{name: "outdent", uuid: "b1", operation: Outdent, want: "a=1 b=2 b2=2.1 b21=2.1.1 b1=3 c=4"},
+		{name: "outdent with subtree", uuid: "b21", operation: Outdent, want: "a=1 b=2 b1=2.1 b2=2.2 b21=2.3 c=3"},
+		{name: "outdent the top level", uuid: "a", operation: Outdent, wantErr: true},
+		{name: "move before", uuid: "c", operation: MoveBefore, target: "a", want: "c=1 a=2 b=3 b1=3.1 b2=3.2 b21=3.2.1"},
+		{name: "move after", uuid: "b2", operation: MoveAfter, target: "c", want: "a=1 b=2 b1=2.1 c=3 b2=4 b21=4.1"},
+		{name: "move under", uuid: "a", operation: MoveUnder, target: "b21", want: "b=1 b1=1.1 b2=1.2 b21=1.2.1 a=1.2.1.1 c=2"},
+		{name: "move into its own subtree", uuid: "b", operation: MoveUnder, target: "b21", wantErr: true},
+		{name: "move before itself", uuid: "b", operation: MoveBefore, target: "b", wantErr: true},
+		{name: "unknown target", uuid: "a", operation: MoveAfter, target: "x", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Restructure(nodes(), tt.uuid, tt.operation, tt.target)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidMove) {
+					t.Fatalf("Restructure() error = %v, want %v", err, ErrInvalidMove)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var outline []string
+			for _, node := range got {
+				outline = append(outline, node.UUID+"="+node.OutlineNumber)
+			}
+			if strings.Join(outline, " ") != tt.want {
+				t.Errorf("Restructure() = %s, want %s", strings.Join(outline, " "), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+)
+
+// Rollup is the values of an activity which are rolled up to its summary activity.
+type Rollup struct {
+	UUID          string
+	OutlineNumber string
+	Start         time.Time
+	End           time.Time
+	// Duration is in days of the calendar.
+	Duration float64
+	Cost     float64
+	// Progress is the percentage of the activity which is complete.
+	Progress  float64
+	IsSummary bool
+}
+
+// RollUpSummaries derives the values of the summary activities from their children, from the deepest level up:
+// the earliest start, the latest end, the working days between them, the sum of the costs and the progress weighted
+// by the durations of the children (or their average when none of them has a duration).
+// The summary activities are updated in place and returned from the deepest level up.
+func RollUpSummaries(items []*Rollup, calendar Calendar) []*Rollup {
+	if calendar == nil {
+		calendar = Continuous
+	}
+
+	activities := make([]*Activity, len(items))
+	itemMap := make(map[string]*Rollup, len(items))
+	for i, item := range items {
+		activities[i] = &Activity{UUID: item.UUID, OutlineNumber: item.OutlineNumber}
+		itemMap[item.UUID] = item
+	}
+
+	o := newOutline(activities)
+	var summaries []*Rollup
+	for _, item := range items {
+		item.IsSummary = o.isSummary(item.UUID)
+		if item.IsSummary {
+			summaries = append(summaries, item)
+		}
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return o.depth[summaries[i].UUID] > o.depth[summaries[j].UUID]
+	})
+
+	for _, summary := range summaries {
+		var (
+			start, end           time.Time
+			cost, duration       float64
+			weighted, unweighted float64
+		)
+		children := o.children[summary.UUID]
+		for _, uuid := range children {
+			child := itemMap[uuid]
+			start = earlier(start, child.Start)
+			end = later(end, child.End)
+			cost += child.Cost
+			duration += child.Duration
+			weighted += child.Progress * child.Duration
+			unweighted += child.Progress
+		}
+
+		// the dates of the summary without any dated child are kept
+		if !start.IsZero() && !end.IsZero() {
+			summary.Start, summary.End = start, end
+			summary.Duration = 0
+			if end.After(start) {
+				summary.Duration = roundValue(calendar.Days(start, end))
+			}
+		}
+
+		summary.Cost = cost
+		if duration > 0 {
+			summary.Progress = roundValue(weighted / duration)
+		} else {
+			summary.Progress = roundValue(unweighted / float64(len(children)))
+		}
+	}
+
+	return summaries
+}
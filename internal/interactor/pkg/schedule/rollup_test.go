@@ -0,0 +1,66 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRollUpSummaries(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n float64) time.Time {
+		return Continuous.Add(start, n)
+	}
+
+	items := []*Rollup{
+		{UUID: "p", OutlineNumber: "1", Start: day(5), End: day(6), Duration: 1, Cost: 1, Progress: 90},
+		{UUID: "s", OutlineNumber: "1.1"},
+		{UUID: "a", OutlineNumber: "1.1.1", Start: day(0), End: day(1), Duration: 1, Cost: 100, Progress: 100},
+		{UUID: "b", OutlineNumber: "1.1.2", Start: day(1), End: day(4), Duration: 3, Cost: 50},
+		{UUID: "c", OutlineNumber: "1.2", Start: day(2), End: day(8), Duration: 6, Cost: 30, Progress: 50},
+		{UUID: "m", OutlineNumber: "2"},
+		{UUID: "m1", OutlineNumber: "2.1", Start: day(3), End: day(3), Progress: 100},
+		{UUID: "m2", OutlineNumber: "2.2", Start: day(4), End: day(4)},
+		{UUID: "e", OutlineNumber: "3", Start: day(1), End: day(2), Duration: 1, Progress: 10},
+		{UUID: "u", OutlineNumber: "4", Start: day(9), End: day(10), Duration: 1},
+		{UUID: "u1", OutlineNumber: "4.1", Duration: 2, Progress: 20},
+	}
+	summaries := RollUpSummaries(items, Continuous)
+	if len(summaries) != 4 || summaries[0].UUID != "s" {
+		t.Fatalf("RollUpSummaries() returned %d summaries, want 4 from the deepest level up", len(summaries))
+	}
+
+	tests := []struct {
+		uuid     string
+		summary  bool
+		start    float64
+		end      float64
+		duration float64
+		cost     float64
+		progress float64
+	}{
+		{uuid: "s", summary: true, start: 0, end: 4, duration: 4, cost: 150, progress: 25},
+		{uuid: "p", summary: true, start: 0, end: 8, duration: 8, cost: 180, progress: 40},
+		{uuid: "m", summary: true, start: 3, end: 4, duration: 1, progress: 50},
+		{uuid: "e", start: 1, end: 2, duration: 1, progress: 10},
+		{uuid: "u", summary: true, start: 9, end: 10, duration: 1, progress: 20},
+	}
+	itemMap := make(map[string]*Rollup)
+	for _, item := range items {
+		itemMap[item.UUID] = item
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uuid, func(t *testing.T) {
+			got := itemMap[tt.uuid]
+			if got.IsSummary != tt.summary {
+				t.Errorf("IsSummary = %v, want %v", got.IsSummary, tt.summary)
+			}
+			if !got.Start.Equal(day(tt.start)) || !got.End.Equal(day(tt.end)) {
+				t.Errorf("dates = %v - %v, want %v - %v", got.Start, got.End, day(tt.start), day(tt.end))
+			}
+			if got.Duration != tt.duration || got.Cost != tt.cost || got.Progress != tt.progress {
+				t.Errorf("Duration, Cost, Progress = %v, %v, %v, want %v, %v, %v", got.Duration, got.Cost, got.Progress, tt.duration, tt.cost, tt.progress)
+			}
+		})
+	}
+}
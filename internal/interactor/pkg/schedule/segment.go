@@ -0,0 +1,106 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// segmentTolerance is the difference in days of the calendar ignored when checking the segments.
+const segmentTolerance = 0.01
+
+var ErrInvalidSegments = errors.New("invalid segments")
+
+// Segment is a working part of a split activity, Duration days of the calendar from Start.
+type Segment struct {
+	Start    time.Time
+	Duration float64
+}
+
+// Split is a split activity: its normalized segments, and its dates and duration derived from them.
+type Split struct {
+	Segments []Segment
+	Start    time.Time
+	Finish   time.Time
+	// Duration is the total duration of the segments.
+	Duration float64
+}
+
+// SplitSegments checks the segments of an activity and normalizes them. The segments must have a start date and a
+// positive duration, and, when they are given, lie between the start and finish of the activity and add up to its
+// duration. The normalized segments are sorted by their start, the overlapping ones merged into one.
+// The returned error wraps ErrInvalidSegments and lists every problem found.
+func SplitSegments(segments []Segment, start, finish *time.Time, duration *float64, calendar Calendar) (*Split, error) {
+	if calendar == nil {
+		calendar = Continuous
+	}
+
+	var (
+		problems []string
+		total    float64
+	)
+	for i, segment := range segments {
+		if segment.Start.IsZero() {
+			problems = append(problems, fmt.Sprintf("segment %d has no start date", i+1))
+			continue
+		}
+		if segment.Duration <= 0 {
+			problems = append(problems, fmt.Sprintf("segment %d has a duration of %g days, it must be positive", i+1, segment.Duration))
+			continue
+		}
+
+		total += segment.Duration
+		if start != nil && calendar.Days(*start, segment.Start) < -segmentTolerance {
+			problems = append(problems, fmt.Sprintf("segment %d starts before the start date of the task", i+1))
+		}
+		if finish != nil && calendar.Days(*finish, calendar.Add(segment.Start, segment.Duration)) > segmentTolerance {
+			problems = append(problems, fmt.Sprintf("segment %d ends after the end date of the task", i+1))
+		}
+	}
+
+	if len(segments) == 0 {
+		problems = append(problems, "no segments")
+	}
+	if len(problems) == 0 && duration != nil && math.Abs(total-*duration) > segmentTolerance {
+		problems = append(problems, fmt.Sprintf("the segments add up to %g days, not to the duration of %g days", round(total), *duration))
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSegments, strings.Join(problems, "; "))
+	}
+
+	sorted := make([]Segment, len(segments))
+	copy(sorted, segments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	// merge the segments starting before the end of the previous one
+	output := &Split{}
+	var end time.Time
+	for _, segment := range sorted {
+		segmentEnd := calendar.Add(segment.Start, segment.Duration)
+		last := len(output.Segments) - 1
+		if last >= 0 && segment.Start.Before(end) {
+			if segmentEnd.After(end) {
+				end = segmentEnd
+				output.Segments[last].Duration = round(calendar.Days(output.Segments[last].Start, end))
+			}
+			continue
+		}
+
+		output.Segments = append(output.Segments, segment)
+		end = segmentEnd
+	}
+
+	output.Start = output.Segments[0].Start
+	output.Finish = end
+	for _, segment := range output.Segments {
+		output.Duration += segment.Duration
+	}
+	output.Duration = round(output.Duration)
+
+	return output, nil
+}
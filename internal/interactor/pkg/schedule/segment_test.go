@@ -0,0 +1,104 @@
+package schedule
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSplitSegments(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n float64) time.Time {
+		return Continuous.Add(start, n)
+	}
+	finish := day(5)
+
+	tests := []struct {
+		name     string
+		segments []Segment
+		start    *time.Time
+		finish   *time.Time
+		duration *float64
+		want     []Segment
+		// wantFinish and wantDuration are the finish and the duration of the split activity
+		wantFinish   float64
+		wantDuration float64
+		// wantErr are the problems expected in the error
+		wantErr []string
+	}{
+		{
+			name:         "sorted and within the task",
+			segments:     []Segment{{Start: day(3), Duration: 2}, {Start: day(0), Duration: 1}},
+			start:        &start,
+			finish:       &finish,
+			duration:     ptr(3),
+			want:         []Segment{{Start: day(0), Duration: 1}, {Start: day(3), Duration: 2}},
+			wantFinish:   5,
+			wantDuration: 3,
+		},
+		{
+			name:         "overlapping segments merged",
+			segments:     []Segment{{Start: day(0), Duration: 2}, {Start: day(1), Duration: 2}, {Start: day(1.5), Duration: 0.5}, {Start: day(5), Duration: 1}},
+			duration:     ptr(5.5),
+			want:         []Segment{{Start: day(0), Duration: 3}, {Start: day(5), Duration: 1}},
+			wantFinish:   6,
+			wantDuration: 4,
+		},
+		{
+			name:     "every problem listed",
+			segments: []Segment{{Start: day(-1), Duration: 1}, {Duration: 1}, {Start: day(4), Duration: 0}, {Start: day(4), Duration: 2}},
+			start:    &start,
+			finish:   &finish,
+			wantErr: []string{
+				"segment 1 starts before the start date of the task",
+				"segment 2 has no start date",
+				"segment 3 has a duration of 0 days",
+				"segment 4 ends after the end date of the task",
+			},
+		},
+		{
+			name:     "total different from the duration",
+			segments: []Segment{{Start: day(0), Duration: 1}, {Start: day(2), Duration: 1}},
+			duration: ptr(3),
+			wantErr:  []string{"the segments add up to 2 days, not to the duration of 3 days"},
+		},
+		{
+			name:    "no segments",
+			wantErr: []string{"no segments"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SplitSegments(tt.segments, tt.start, tt.finish, tt.duration, Continuous)
+			if len(tt.wantErr) > 0 {
+				if !errors.Is(err, ErrInvalidSegments) {
+					t.Fatalf("SplitSegments() error = %v, want ErrInvalidSegments", err)
+				}
+				for _, problem := range tt.wantErr {
+					if !strings.Contains(err.Error(), problem) {
+						t.Errorf("SplitSegments() error = %v, want %q", err, problem)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(got.Segments) != len(tt.want) {
+				t.Fatalf("SplitSegments() segments = %v, want %v", got.Segments, tt.want)
+			}
+			for i, segment := range got.Segments {
+				if !segment.Start.Equal(tt.want[i].Start) || segment.Duration != tt.want[i].Duration {
+					t.Errorf("segment %d = %v, want %v", i, segment, tt.want[i])
+				}
+			}
+
+			if !got.Start.Equal(tt.want[0].Start) || !got.Finish.Equal(day(tt.wantFinish)) || got.Duration != tt.wantDuration {
+				t.Errorf("SplitSegments() = %v to %v (%g days), want %v to %v (%g days)",
+					got.Start, got.Finish, got.Duration, tt.want[0].Start, day(tt.wantFinish), tt.wantDuration)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+)
+
+// statuses of a task
+const (
+	StatusNotStarted = "not_started"
+	StatusInProgress = "in_progress"
+	StatusBlocked    = "blocked"
+	StatusOnHold     = "on_hold"
+	StatusDone       = "done"
+	StatusCancelled  = "cancelled"
+)
+
+var ErrInvalidStatus = errors.New("invalid status")
+
+// Statuses are the statuses of a task in their usual order.
+var Statuses = []string{StatusNotStarted, StatusInProgress, StatusBlocked, StatusOnHold, StatusDone, StatusCancelled}
+
+// Transitions maps a status to the statuses a task can change to from it.
+type Transitions map[string][]string
+
+// DefaultTransitions returns the transitions used by the project types without their own ones.
+func DefaultTransitions() Transitions {
+	return Transitions{
+		StatusNotStarted: {StatusInProgress, StatusBlocked, StatusOnHold, StatusDone, StatusCancelled},
+		StatusInProgress: {StatusBlocked, StatusOnHold, StatusDone, StatusCancelled},
+		StatusBlocked:    {StatusInProgress, StatusOnHold, StatusCancelled},
+		StatusOnHold:     {StatusNotStarted, StatusInProgress, StatusCancelled},
+		StatusDone:       {StatusInProgress},
+		StatusCancelled:  {StatusNotStarted},
+	}
+}
+
+// IsStatus reports whether the status is one of the statuses of a task.
+func IsStatus(status string) bool {
+	for _, s := range Statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewTransitions checks the transitions, which must be between the statuses of a task. It returns the default transitions
+// when none are given, and an error wrapping ErrInvalidStatus otherwise.
+func NewTransitions(transitions map[string][]string) (Transitions, error) {
+	if len(transitions) == 0 {
+		return DefaultTransitions(), nil
+	}
+
+	for from, statuses := range transitions {
+		if !IsStatus(from) {
+			return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidStatus, from)
+		}
+
+		for _, to := range statuses {
+			if !IsStatus(to) {
+				return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidStatus, to)
+			}
+		}
+	}
+
+	return transitions, nil
+}
+
+// Allows reports whether a task can change from the status to the other one. Keeping the same status is always allowed.
+func (t Transitions) Allows(from, to string) bool {
+	if from == to {
+		return true
+	}
+
+	for _, status := range t[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StatusOf returns the status implied by the progress of a task without one.
+func StatusOf(progress int64) string {
+	switch {
+	case progress >= 100:
+		return StatusDone
+	case progress > 0:
+		return StatusInProgress
+	default:
+		return StatusNotStarted
+	}
+}
+
+// ResolveStatus checks the change of the status and the progress of a task, from its current ones (an empty status for a new task),
+// and returns the resolved status and progress. A nil status or progress is not changed. The status must follow the transitions,
+// a done task is 100% complete and a not started task has no progress: the progress of the done or cancelled tasks cannot be changed,
+// and a not started task with progress is in progress. The status of a new task without one is implied by its progress.
+// The returned error wraps ErrInvalidStatus.
+func ResolveStatus(current string, currentProgress int64, status *string, progress *int64, transitions Transitions) (string, int64, error) {
+	resolved, resolvedProgress := current, currentProgress
+	if progress != nil {
+		if *progress < 0 || *progress > 100 {
+			return "", 0, fmt.Errorf("%w: the progress must be between 0 and 100, got %d", ErrInvalidStatus, *progress)
+		}
+
+		resolvedProgress = *progress
+	}
+
+	if status == nil {
+		if current == "" {
+			return StatusOf(resolvedProgress), resolvedProgress, nil
+		}
+
+		if (current == StatusDone || current == StatusCancelled) && resolvedProgress != currentProgress {
+			return "", 0, fmt.Errorf("%w: the progress of the %s task cannot be changed", ErrInvalidStatus, current)
+		}
+
+		if current == StatusNotStarted && resolvedProgress > 0 {
+			started := StatusInProgress
+			status = &started
+		}
+	}
+
+	if status != nil {
+		if !IsStatus(*status) {
+			return "", 0, fmt.Errorf("%w: unknown status %q", ErrInvalidStatus, *status)
+		}
+
+		if current != "" && !transitions.Allows(current, *status) {
+			return "", 0, fmt.Errorf("%w: the task cannot change from %s to %s", ErrInvalidStatus, current, *status)
+		}
+
+		resolved = *status
+	}
+
+	switch resolved {
+	case StatusDone:
+		if progress != nil && *progress != 100 {
+			return "", 0, fmt.Errorf("%w: the done task must be 100%% complete, got %d%%", ErrInvalidStatus, *progress)
+		}
+		resolvedProgress = 100
+	case StatusNotStarted:
+		if progress != nil && *progress != 0 {
+			return "", 0, fmt.Errorf("%w: the not started task cannot have progress, got %d%%", ErrInvalidStatus, *progress)
+		}
+		resolvedProgress = 0
+	}
+
+	return resolved, resolvedProgress, nil
+}
+
+// ConsistentStatus returns the status kept consistent with the progress rolled up from the subtasks of a summary task:
+// the done summary task below 100% and the not started one with progress are in progress.
+func ConsistentStatus(status string, progress int64) string {
+	switch {
+	case status == "":
+		return StatusOf(progress)
+	case status == StatusDone && progress < 100:
+		return StatusInProgress
+	case status == StatusNotStarted && progress > 0:
+		return StatusInProgress
+	default:
+		return status
+	}
+}
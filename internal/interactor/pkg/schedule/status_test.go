@@ -0,0 +1,128 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewTransitions(t *testing.T) {
+	tests := []struct {
+		name        string
+		transitions map[string][]string
+		from        string
+		to          string
+		want        bool
+		wantErr     bool
+	}{
+		{name: "default", from: StatusInProgress, to: StatusBlocked, want: true},
+		{name: "default reopen", from: StatusDone, to: StatusInProgress, want: true},
+		{name: "default not allowed", from: StatusCancelled, to: StatusDone},
+		{name: "same status", transitions: map[string][]string{StatusNotStarted: {}}, from: StatusBlocked, to: StatusBlocked, want: true},
+		{name: "configured", transitions: map[string][]string{StatusNotStarted: {StatusDone}}, from: StatusNotStarted, to: StatusDone, want: true},
+		{name: "configured not allowed", transitions: map[string][]string{StatusNotStarted: {StatusDone}}, from: StatusNotStarted, to: StatusInProgress},
+		{name: "unknown from", transitions: map[string][]string{"closed": {StatusDone}}, wantErr: true},
+		{name: "unknown to", transitions: map[string][]string{StatusDone: {"closed"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transitions, err := NewTransitions(tt.transitions)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidStatus) {
+					t.Errorf("NewTransitions() error = %v, want %v", err, ErrInvalidStatus)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := transitions.Allows(tt.from, tt.to); got != tt.want {
+				t.Errorf("Allows(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveStatus(t *testing.T) {
+	status := func(s string) *string {
+		return &s
+	}
+	progress := func(p int64) *int64 {
+		return &p
+	}
+
+	tests := []struct {
+		name            string
+		current         string
+		currentProgress int64
+		status          *string
+		progress        *int64
+		want            string
+		wantProgress    int64
+		wantErr         bool
+	}{
+		{name: "new task from its progress", progress: progress(40), want: StatusInProgress, wantProgress: 40},
+		{name: "new task without progress", want: StatusNotStarted},
+		{name: "new done task", status: status(StatusDone), want: StatusDone, wantProgress: 100},
+		{name: "new blocked task", status: status(StatusBlocked), progress: progress(30), want: StatusBlocked, wantProgress: 30},
+		{name: "unchanged", current: StatusBlocked, currentProgress: 40, want: StatusBlocked, wantProgress: 40},
+		{name: "progress of a blocked task", current: StatusBlocked, currentProgress: 40, progress: progress(50), want: StatusBlocked, wantProgress: 50},
+		{name: "started by its progress", current: StatusNotStarted, progress: progress(10), want: StatusInProgress, wantProgress: 10},
+		{name: "blocked", current: StatusInProgress, currentProgress: 40, status: status(StatusBlocked), want: StatusBlocked, wantProgress: 40},
+		{name: "done completes the task", current: StatusInProgress, currentProgress: 40, status: status(StatusDone), want: StatusDone, wantProgress: 100},
+		{name: "done with 100%", current: StatusInProgress, currentProgress: 40, status: status(StatusDone), progress: progress(100), want: StatusDone, wantProgress: 100},
+		{name: "reopened", current: StatusDone, currentProgress: 100, status: status(StatusInProgress), progress: progress(80), want: StatusInProgress, wantProgress: 80},
+		{name: "restarted clears the progress", current: StatusOnHold, currentProgress: 20, status: status(StatusNotStarted), want: StatusNotStarted},
+		{name: "done below 100%", current: StatusInProgress, currentProgress: 40, status: status(StatusDone), progress: progress(90), wantErr: true},
+		{name: "not started with progress", current: StatusOnHold, status: status(StatusNotStarted), progress: progress(20), wantErr: true},
+		{name: "progress of a done task", current: StatusDone, currentProgress: 100, progress: progress(90), wantErr: true},
+		{name: "progress of a cancelled task", current: StatusCancelled, currentProgress: 20, progress: progress(30), wantErr: true},
+		{name: "transition not allowed", current: StatusCancelled, status: status(StatusDone), wantErr: true},
+		{name: "unknown status", current: StatusInProgress, status: status("closed"), wantErr: true},
+		{name: "progress out of range", current: StatusInProgress, progress: progress(120), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotProgress, err := ResolveStatus(tt.current, tt.currentProgress, tt.status, tt.progress, DefaultTransitions())
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidStatus) {
+					t.Errorf("ResolveStatus() error = %v, want %v", err, ErrInvalidStatus)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want || gotProgress != tt.wantProgress {
+				t.Errorf("ResolveStatus() = %s, %d, want %s, %d", got, gotProgress, tt.want, tt.wantProgress)
+			}
+		})
+	}
+
+	// a not started task cannot start when the transition is not configured
+	transitions := Transitions{StatusNotStarted: {StatusCancelled}}
+	if _, _, err := ResolveStatus(StatusNotStarted, 0, nil, progress(10), transitions); !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("ResolveStatus() error = %v, want %v", err, ErrInvalidStatus)
+	}
+}
+
+func TestConsistentStatus(t *testing.T) {
+	tests := []struct {
+		status   string
+		progress int64
+		want     string
+	}{
+		{status: "", progress: 100, want: StatusDone},
+		{status: StatusDone, progress: 100, want: StatusDone},
+		{status: StatusDone, progress: 80, want: StatusInProgress},
+		{status: StatusNotStarted, progress: 10, want: StatusInProgress},
+		{status: StatusNotStarted, want: StatusNotStarted},
+		{status: StatusBlocked, progress: 100, want: StatusBlocked},
+	}
+	for _, tt := range tests {
+		if got := ConsistentStatus(tt.status, tt.progress); got != tt.want {
+			t.Errorf("ConsistentStatus(%s, %d) = %s, want %s", tt.status, tt.progress, got, tt.want)
+		}
+	}
+}
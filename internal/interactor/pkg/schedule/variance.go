@@ -0,0 +1,167 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+)
+
+// Variance is the difference of an activity between its baseline and its current schedule.
+type Variance struct {
+	UUID          string
+	OutlineNumber string
+	// HasBaseline reports whether the activity (or one of its descendants) is in the baseline.
+	HasBaseline      bool
+	BaselineStart    time.Time
+	BaselineFinish   time.Time
+	BaselineDuration float64
+	BaselineCost     float64
+	Start            time.Time
+	Finish           time.Time
+	Duration         float64
+	Cost             float64
+	IsSummary        bool
+	// StartVariance and FinishVariance are in days of the calendar, positive when the activity slips.
+	StartVariance    *float64
+	FinishVariance   *float64
+	DurationVariance *float64
+	CostVariance     *float64
+}
+
+// RollUpVariances fills in the variances of the activities, rolling the dates, durations and costs
+// of the summary activities up from their children first.
+func RollUpVariances(variances []*Variance, calendar Calendar) {
+	if calendar == nil {
+		calendar = Continuous
+	}
+
+	activities := make([]*Activity, len(variances))
+	varianceMap := make(map[string]*Variance, len(variances))
+	for i, variance := range variances {
+		activities[i] = &Activity{UUID: variance.UUID, OutlineNumber: variance.OutlineNumber}
+		varianceMap[variance.UUID] = variance
+	}
+
+	// the deepest summaries first, so that every child has been rolled up before its parent
+	o := newOutline(activities)
+	summaries := make([]*Variance, 0)
+	for _, variance := range variances {
+		if o.isSummary(variance.UUID) {
+			summaries = append(summaries, variance)
+		}
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return o.depth[summaries[i].UUID] > o.depth[summaries[j].UUID]
+	})
+
+	for _, summary := range summaries {
+		rollUpVariance(summary, o.children[summary.UUID], varianceMap, calendar)
+	}
+
+	for _, variance := range variances {
+		variance.StartVariance, variance.FinishVariance = nil, nil
+		variance.DurationVariance, variance.CostVariance = nil, nil
+		if !variance.HasBaseline {
+			continue
+		}
+
+		if !variance.BaselineStart.IsZero() && !variance.Start.IsZero() {
+			variance.StartVariance = dayVariance(calendar, variance.BaselineStart, variance.Start)
+		}
+
+		if !variance.BaselineFinish.IsZero() && !variance.Finish.IsZero() {
+			variance.FinishVariance = dayVariance(calendar, variance.BaselineFinish, variance.Finish)
+		}
+
+		durationVariance := roundValue(variance.Duration - variance.BaselineDuration)
+		costVariance := variance.Cost - variance.BaselineCost
+		variance.DurationVariance = &durationVariance
+		variance.CostVariance = &costVariance
+	}
+}
+
+// SortBySlippage sorts the variances from the worst finish slippage to the best,
+// then by the start slippage; the activities that cannot be compared are kept last.
+func SortBySlippage(variances []*Variance) {
+	sort.SliceStable(variances, func(i, j int) bool {
+		if c := compareSlippage(variances[i].FinishVariance, variances[j].FinishVariance); c != 0 {
+			return c > 0
+		}
+
+		return compareSlippage(variances[i].StartVariance, variances[j].StartVariance) > 0
+	})
+}
+
+// rollUpVariance is a helper function to replace the dates, durations and costs of the summary by the ones of its children.
+func rollUpVariance(summary *Variance, children []string, varianceMap map[string]*Variance, calendar Calendar) {
+	summary.IsSummary = true
+	summary.HasBaseline = false
+	summary.BaselineStart, summary.BaselineFinish = time.Time{}, time.Time{}
+	summary.Start, summary.Finish = time.Time{}, time.Time{}
+	summary.BaselineCost, summary.Cost = 0, 0
+	for _, uuid := range children {
+		child := varianceMap[uuid]
+		summary.Start = earlier(summary.Start, child.Start)
+		summary.Finish = later(summary.Finish, child.Finish)
+		summary.Cost += child.Cost
+		if !child.HasBaseline {
+			continue
+		}
+
+		summary.HasBaseline = true
+		summary.BaselineStart = earlier(summary.BaselineStart, child.BaselineStart)
+		summary.BaselineFinish = later(summary.BaselineFinish, child.BaselineFinish)
+		summary.BaselineCost += child.BaselineCost
+	}
+
+	summary.Duration, summary.BaselineDuration = 0, 0
+	if !summary.Start.IsZero() && summary.Finish.After(summary.Start) {
+		summary.Duration = round(calendar.Days(summary.Start, summary.Finish))
+	}
+
+	if !summary.BaselineStart.IsZero() && summary.BaselineFinish.After(summary.BaselineStart) {
+		summary.BaselineDuration = round(calendar.Days(summary.BaselineStart, summary.BaselineFinish))
+	}
+}
+
+// dayVariance is a helper function to get the days of the calendar from the baseline date to the current date.
+func dayVariance(calendar Calendar, baseline, current time.Time) *float64 {
+	days := roundValue(calendar.Days(baseline, current))
+	return &days
+}
+
+// compareSlippage is a helper function to compare two variances, where a missing variance is the smallest.
+func compareSlippage(a, b *float64) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	case *a > *b:
+		return 1
+	case *a < *b:
+		return -1
+	}
+
+	return 0
+}
+
+// earlier is a helper function to get the earlier of two dates, ignoring the zero one.
+func earlier(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+
+	return a
+}
+
+// later is a helper function to get the later of two dates, ignoring the zero one.
+func later(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+
+	return a
+}
@@ -0,0 +1,85 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRollUpVariances(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n float64) time.Time {
+		return Continuous.Add(start, n)
+	}
+
+	variances := []*Variance{
+		{UUID: "s", OutlineNumber: "1"},
+		{UUID: "a", OutlineNumber: "1.1", HasBaseline: true, BaselineStart: day(0), BaselineFinish: day(2), BaselineDuration: 2, BaselineCost: 100,
+			Start: day(1), Finish: day(3), Duration: 2, Cost: 150},
+		{UUID: "b", OutlineNumber: "1.2", HasBaseline: true, BaselineStart: day(2), BaselineFinish: day(4), BaselineDuration: 2, BaselineCost: 50,
+			Start: day(3), Finish: day(6), Duration: 3, Cost: 50},
+		{UUID: "c", OutlineNumber: "1.3", Start: day(6), Finish: day(7), Duration: 1, Cost: 20},
+		{UUID: "d", OutlineNumber: "2", HasBaseline: true, BaselineStart: day(0), BaselineFinish: day(1), BaselineDuration: 1,
+			Start: day(0), Finish: day(1), Duration: 1},
+	}
+	RollUpVariances(variances, Continuous)
+
+	tests := []struct {
+		uuid     string
+		summary  bool
+		start    *float64
+		finish   *float64
+		duration *float64
+		cost     *float64
+	}{
+		{uuid: "s", summary: true, start: ptr(1), finish: ptr(3), duration: ptr(2), cost: ptr(70)},
+		{uuid: "a", start: ptr(1), finish: ptr(1), duration: ptr(0), cost: ptr(50)},
+		{uuid: "b", start: ptr(1), finish: ptr(2), duration: ptr(1), cost: ptr(0)},
+		{uuid: "c"},
+		{uuid: "d", start: ptr(0), finish: ptr(0), duration: ptr(0), cost: ptr(0)},
+	}
+	for i, tt := range tests {
+		t.Run(tt.uuid, func(t *testing.T) {
+			got := variances[i]
+			if got.IsSummary != tt.summary {
+				t.Errorf("IsSummary = %v, want %v", got.IsSummary, tt.summary)
+			}
+			assertVariance(t, "StartVariance", got.StartVariance, tt.start)
+			assertVariance(t, "FinishVariance", got.FinishVariance, tt.finish)
+			assertVariance(t, "DurationVariance", got.DurationVariance, tt.duration)
+			assertVariance(t, "CostVariance", got.CostVariance, tt.cost)
+		})
+	}
+}
+
+func TestSortBySlippage(t *testing.T) {
+	variances := []*Variance{
+		{UUID: "none"},
+		{UUID: "early", FinishVariance: ptr(-1), StartVariance: ptr(0)},
+		{UUID: "late", FinishVariance: ptr(3), StartVariance: ptr(1)},
+		{UUID: "later start", FinishVariance: ptr(3), StartVariance: ptr(2)},
+		{UUID: "on time", FinishVariance: ptr(0), StartVariance: ptr(0)},
+	}
+	SortBySlippage(variances)
+
+	want := []string{"later start", "late", "on time", "early", "none"}
+	for i, variance := range variances {
+		if variance.UUID != want[i] {
+			t.Fatalf("SortBySlippage()[%d] = %q, want %q", i, variance.UUID, want[i])
+		}
+	}
+}
+
+func ptr(value float64) *float64 {
+	return &value
+}
+
+func assertVariance(t *testing.T, name string, got, want *float64) {
+	t.Helper()
+	switch {
+	case got == nil && want == nil:
+	case got == nil || want == nil:
+		t.Errorf("%s = %v, want %v", name, got, want)
+	case *got != *want:
+		t.Errorf("%s = %v, want %v", name, *got, *want)
+	}
+}
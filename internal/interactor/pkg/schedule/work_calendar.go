@@ -0,0 +1,239 @@
+package schedule
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxCalendarDays is the maximum number of days the calendar walks through, to stop on calendars without working time.
+const maxCalendarDays = 366 * 100
+
+// WorkingTime is a working period of the day, in hours (e.g. 8 to 12).
+type WorkingTime struct {
+	// 開始時間
+	From float64
+	// 結束時間
+	To float64
+}
+
+// Holiday is a non-working period of the calendar, both dates included.
+type Holiday struct {
+	// 起始日期
+	Start time.Time
+	// 結束日期
+	End time.Time
+}
+
+// WorkCalendar is the calendar of the working days, working times and holidays.
+type WorkCalendar struct {
+	workWeek     map[time.Weekday]bool
+	workingTimes []WorkingTime
+	holidays     map[string]bool
+	location     *time.Location
+	hoursPerDay  float64
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// NewWorkCalendar builds a working calendar. The work week defaults to Monday to Friday and
+// the working time to 8 to 17 when they are empty.
+func NewWorkCalendar(workWeek []string, workingTimes []WorkingTime, holidays []Holiday, location *time.Location) *WorkCalendar {
+	c := &WorkCalendar{
+		workWeek: parseWorkWeek(workWeek),
+		holidays: make(map[string]bool),
+		location: location,
+	}
+	if c.location == nil {
+		c.location = time.UTC
+	}
+
+	if len(c.workWeek) == 0 {
+		for weekday := time.Monday; weekday <= time.Friday; weekday++ {
+			c.workWeek[weekday] = true
+		}
+	}
+
+	for _, workingTime := range workingTimes {
+		if workingTime.From >= 0 && workingTime.To <= 24 && workingTime.To > workingTime.From {
+			c.workingTimes = append(c.workingTimes, workingTime)
+			c.hoursPerDay += workingTime.To - workingTime.From
+		}
+	}
+	if len(c.workingTimes) == 0 {
+		c.workingTimes = []WorkingTime{{From: 8, To: 17}}
+		c.hoursPerDay = 9
+	}
+	sort.Slice(c.workingTimes, func(i, j int) bool {
+		return c.workingTimes[i].From < c.workingTimes[j].From
+	})
+
+	for _, holiday := range holidays {
+		if holiday.Start.IsZero() {
+			continue
+		}
+
+		end := holiday.End
+		if end.IsZero() || end.Before(holiday.Start) {
+			end = holiday.Start
+		}
+
+		for day := c.dayStart(holiday.Start); !day.After(end.In(c.location)); day = day.AddDate(0, 0, 1) {
+			c.holidays[day.Format(time.DateOnly)] = true
+		}
+	}
+
+	return c
+}
+
+// HoursPerDay returns the working hours of one day.
+func (c *WorkCalendar) HoursPerDay() float64 {
+	return c.hoursPerDay
+}
+
+// IsWorkingDay reports whether the date is a working day.
+func (c *WorkCalendar) IsWorkingDay(t time.Time) bool {
+	t = t.In(c.location)
+	return c.workWeek[t.Weekday()] && !c.holidays[t.Format(time.DateOnly)]
+}
+
+// Days returns the working days between start and end.
+func (c *WorkCalendar) Days(start, end time.Time) float64 {
+	if end.Before(start) {
+		return -c.Days(end, start)
+	}
+
+	var hours float64
+	for day := c.dayStart(start); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if !c.IsWorkingDay(day) {
+			continue
+		}
+
+		for _, workingTime := range c.workingTimes {
+			from, to := c.period(day, workingTime)
+			if from.Before(start) {
+				from = start
+			}
+			if to.After(end) {
+				to = end
+			}
+			if to.After(from) {
+				hours += to.Sub(from).Hours()
+			}
+		}
+	}
+
+	return hours / c.hoursPerDay
+}
+
+// Add returns the date after the working days from t, going backwards when days is negative.
+func (c *WorkCalendar) Add(t time.Time, days float64) time.Time {
+	remaining := math.Abs(days) * c.hoursPerDay
+	if remaining == 0 {
+		return t
+	}
+
+	backward := days < 0
+	day := c.dayStart(t)
+	for i := 0; i < maxCalendarDays; i++ {
+		if c.IsWorkingDay(day) {
+			for j := range c.workingTimes {
+				workingTime := c.workingTimes[j]
+				if backward {
+					workingTime = c.workingTimes[len(c.workingTimes)-1-j]
+				}
+
+				from, to := c.period(day, workingTime)
+				if backward && to.After(t) {
+					to = t
+				}
+				if !backward && from.Before(t) {
+					from = t
+				}
+				if !to.After(from) {
+					continue
+				}
+
+				available := to.Sub(from).Hours()
+				if remaining <= available {
+					offset := time.Duration(remaining * float64(time.Hour))
+					if backward {
+						return to.Add(-offset)
+					}
+					return from.Add(offset)
+				}
+				remaining -= available
+			}
+		}
+
+		if backward {
+			day = day.AddDate(0, 0, -1)
+		} else {
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+
+	return t
+}
+
+// Shift moves the date from the reference date to the new one, keeping the number of working days between
+// the reference and the date and the time of the day, so that the date stays on the same working day of the schedule.
+func (c *WorkCalendar) Shift(date, from, to time.Time) time.Time {
+	// count the working days from the reference day to the day of the date
+	start, end, step := from, date, 1
+	if date.Before(from) {
+		start, end, step = date, from, -1
+	}
+
+	var days int
+	for day := c.dayStart(start); day.Before(c.dayStart(end)); day = day.AddDate(0, 0, 1) {
+		if c.IsWorkingDay(day) {
+			days++
+		}
+	}
+
+	shifted := c.dayStart(to)
+	for i := 0; days > 0 && i < maxCalendarDays; i++ {
+		shifted = shifted.AddDate(0, 0, step)
+		if c.IsWorkingDay(shifted) {
+			days--
+		}
+	}
+
+	return shifted.Add(date.Sub(c.dayStart(date)))
+}
+
+// parseWorkWeek is a helper function to parse the names of the working days, e.g. "Monday" or "mon".
+func parseWorkWeek(workWeek []string) map[time.Weekday]bool {
+	days := make(map[time.Weekday]bool)
+	for _, day := range workWeek {
+		day = strings.ToLower(strings.TrimSpace(day))
+		for name, weekday := range weekdays {
+			if len(day) >= 3 && strings.HasPrefix(name, day) {
+				days[weekday] = true
+			}
+		}
+	}
+
+	return days
+}
+
+// dayStart is a helper function to get the beginning of the day of t in the calendar's location.
+func (c *WorkCalendar) dayStart(t time.Time) time.Time {
+	t = t.In(c.location)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, c.location)
+}
+
+// period is a helper function to get the dates of the working time on the day.
+func (c *WorkCalendar) period(day time.Time, workingTime WorkingTime) (time.Time, time.Time) {
+	return day.Add(time.Duration(workingTime.From * float64(time.Hour))), day.Add(time.Duration(workingTime.To * float64(time.Hour)))
+}
@@ -0,0 +1,77 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorkCalendar(t *testing.T) {
+	location := time.FixedZone("CST", 8*60*60)
+	date := func(day, hour int) time.Time {
+		return time.Date(2024, 2, day, hour, 0, 0, 0, location)
+	}
+
+	// 2024-02-05 is a Monday, and 2024-02-08 is a holiday
+	calendar := NewWorkCalendar(
+		[]string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"},
+		[]WorkingTime{{From: 8, To: 12}, {From: 13, To: 17}},
+		[]Holiday{{Start: date(8, 0), End: date(8, 0)}},
+		location,
+	)
+
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		days  float64
+	}{
+		{name: "one day", start: date(5, 8), end: date(5, 17), days: 1},
+		{name: "half day with lunch break", start: date(5, 10), end: date(5, 14), days: 0.375},
+		{name: "over the holiday", start: date(7, 8), end: date(9, 17), days: 2},
+		{name: "over the weekend", start: date(9, 8), end: date(12, 17), days: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calendar.Days(tt.start, tt.end); got != tt.days {
+				t.Errorf("Days() = %v, want %v", got, tt.days)
+			}
+			if got := calendar.Add(tt.start, tt.days); !got.Equal(tt.end) {
+				t.Errorf("Add() = %v, want %v", got, tt.end)
+			}
+			if got := calendar.Add(tt.end, -tt.days); !got.Equal(tt.start) {
+				t.Errorf("Add() backwards = %v, want %v", got, tt.start)
+			}
+		})
+	}
+}
+
+func TestWorkCalendarShift(t *testing.T) {
+	location := time.FixedZone("CST", 8*60*60)
+	date := func(day, hour int) time.Time {
+		return time.Date(2024, 2, day, hour, 0, 0, 0, location)
+	}
+
+	// 2024-02-05 is a Monday, and 2024-02-08 is a holiday
+	calendar := NewWorkCalendar(nil, nil, []Holiday{{Start: date(8, 0), End: date(8, 0)}}, location)
+
+	tests := []struct {
+		name string
+		date time.Time
+		from time.Time
+		to   time.Time
+		want time.Time
+	}{
+		{name: "reference date", date: date(5, 0), from: date(5, 0), to: date(14, 0), want: date(14, 0)},
+		{name: "over the weekend", date: date(7, 8), from: date(5, 0), to: date(15, 0), want: date(19, 8)},
+		{name: "over the holiday", date: date(9, 17), from: date(6, 0), to: date(1, 0), want: date(5, 17)},
+		{name: "before the reference", date: date(2, 8), from: date(5, 0), to: date(15, 0), want: date(14, 8)},
+		{name: "exclusive end on the weekend", date: date(10, 0), from: date(5, 0), to: date(15, 0), want: date(21, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calendar.Shift(tt.date, tt.from, tt.to); !got.Equal(tt.want) {
+				t.Errorf("Shift() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
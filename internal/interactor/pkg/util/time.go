@@ -1,6 +1,10 @@
 package util
 
-import "time"
+import (
+	"time"
+	// embed the timezone database for the environments without it
+	_ "time/tzdata"
+)
 
 func ChangeToUTC(lasting time.Time) time.Time {
 	return lasting.UTC()
@@ -31,3 +35,13 @@ func GetAge(birthday time.Time) (age int) {
 	}
 	return age
 }
+
+// LoadLocation loads the location by its name, or returns UTC when it is unknown.
+func LoadLocation(name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
@@ -0,0 +1,139 @@
+package project_baseline_task
+
+import (
+	db "gantt/internal/entity/postgresql/db/project_baseline_tasks"
+	store "gantt/internal/entity/postgresql/project_baseline_task"
+	model "gantt/internal/interactor/models/project_baseline_tasks"
+	"gantt/internal/interactor/pkg/util"
+	"gantt/internal/interactor/pkg/util/log"
+	"gantt/internal/interactor/pkg/util/uuid"
+
+	"github.com/bytedance/sonic"
+
+	"gorm.io/gorm"
+)
+
+type Service interface {
+	WithTrx(tx *gorm.DB) Service
+	CreateAll(input []*model.Create) (output []*db.Base, err error)
+	GetByListNoPagination(input *model.Field) (output []*db.Base, err error)
+	Delete(input *model.Field) (err error)
+}
+
+type service struct {
+	Repository store.Entity
+}
+
+func Init(db *gorm.DB) Service {
+	return &service{
+		Repository: store.Init(db),
+	}
+}
+
+func (s *service) WithTrx(tx *gorm.DB) Service {
+	return &service{
+		Repository: s.Repository.WithTrx(tx),
+	}
+}
+
+func (s *service) CreateAll(input []*model.Create) (output []*db.Base, err error) {
+	var base []*db.Base
+	marshal, err := sonic.Marshal(input)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	err = sonic.Unmarshal(marshal, &base)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	for i, field := range base {
+		field.ID = util.PointerString(uuid.CreatedUUIDString())
+		field.CreatedAt = util.PointerTime(util.NowToUTC())
+		field.UpdatedAt = util.PointerTime(util.NowToUTC())
+		field.UpdatedBy = util.PointerString(input[i].CreatedBy)
+	}
+
+	err = s.Repository.CreateAll(base)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	marshal, err = sonic.Marshal(base)
+	if err != nil {
+		log.Error(err)
+
+		return nil, err
+	}
+
+	err = sonic.Unmarshal(marshal, &output)
+	if err != nil {
+		log.Error(err)
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *service) GetByListNoPagination(input *model.Field) (output []*db.Base, err error) {
+	field := &db.Base{}
+	marshal, err := sonic.Marshal(input)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	err = sonic.Unmarshal(marshal, &field)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	fields, err := s.Repository.GetByListNoPagination(field)
+	if err != nil {
+		log.Error(err)
+		return output, err
+	}
+
+	marshal, err = sonic.Marshal(fields)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	err = sonic.Unmarshal(marshal, &output)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *service) Delete(input *model.Field) (err error) {
+	field := &db.Base{}
+	marshal, err := sonic.Marshal(input)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	err = sonic.Unmarshal(marshal, &field)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	err = s.Repository.Delete(field)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package calendar
+
+import (
+	"gantt/internal/interactor/pkg/util"
+	"net/http"
+
+	constant "gantt/internal/interactor/constants"
+
+	"gantt/internal/interactor/manager/calendar"
+	calendarModel "gantt/internal/interactor/models/calendars"
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type Control interface {
+	Create(ctx *gin.Context)
+	GetByList(ctx *gin.Context)
+	GetByListNoPagination(ctx *gin.Context)
+	GetBySingle(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Update(ctx *gin.Context)
+}
+
+type control struct {
+	Manager calendar.Manager
+}
+
+func Init(db *gorm.DB) Control {
+	return &control{
+		Manager: calendar.Init(db),
+	}
+}
+
+// Create
+// @Summary 新增行事曆
+// @description 新增行事曆
+// @Tags calendar
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param * body calendars.Create true "新增行事曆"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /calendars [post]
+func (c *control) Create(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &calendarModel.Create{}
+	input.CreatedBy = ctx.MustGet("user_id").(string)
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Create(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetByList
+// @Summary 取得全部行事曆
+// @description 取得全部行事曆
+// @Tags calendar
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param page query int true "目前頁數,請從1開始帶入"
+// @param limit query int true "一次回傳比數,請從1開始帶入,最高上限20"
+// @success 200 object code.SuccessfulMessage{body=calendars.List} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /calendars [get]
+func (c *control) GetByList(ctx *gin.Context) {
+	input := &calendarModel.Fields{}
+
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	if input.Limit >= constant.DefaultLimit {
+		input.Limit = constant.DefaultLimit
+	}
+
+	httpCode, codeMessage := c.Manager.GetByList(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetByListNoPagination
+// @Summary 取得全部行事曆(不用page&limit)
+// @description 取得全部行事曆
+// @Tags calendar
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @success 200 object code.SuccessfulMessage{body=calendars.List} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /calendars/no-pagination [get]
+func (c *control) GetByListNoPagination(ctx *gin.Context) {
+	input := &calendarModel.Field{}
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.GetByListNoPagination(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetBySingle
+// @Summary 取得單一行事曆
+// @description 取得單一行事曆
+// @Tags calendar
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "行事曆UUID"
+// @success 200 object code.SuccessfulMessage{body=calendars.Single} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /calendars/{id} [get]
+func (c *control) GetBySingle(ctx *gin.Context) {
+	id := ctx.Param("id")
+	input := &calendarModel.Field{}
+	input.ID = id
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.GetBySingle(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Delete
+// @Summary 刪除單一行事曆
+// @description 刪除單一行事曆
+// @Tags calendar
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "行事曆UUID"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /calendars/{id} [delete]
+func (c *control) Delete(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &calendarModel.Field{}
+	input.ID = id
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Delete(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Update
+// @Summary 更新單一行事曆
+// @description 更新單一行事曆
+// @Tags calendar
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "行事曆UUID"
+// @param * body calendars.Update true "更新行事曆"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /calendars/{id} [patch]
+func (c *control) Update(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &calendarModel.Update{}
+	input.ID = id
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Update(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
@@ -8,7 +8,9 @@ import (
 	constant "gantt/internal/interactor/constants"
 
 	"gantt/internal/interactor/manager/project"
+	projectBaselineModel "gantt/internal/interactor/models/project_baselines"
 	projectModel "gantt/internal/interactor/models/projects"
+	taskModel "gantt/internal/interactor/models/tasks"
 	"gantt/internal/interactor/pkg/util/code"
 	"gantt/internal/interactor/pkg/util/log"
 
@@ -23,6 +25,14 @@ type Control interface {
 	GetBySingle(ctx *gin.Context)
 	Delete(ctx *gin.Context)
 	Update(ctx *gin.Context)
+	GetCriticalPath(ctx *gin.Context)
+	GetVariance(ctx *gin.Context)
+	GetEarnedValue(ctx *gin.Context)
+	GetForecast(ctx *gin.Context)
+	GetMilestones(ctx *gin.Context)
+	LevelResources(ctx *gin.Context)
+	RollUpTasks(ctx *gin.Context)
+	Clone(ctx *gin.Context)
 }
 
 type control struct {
@@ -37,7 +47,7 @@ func Init(db *gorm.DB) Control {
 
 // Create
 // @Summary 新增專案
-// @description 新增專案
+// @description 新增專案，帶入template_uuid時以範本建立任務、專案資源及事件標記，任務日期依專案起始日期平移
 // @Tags project
 // @version 1.0
 // @Accept json
@@ -219,3 +229,252 @@ func (c *control) Update(ctx *gin.Context) {
 	httpCode, codeMessage := c.Manager.Update(trx, input)
 	ctx.JSON(httpCode, codeMessage)
 }
+
+// GetCriticalPath
+// @Summary 取得專案要徑
+// @description 取得專案要徑
+// @Tags project
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param project-uuid path string true "專案UUID"
+// @success 200 object code.SuccessfulMessage{body=tasks.CriticalPath} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /projects/{project-uuid}/critical-path [get]
+func (c *control) GetCriticalPath(ctx *gin.Context) {
+	projectID := ctx.Param("projectID")
+	input := &projectModel.Field{}
+	input.ProjectUUID = projectID
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.UserID = util.PointerString(ctx.MustGet("user_id").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.GetCriticalPath(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetVariance
+// @Summary 取得專案基準線差異報表
+// @description 取得專案各任務(含摘要任務彙總)與基準線的起始、完成、期間及花費差異
+// @Tags project
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param project-uuid path string true "專案UUID"
+// @param baseline query string false "基準線UUID (空值表示已選取的基準線)"
+// @param sort query string false "排序 (outline: 大綱順序、slippage: 延誤最嚴重者優先)"
+// @success 200 object code.SuccessfulMessage{body=project_baselines.VarianceReport} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /projects/{project-uuid}/variance [get]
+func (c *control) GetVariance(ctx *gin.Context) {
+	projectID := ctx.Param("projectID")
+	input := &projectBaselineModel.Variance{}
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	input.ProjectUUID = projectID
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.UserID = util.PointerString(ctx.MustGet("user_id").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.GetVariance(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetEarnedValue
+// @Summary 取得專案實獲值
+// @description 依狀態日期取得專案、摘要任務及各任務的實獲值指標 (PV、EV、AC、SV、CV、SPI、CPI、EAC、ETC)
+// @Tags project
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param project-uuid path string true "專案UUID"
+// @param status_date query string false "狀態日期 (YYYY-MM-DD，空值表示今日)"
+// @success 200 object code.SuccessfulMessage{body=tasks.EarnedValue} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /projects/{project-uuid}/earned-value [get]
+func (c *control) GetEarnedValue(ctx *gin.Context) {
+	projectID := ctx.Param("projectID")
+	input := &taskModel.EarnedValueField{}
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	input.ProjectUUID = projectID
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.UserID = util.PointerString(ctx.MustGet("user_id").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.GetEarnedValue(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetForecast
+// @Summary 取得專案完成日期預測
+// @description 依任務的三點估計(PERT)以蒙地卡羅模擬相依網路，取得專案及里程碑的P50、P80、P95完成日期及各任務的要徑指數，帶入相同亂數種子可重現相同結果
+// @Tags project
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param project-uuid path string true "專案UUID"
+// @param milestone_uuids query []string false "里程碑任務UUIDs (空值表示專案全部里程碑)"
+// @param iterations query int false "模擬次數 (預設1000，最高10000)"
+// @param seed query int false "亂數種子 (空值表示隨機產生)"
+// @success 200 object code.SuccessfulMessage{body=tasks.Forecast} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "里程碑不屬於專案或前任設定錯誤"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /projects/{project-uuid}/forecast [get]
+func (c *control) GetForecast(ctx *gin.Context) {
+	projectID := ctx.Param("projectID")
+	input := &taskModel.ForecastField{}
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	input.ProjectUUID = projectID
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.UserID = util.PointerString(ctx.MustGet("user_id").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.GetForecast(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetMilestones
+// @Summary 取得里程碑報表
+// @description 取得可存取專案的里程碑及其狀態 (未到期、已達成、逾期)，依里程碑日期排序
+// @Tags project
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param project_uuids query []string false "專案UUIDs (空值表示可存取的全部專案)"
+// @param start_date query string false "起始日期 (YYYY-MM-DD)"
+// @param end_date query string false "結束日期 (YYYY-MM-DD)"
+// @param status query string false "狀態 (due、achieved、late)"
+// @success 200 object code.SuccessfulMessage{body=tasks.MilestoneList} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /projects/milestones [get]
+func (c *control) GetMilestones(ctx *gin.Context) {
+	input := &taskModel.MilestoneField{}
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.UserID = util.PointerString(ctx.MustGet("user_id").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.GetMilestones(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// LevelResources
+// @Summary 調配專案資源
+// @description 在浮時內延後非要徑任務(依優先順序及限制)以排除資源過度配置，preview模式僅回傳建議的日期變更，commit模式套用變更
+// @Tags project
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param project-uuid path string true "專案UUID"
+// @param * body tasks.Level true "資源調配"
+// @success 200 object code.SuccessfulMessage{body=tasks.LevelResult} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /projects/{project-uuid}/level-resources [post]
+func (c *control) LevelResources(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	projectID := ctx.Param("projectID")
+	input := &taskModel.Level{}
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	input.ProjectUUID = projectID
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.LevelResources(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// RollUpTasks
+// @Summary 重新彙總專案摘要任務
+// @description 依子任務重新計算專案各摘要任務的起始、結束日期、期間、花費及完成百分比(依期間加權)，用於修復既有專案
+// @Tags project
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param project-uuid path string true "專案UUID"
+// @success 200 object code.SuccessfulMessage{body=tasks.RollUpResult} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /projects/{project-uuid}/roll-up [post]
+func (c *control) RollUpTasks(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	projectID := ctx.Param("projectID")
+	input := &taskModel.RollUp{}
+	input.ProjectUUID = projectID
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.RollUpTasks(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Clone
+// @Summary 複製專案
+// @description 複製專案的任務、專案資源及事件標記為新專案，任務日期依新的起始日期以工作日平移，完成百分比歸零
+// @Tags project
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param project-uuid path string true "專案UUID"
+// @param * body projects.Clone true "複製專案"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /projects/{project-uuid}/clone [post]
+func (c *control) Clone(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	projectID := ctx.Param("projectID")
+	input := &projectModel.Clone{}
+	input.CreatedBy = ctx.MustGet("user_id").(string)
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	input.ProjectUUID = projectID
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.Clone(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
@@ -0,0 +1,259 @@
+package project_baseline
+
+import (
+	"gantt/internal/interactor/pkg/util"
+	"net/http"
+
+	constant "gantt/internal/interactor/constants"
+
+	"gantt/internal/interactor/manager/project_baseline"
+	projectBaselineModel "gantt/internal/interactor/models/project_baselines"
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type Control interface {
+	Create(ctx *gin.Context)
+	GetByList(ctx *gin.Context)
+	GetByListNoPagination(ctx *gin.Context)
+	GetBySingle(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Select(ctx *gin.Context)
+	Compare(ctx *gin.Context)
+}
+
+type control struct {
+	Manager project_baseline.Manager
+}
+
+func Init(db *gorm.DB) Control {
+	return &control{
+		Manager: project_baseline.Init(db),
+	}
+}
+
+// Create
+// @Summary 新增基準線
+// @description 新增基準線，並快照專案所有任務的日期、期間、花費時間及完成百分比
+// @Tags project-baseline
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param * body project_baselines.Create true "新增基準線"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-baselines [post]
+func (c *control) Create(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &projectBaselineModel.Create{}
+	input.CreatedBy = ctx.MustGet("user_id").(string)
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Create(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetByList
+// @Summary 取得全部基準線
+// @description 取得全部基準線
+// @Tags project-baseline
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param project_uuid query string false "專案UUID"
+// @param page query int true "目前頁數,請從1開始帶入"
+// @param limit query int true "一次回傳比數,請從1開始帶入,最高上限20"
+// @success 200 object code.SuccessfulMessage{body=project_baselines.List} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-baselines [get]
+func (c *control) GetByList(ctx *gin.Context) {
+	input := &projectBaselineModel.Fields{}
+
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	if input.Limit >= constant.DefaultLimit {
+		input.Limit = constant.DefaultLimit
+	}
+
+	httpCode, codeMessage := c.Manager.GetByList(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetByListNoPagination
+// @Summary 取得全部基準線(不用page&limit)
+// @description 取得全部基準線
+// @Tags project-baseline
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param project_uuid query string false "專案UUID"
+// @success 200 object code.SuccessfulMessage{body=project_baselines.List} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-baselines/no-pagination [get]
+func (c *control) GetByListNoPagination(ctx *gin.Context) {
+	input := &projectBaselineModel.Field{}
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.GetByListNoPagination(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetBySingle
+// @Summary 取得單一基準線
+// @description 取得單一基準線
+// @Tags project-baseline
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "基準線UUID"
+// @success 200 object code.SuccessfulMessage{body=project_baselines.Single} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-baselines/{id} [get]
+func (c *control) GetBySingle(ctx *gin.Context) {
+	id := ctx.Param("id")
+	input := &projectBaselineModel.Field{}
+	input.ID = id
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.GetBySingle(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Delete
+// @Summary 刪除單一基準線
+// @description 刪除單一基準線
+// @Tags project-baseline
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "基準線UUID"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-baselines/{id} [delete]
+func (c *control) Delete(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &projectBaselineModel.Field{}
+	input.ID = id
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Delete(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Update
+// @Summary 更新單一基準線
+// @description 更新單一基準線
+// @Tags project-baseline
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "基準線UUID"
+// @param * body project_baselines.Update true "更新基準線"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-baselines/{id} [patch]
+func (c *control) Update(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &projectBaselineModel.Update{}
+	input.ID = id
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Update(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Select
+// @Summary 選用單一基準線
+// @description 選用單一基準線，get-by-projects的基準線欄位將以此基準線填入
+// @Tags project-baseline
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "基準線UUID"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-baselines/{id}/select [post]
+func (c *control) Select(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &projectBaselineModel.Update{}
+	input.ID = id
+	input.IsSelected = util.PointerBool(true)
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+
+	httpCode, codeMessage := c.Manager.Update(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Compare
+// @Summary 比較基準線
+// @description 比較基準線與另一基準線或目前排程的任務差異
+// @Tags project-baseline
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "基準線UUID"
+// @param target_uuid query string false "比較的基準線UUID (空值表示與目前排程比較)"
+// @success 200 object code.SuccessfulMessage{body=project_baselines.Comparison} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-baselines/{id}/compare [get]
+func (c *control) Compare(ctx *gin.Context) {
+	id := ctx.Param("id")
+	input := &projectBaselineModel.Compare{}
+	input.ID = id
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Compare(input)
+	ctx.JSON(httpCode, codeMessage)
+}
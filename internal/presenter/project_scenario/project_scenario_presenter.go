@@ -0,0 +1,260 @@
+package project_scenario
+
+import (
+	"gantt/internal/interactor/pkg/util"
+	"net/http"
+
+	constant "gantt/internal/interactor/constants"
+
+	"gantt/internal/interactor/manager/project_scenario"
+	projectScenarioModel "gantt/internal/interactor/models/project_scenarios"
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type Control interface {
+	Create(ctx *gin.Context)
+	GetByList(ctx *gin.Context)
+	GetByListNoPagination(ctx *gin.Context)
+	GetBySingle(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Compare(ctx *gin.Context)
+	Promote(ctx *gin.Context)
+}
+
+type control struct {
+	Manager project_scenario.Manager
+}
+
+func Init(db *gorm.DB) Control {
+	return &control{
+		Manager: project_scenario.Init(db),
+	}
+}
+
+// Create
+// @Summary 新增情境
+// @description 新增情境，將專案的任務及資源指派複製至隱藏的沙盒專案，以情境專案UUID編輯情境的任務不影響目前排程
+// @Tags project-scenario
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param * body project_scenarios.Create true "新增情境"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-scenarios [post]
+func (c *control) Create(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &projectScenarioModel.Create{}
+	input.CreatedBy = ctx.MustGet("user_id").(string)
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Create(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetByList
+// @Summary 取得全部情境
+// @description 取得全部情境
+// @Tags project-scenario
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param project_uuid query string false "專案UUID"
+// @param page query int true "目前頁數,請從1開始帶入"
+// @param limit query int true "一次回傳比數,請從1開始帶入,最高上限20"
+// @success 200 object code.SuccessfulMessage{body=project_scenarios.List} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-scenarios [get]
+func (c *control) GetByList(ctx *gin.Context) {
+	input := &projectScenarioModel.Fields{}
+
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	if input.Limit >= constant.DefaultLimit {
+		input.Limit = constant.DefaultLimit
+	}
+
+	httpCode, codeMessage := c.Manager.GetByList(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetByListNoPagination
+// @Summary 取得全部情境(不用page&limit)
+// @description 取得全部情境
+// @Tags project-scenario
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param project_uuid query string false "專案UUID"
+// @success 200 object code.SuccessfulMessage{body=project_scenarios.List} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-scenarios/no-pagination [get]
+func (c *control) GetByListNoPagination(ctx *gin.Context) {
+	input := &projectScenarioModel.Field{}
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.GetByListNoPagination(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetBySingle
+// @Summary 取得單一情境
+// @description 取得單一情境
+// @Tags project-scenario
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "情境UUID"
+// @success 200 object code.SuccessfulMessage{body=project_scenarios.Single} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-scenarios/{id} [get]
+func (c *control) GetBySingle(ctx *gin.Context) {
+	id := ctx.Param("id")
+	input := &projectScenarioModel.Field{}
+	input.ID = id
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.GetBySingle(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Delete
+// @Summary 捨棄單一情境
+// @description 捨棄單一情境及其沙盒專案的任務
+// @Tags project-scenario
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "情境UUID"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-scenarios/{id} [delete]
+func (c *control) Delete(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &projectScenarioModel.Field{}
+	input.ID = id
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Delete(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Update
+// @Summary 更新單一情境
+// @description 更新單一情境
+// @Tags project-scenario
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "情境UUID"
+// @param * body project_scenarios.Update true "更新情境"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-scenarios/{id} [patch]
+func (c *control) Update(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &projectScenarioModel.Update{}
+	input.ID = id
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Update(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Compare
+// @Summary 比較情境
+// @description 比較情境與目前排程的結束日期、花費、資源負載及任務差異
+// @Tags project-scenario
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "情境UUID"
+// @success 200 object code.SuccessfulMessage{body=project_scenarios.Comparison} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-scenarios/{id}/compare [get]
+func (c *control) Compare(ctx *gin.Context) {
+	id := ctx.Param("id")
+	input := &projectScenarioModel.Field{}
+	input.ID = id
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Compare(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Promote
+// @Summary 採用情境
+// @description 以情境的排程取代專案目前的排程(經由任務批次更新的檢查)，並移除情境
+// @Tags project-scenario
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "情境UUID"
+// @success 200 object code.SuccessfulMessage{body=tasks.Result} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "使用者無權限或排程不合法"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-scenarios/{id}/promote [post]
+func (c *control) Promote(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &projectScenarioModel.Promote{}
+	input.ID = id
+	input.UpdatedBy = ctx.MustGet("user_id").(string)
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.Promote(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
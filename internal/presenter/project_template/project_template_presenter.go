@@ -0,0 +1,208 @@
+package project_template
+
+import (
+	"gantt/internal/interactor/pkg/util"
+	"net/http"
+
+	constant "gantt/internal/interactor/constants"
+
+	"gantt/internal/interactor/manager/project_template"
+	projectTemplateModel "gantt/internal/interactor/models/project_templates"
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type Control interface {
+	Create(ctx *gin.Context)
+	GetByList(ctx *gin.Context)
+	GetByListNoPagination(ctx *gin.Context)
+	GetBySingle(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Update(ctx *gin.Context)
+}
+
+type control struct {
+	Manager project_template.Manager
+}
+
+func Init(db *gorm.DB) Control {
+	return &control{
+		Manager: project_template.Init(db),
+	}
+}
+
+// Create
+// @Summary 新增範本
+// @description 將專案另存為範本，保存任務(含大綱、前置任務、資源指派)、專案資源及事件標記，日期以專案起始日期為基準
+// @Tags project-template
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param * body project_templates.Create true "新增範本"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-templates [post]
+func (c *control) Create(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &projectTemplateModel.Create{}
+	input.CreatedBy = ctx.MustGet("user_id").(string)
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Create(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetByList
+// @Summary 取得全部範本
+// @description 取得全部範本
+// @Tags project-template
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param name query string false "名稱"
+// @param type_id query string false "類別ID"
+// @param page query int true "目前頁數,請從1開始帶入"
+// @param limit query int true "一次回傳比數,請從1開始帶入,最高上限20"
+// @success 200 object code.SuccessfulMessage{body=project_templates.List} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-templates [get]
+func (c *control) GetByList(ctx *gin.Context) {
+	input := &projectTemplateModel.Fields{}
+
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	if input.Limit >= constant.DefaultLimit {
+		input.Limit = constant.DefaultLimit
+	}
+
+	httpCode, codeMessage := c.Manager.GetByList(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetByListNoPagination
+// @Summary 取得全部範本(不用page&limit)
+// @description 取得全部範本
+// @Tags project-template
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param name query string false "名稱"
+// @param type_id query string false "類別ID"
+// @success 200 object code.SuccessfulMessage{body=project_templates.List} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-templates/no-pagination [get]
+func (c *control) GetByListNoPagination(ctx *gin.Context) {
+	input := &projectTemplateModel.Field{}
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.GetByListNoPagination(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetBySingle
+// @Summary 取得單一範本
+// @description 取得單一範本
+// @Tags project-template
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "範本UUID"
+// @success 200 object code.SuccessfulMessage{body=project_templates.Single} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-templates/{id} [get]
+func (c *control) GetBySingle(ctx *gin.Context) {
+	id := ctx.Param("id")
+	input := &projectTemplateModel.Field{}
+	input.ID = id
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.GetBySingle(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Delete
+// @Summary 刪除單一範本
+// @description 刪除單一範本
+// @Tags project-template
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "範本UUID"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-templates/{id} [delete]
+func (c *control) Delete(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &projectTemplateModel.Field{}
+	input.ID = id
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Delete(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Update
+// @Summary 更新單一範本
+// @description 更新單一範本
+// @Tags project-template
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "範本UUID"
+// @param * body project_templates.Update true "更新範本"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /project-templates/{id} [patch]
+func (c *control) Update(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &projectTemplateModel.Update{}
+	input.ID = id
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Update(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
@@ -30,6 +30,7 @@ type Control interface {
 	Delete(ctx *gin.Context)
 	Update(ctx *gin.Context)
 	Import(ctx *gin.Context)
+	GetByLoad(ctx *gin.Context)
 }
 
 type control struct {
@@ -245,3 +246,32 @@ func (c *control) Import(ctx *gin.Context) {
 	httpCode, codeMessage := c.Manager.Import(trx, input)
 	ctx.JSON(httpCode, codeMessage)
 }
+
+// GetByLoad
+// @Summary 取得資源負載
+// @description 依日期區間取得各資源跨專案的每日或每週分配工時
+// @Tags resource
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param start_date query string true "起始日期 (YYYY-MM-DD)"
+// @param end_date query string true "結束日期 (YYYY-MM-DD)"
+// @param interval query string false "區間 (day、week，預設day)"
+// @param resource_uuids query []string false "資源UUIDs" collectionFormat(multi)
+// @param resource_groups query []string false "群組" collectionFormat(multi)
+// @success 200 object code.SuccessfulMessage{body=resources.LoadList} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /resources/load [get]
+func (c *control) GetByLoad(ctx *gin.Context) {
+	input := &resourceModel.Load{}
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.GetByLoad(input)
+	ctx.JSON(httpCode, codeMessage)
+}
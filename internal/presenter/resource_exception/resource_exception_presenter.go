@@ -0,0 +1,202 @@
+package resource_exception
+
+import (
+	"gantt/internal/interactor/pkg/util"
+	"net/http"
+
+	constant "gantt/internal/interactor/constants"
+
+	"gantt/internal/interactor/manager/resource_exception"
+	resourceExceptionModel "gantt/internal/interactor/models/resource_exceptions"
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type Control interface {
+	Create(ctx *gin.Context)
+	GetByList(ctx *gin.Context)
+	GetByListNoPagination(ctx *gin.Context)
+	GetBySingle(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Update(ctx *gin.Context)
+}
+
+type control struct {
+	Manager resource_exception.Manager
+}
+
+func Init(db *gorm.DB) Control {
+	return &control{
+		Manager: resource_exception.Init(db),
+	}
+}
+
+// Create
+// @Summary 新增資源例外日
+// @description 新增資源例外日
+// @Tags resource_exception
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param * body resource_exceptions.Create true "新增資源例外日"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /resource-exceptions [post]
+func (c *control) Create(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &resourceExceptionModel.Create{}
+	input.CreatedBy = ctx.MustGet("user_id").(string)
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Create(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetByList
+// @Summary 取得全部資源例外日
+// @description 取得全部資源例外日
+// @Tags resource_exception
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param resource_uuid query string false "資源UUID"
+// @param page query int true "目前頁數,請從1開始帶入"
+// @param limit query int true "一次回傳比數,請從1開始帶入,最高上限20"
+// @success 200 object code.SuccessfulMessage{body=resource_exceptions.List} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /resource-exceptions [get]
+func (c *control) GetByList(ctx *gin.Context) {
+	input := &resourceExceptionModel.Fields{}
+
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	if input.Limit >= constant.DefaultLimit {
+		input.Limit = constant.DefaultLimit
+	}
+
+	httpCode, codeMessage := c.Manager.GetByList(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetByListNoPagination
+// @Summary 取得全部資源例外日(不用page&limit)
+// @description 取得全部資源例外日
+// @Tags resource_exception
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param resource_uuid query string false "資源UUID"
+// @success 200 object code.SuccessfulMessage{body=tasks.List} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /resource-exceptions/no-pagination [get]
+func (c *control) GetByListNoPagination(ctx *gin.Context) {
+	input := &resourceExceptionModel.Field{}
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.GetByListNoPagination(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetBySingle
+// @Summary 取得單一資源例外日
+// @description 取得單一資源例外日
+// @Tags resource_exception
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "資源例外日UUID"
+// @success 200 object code.SuccessfulMessage{body=resource_exceptions.Single} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /resource-exceptions/{id} [get]
+func (c *control) GetBySingle(ctx *gin.Context) {
+	id := ctx.Param("id")
+	input := &resourceExceptionModel.Field{}
+	input.ID = id
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.GetBySingle(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Delete
+// @Summary 刪除單一資源例外日
+// @description 刪除單一資源例外日
+// @Tags resource_exception
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "資源例外日UUID"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /resource-exceptions/{id} [delete]
+func (c *control) Delete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	input := &resourceExceptionModel.Field{}
+	input.ID = id
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Delete(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Update
+// @Summary 更新單一資源例外日
+// @description 更新單一資源例外日
+// @Tags resource_exception
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "資源例外日UUID"
+// @param * body resource_exceptions.Update true "更新資源例外日"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /resource-exceptions/{id} [patch]
+func (c *control) Update(ctx *gin.Context) {
+	id := ctx.Param("id")
+	input := &resourceExceptionModel.Update{}
+	input.ID = id
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Update(input)
+	ctx.JSON(httpCode, codeMessage)
+}
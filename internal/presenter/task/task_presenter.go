@@ -12,6 +12,7 @@ import (
 
 	"gantt/internal/interactor/manager/task"
 	taskModel "gantt/internal/interactor/models/tasks"
+	"gantt/internal/interactor/pkg/schedule"
 	"gantt/internal/interactor/pkg/util/code"
 	"gantt/internal/interactor/pkg/util/log"
 
@@ -29,6 +30,11 @@ type Control interface {
 	Update(ctx *gin.Context)
 	UpdateAll(ctx *gin.Context)
 	Import(ctx *gin.Context)
+	Indent(ctx *gin.Context)
+	Outdent(ctx *gin.Context)
+	Move(ctx *gin.Context)
+	Duplicate(ctx *gin.Context)
+	UpdateRecurrence(ctx *gin.Context)
 }
 
 type control struct {
@@ -43,14 +49,14 @@ func Init(db *gorm.DB) Control {
 
 // Create
 // @Summary 新增單一任務
-// @description 新增單一任務
+// @description 新增單一任務，帶入recurrence時此任務為摘要任務，依週期規則及工作行事曆產生週期任務為其子任務；帶入segments時檢查分段(不得超出任務日期、總期間須與期間相符)，合併重疊的分段並依分段重新計算任務日期及期間
 // @Tags task
 // @version 1.0
 // @Accept json
 // @produce json
 // @param Authorization header string true "JWE Token"
 // @param * body tasks.Create true "新增任務"
-// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @success 200 object code.SuccessfulMessage{body=tasks.Result} "成功後返回的值"
 // @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
 // @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
 // @Router /tasks [post]
@@ -79,7 +85,7 @@ func (c *control) Create(ctx *gin.Context) {
 // @produce json
 // @param Authorization header string true "JWE Token"
 // @param * body []tasks.Create true "新增任務"
-// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @success 200 object code.SuccessfulMessage{body=tasks.Result} "成功後返回的值"
 // @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
 // @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
 // @Router /tasks/create-all [post]
@@ -197,6 +203,7 @@ func (c *control) GetBySingle(ctx *gin.Context) {
 func (c *control) Delete(ctx *gin.Context) {
 	trx := ctx.MustGet("db_trx").(*gorm.DB)
 	input := &taskModel.DeletedTaskUUIDs{}
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
 	input.Role = util.PointerString(ctx.MustGet("role").(string))
 	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
 	if err := ctx.ShouldBindJSON(input); err != nil {
@@ -211,7 +218,7 @@ func (c *control) Delete(ctx *gin.Context) {
 
 // Update
 // @Summary 更新單一任務
-// @description 更新單一任務
+// @description 更新單一任務，帶入segments時檢查分段並合併重疊的分段，任務日期及期間依分段重新計算
 // @Tags task
 // @version 1.0
 // @Accept json
@@ -219,7 +226,7 @@ func (c *control) Delete(ctx *gin.Context) {
 // @param Authorization header string true "JWE Token"
 // @param task-uuid path string true "任務UUID"
 // @param * body tasks.Update true "更新任務"
-// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @success 200 object code.SuccessfulMessage{body=tasks.Result} "成功後返回的值"
 // @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
 // @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
 // @Router /tasks/{task-uuid} [patch]
@@ -250,7 +257,7 @@ func (c *control) Update(ctx *gin.Context) {
 // @produce json
 // @param Authorization header string true "JWE Token"
 // @param * body []tasks.Update true "更新任務"
-// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @success 200 object code.SuccessfulMessage{body=tasks.Result} "成功後返回的值"
 // @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
 // @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
 // @Router /tasks/update-all [patch]
@@ -282,7 +289,7 @@ func (c *control) UpdateAll(ctx *gin.Context) {
 // @produce json
 // @param Authorization header string true "JWE Token"
 // @param * body tasks.Import true "匯入專案"
-// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @success 200 object code.SuccessfulMessage{body=tasks.Result} "成功後返回的值"
 // @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
 // @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
 // @Router /tasks/import [post]
@@ -305,3 +312,154 @@ func (c *control) Import(ctx *gin.Context) {
 	httpCode, codeMessage := c.Manager.Import(trx, input)
 	ctx.JSON(httpCode, codeMessage)
 }
+
+// Indent
+// @Summary 任務降階
+// @description 任務連同其子任務成為前一個同階任務的最後一個子任務
+// @Tags task
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param task-uuid path string true "任務UUID"
+// @success 200 object code.SuccessfulMessage{body=tasks.Result} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "無法降階"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /tasks/{task-uuid}/indent [patch]
+func (c *control) Indent(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &taskModel.Move{}
+	input.TaskUUID = ctx.Param("taskUUID")
+	input.Position = schedule.Indent
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.Move(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Outdent
+// @Summary 任務升階
+// @description 任務連同其子任務移至其父任務之後
+// @Tags task
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param task-uuid path string true "任務UUID"
+// @success 200 object code.SuccessfulMessage{body=tasks.Result} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "無法升階"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /tasks/{task-uuid}/outdent [patch]
+func (c *control) Outdent(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &taskModel.Move{}
+	input.TaskUUID = ctx.Param("taskUUID")
+	input.Position = schedule.Outdent
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.Move(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Move
+// @Summary 移動任務
+// @description 任務連同其子任務移至目標任務之前、之後或成為其最後一個子任務
+// @Tags task
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param task-uuid path string true "任務UUID"
+// @param * body tasks.Move true "移動任務"
+// @success 200 object code.SuccessfulMessage{body=tasks.Result} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "無法移動"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /tasks/{task-uuid}/move [patch]
+func (c *control) Move(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &taskModel.Move{}
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	input.TaskUUID = ctx.Param("taskUUID")
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.Move(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Duplicate
+// @Summary 複製任務
+// @description 任務連同其子任務、分段、標示、資源及附件複製至原專案或其他專案
+// @Tags task
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param task-uuid path string true "任務UUID"
+// @param * body tasks.Duplicate true "複製任務"
+// @success 200 object code.SuccessfulMessage{body=tasks.Result} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "無法複製"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /tasks/{task-uuid}/duplicate [post]
+func (c *control) Duplicate(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &taskModel.Duplicate{}
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	input.TaskUUID = ctx.Param("taskUUID")
+	input.CreatedBy = ctx.MustGet("user_id").(string)
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.Duplicate(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// UpdateRecurrence
+// @Summary 更新週期任務
+// @description 依新的規則、名稱、期間或資源重新產生週期任務於生效日期起尚未開始的子任務，之前及已開始的子任務保留不變
+// @Tags task
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param task-uuid path string true "週期任務UUID"
+// @param * body tasks.UpdateRecurrence true "更新週期任務"
+// @success 200 object code.SuccessfulMessage{body=tasks.Result} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "無法更新"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /tasks/{task-uuid}/recurrence [patch]
+func (c *control) UpdateRecurrence(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &taskModel.UpdateRecurrence{}
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	input.TaskUUID = ctx.Param("taskUUID")
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.UpdateRecurrence(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
@@ -0,0 +1,186 @@
+package task_checklist
+
+import (
+	"gantt/internal/interactor/pkg/util"
+	"net/http"
+
+	"gantt/internal/interactor/manager/task_checklist"
+	taskChecklistItemModel "gantt/internal/interactor/models/task_checklist_items"
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type Control interface {
+	Create(ctx *gin.Context)
+	GetByList(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Reorder(ctx *gin.Context)
+}
+
+type control struct {
+	Manager task_checklist.Manager
+}
+
+func Init(db *gorm.DB) Control {
+	return &control{
+		Manager: task_checklist.Init(db),
+	}
+}
+
+// Create
+// @Summary 新增任務的檢查清單項目
+// @description 新增項目於任務檢查清單的最後，專案依檢查清單計算進度時同步更新任務進度
+// @Tags task_checklist
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param task-uuid path string true "任務UUID"
+// @param * body task_checklist_items.Create true "新增檢查清單項目"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "使用者無權限或資源不存在"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /tasks/{task-uuid}/checklist [post]
+func (c *control) Create(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &taskChecklistItemModel.Create{}
+	input.TaskUUID = ctx.Param("taskUUID")
+	input.CreatedBy = ctx.MustGet("user_id").(string)
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Create(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetByList
+// @Summary 取得任務的檢查清單
+// @description 依排序取得任務的檢查清單項目及完成比例
+// @Tags task_checklist
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param task-uuid path string true "任務UUID"
+// @param resource_uuid query string false "負責資源UUID"
+// @param is_done query bool false "是否完成"
+// @success 200 object code.SuccessfulMessage{body=task_checklist_items.List} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /tasks/{task-uuid}/checklist [get]
+func (c *control) GetByList(ctx *gin.Context) {
+	input := &taskChecklistItemModel.Field{}
+	input.TaskUUID = util.PointerString(ctx.Param("taskUUID"))
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.GetByList(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Update
+// @Summary 更新任務的檢查清單項目
+// @description 更新檢查清單項目，專案依檢查清單計算進度時同步更新任務進度
+// @Tags task_checklist
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param task-uuid path string true "任務UUID"
+// @param id path string true "檢查清單項目ID"
+// @param * body task_checklist_items.Update true "更新檢查清單項目"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "使用者無權限或資源不存在"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /tasks/{task-uuid}/checklist/{id} [patch]
+func (c *control) Update(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &taskChecklistItemModel.Update{}
+	input.ID = ctx.Param("id")
+	input.TaskUUID = ctx.Param("taskUUID")
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Update(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Delete
+// @Summary 刪除任務的檢查清單項目
+// @description 刪除檢查清單項目，專案依檢查清單計算進度時同步更新任務進度
+// @Tags task_checklist
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param task-uuid path string true "任務UUID"
+// @param id path string true "檢查清單項目ID"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "使用者無權限"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /tasks/{task-uuid}/checklist/{id} [delete]
+func (c *control) Delete(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &taskChecklistItemModel.Field{}
+	input.ID = util.PointerString(ctx.Param("id"))
+	input.TaskUUID = util.PointerString(ctx.Param("taskUUID"))
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+
+	httpCode, codeMessage := c.Manager.Delete(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Reorder
+// @Summary 重新排序任務的檢查清單
+// @description 依傳入的項目ID順序重新排序任務的檢查清單，須包含全部項目
+// @Tags task_checklist
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param task-uuid path string true "任務UUID"
+// @param * body task_checklist_items.Reorder true "重新排序檢查清單"
+// @success 200 object code.SuccessfulMessage{body=[]string} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "使用者無權限或項目不符"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /tasks/{task-uuid}/checklist/reorder [patch]
+func (c *control) Reorder(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &taskChecklistItemModel.Reorder{}
+	input.TaskUUID = ctx.Param("taskUUID")
+	input.UpdatedBy = ctx.MustGet("user_id").(string)
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+	input.ResUUID = util.PointerString(ctx.MustGet("resource_id").(string))
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.Reorder(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
@@ -0,0 +1,308 @@
+package timesheet
+
+import (
+	"gantt/internal/interactor/pkg/util"
+	"net/http"
+
+	constant "gantt/internal/interactor/constants"
+
+	"gantt/internal/interactor/manager/timesheet"
+	timesheetEntryModel "gantt/internal/interactor/models/timesheet_entries"
+	timesheetModel "gantt/internal/interactor/models/timesheets"
+	"gantt/internal/interactor/pkg/util/code"
+	"gantt/internal/interactor/pkg/util/log"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type Control interface {
+	CreateEntry(ctx *gin.Context)
+	UpdateEntry(ctx *gin.Context)
+	DeleteEntry(ctx *gin.Context)
+	GetByList(ctx *gin.Context)
+	GetByApprovalList(ctx *gin.Context)
+	GetBySingle(ctx *gin.Context)
+	Submit(ctx *gin.Context)
+	Approve(ctx *gin.Context)
+	Reject(ctx *gin.Context)
+}
+
+type control struct {
+	Manager timesheet.Manager
+}
+
+func Init(db *gorm.DB) Control {
+	return &control{
+		Manager: timesheet.Init(db),
+	}
+}
+
+// CreateEntry
+// @Summary 新增工時
+// @description 於指派給使用者的任務新增單日工時，並自動建立該週的工時表
+// @Tags timesheet
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param * body timesheet_entries.Create true "新增工時"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "未指派任務、工時表已送出或單日工時超過上限"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /timesheets/entries [post]
+func (c *control) CreateEntry(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	input := &timesheetEntryModel.Create{}
+	input.CreatedBy = ctx.MustGet("user_id").(string)
+	input.ResourceUUID = ctx.MustGet("resource_id").(string)
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.CreateEntry(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// UpdateEntry
+// @Summary 更新單一工時
+// @description 更新單一工時(僅限工時表的擁有者，且工時表未送出或已退回)
+// @Tags timesheet
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "工時UUID"
+// @param * body timesheet_entries.Update true "更新工時"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "使用者無權限、工時表已送出或單日工時超過上限"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /timesheets/entries/{id} [patch]
+func (c *control) UpdateEntry(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &timesheetEntryModel.Update{}
+	input.ID = id
+	input.UpdatedBy = util.PointerString(ctx.MustGet("user_id").(string))
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	httpCode, codeMessage := c.Manager.UpdateEntry(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// DeleteEntry
+// @Summary 刪除單一工時
+// @description 刪除單一工時(僅限工時表的擁有者，且工時表未送出或已退回)
+// @Tags timesheet
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "工時UUID"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "使用者無權限或工時表已送出"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /timesheets/entries/{id} [delete]
+func (c *control) DeleteEntry(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &timesheetEntryModel.Field{}
+	input.ID = id
+	input.UserID = util.PointerString(ctx.MustGet("user_id").(string))
+
+	httpCode, codeMessage := c.Manager.DeleteEntry(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetByList
+// @Summary 取得使用者的全部工時表
+// @description 取得使用者的全部工時表
+// @Tags timesheet
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param week_start query string false "週起始日 (YYYY-MM-DD，週內任一日皆可)"
+// @param status query string false "狀態 (draft、submitted、approved、rejected)"
+// @param page query int true "目前頁數,請從1開始帶入"
+// @param limit query int true "一次回傳比數,請從1開始帶入,最高上限20"
+// @success 200 object code.SuccessfulMessage{body=timesheets.List} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /timesheets [get]
+func (c *control) GetByList(ctx *gin.Context) {
+	input := &timesheetModel.Fields{}
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	if input.Limit >= constant.DefaultLimit {
+		input.Limit = constant.DefaultLimit
+	}
+
+	input.UserID = util.PointerString(ctx.MustGet("user_id").(string))
+	httpCode, codeMessage := c.Manager.GetByList(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetByApprovalList
+// @Summary 取得待審核的工時表
+// @description 取得使用者主管部門成員的工時表(預設為已送出)
+// @Tags timesheet
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param week_start query string false "週起始日 (YYYY-MM-DD，週內任一日皆可)"
+// @param status query string false "狀態 (draft、submitted、approved、rejected)"
+// @param page query int true "目前頁數,請從1開始帶入"
+// @param limit query int true "一次回傳比數,請從1開始帶入,最高上限20"
+// @success 200 object code.SuccessfulMessage{body=timesheets.List} "成功後返回的值"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /timesheets/approvals [get]
+func (c *control) GetByApprovalList(ctx *gin.Context) {
+	input := &timesheetModel.Fields{}
+	if err := ctx.ShouldBindQuery(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	if input.Limit >= constant.DefaultLimit {
+		input.Limit = constant.DefaultLimit
+	}
+
+	input.UserID = util.PointerString(ctx.MustGet("user_id").(string))
+	httpCode, codeMessage := c.Manager.GetByApprovalList(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// GetBySingle
+// @Summary 取得單一工時表
+// @description 取得單一工時表及其工時(僅限擁有者、其主管及管理員)
+// @Tags timesheet
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "工時表UUID"
+// @success 200 object code.SuccessfulMessage{body=timesheets.Single} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "使用者無權限"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /timesheets/{id} [get]
+func (c *control) GetBySingle(ctx *gin.Context) {
+	id := ctx.Param("id")
+	input := &timesheetModel.Field{}
+	input.ID = id
+	input.UserID = util.PointerString(ctx.MustGet("user_id").(string))
+	input.Role = util.PointerString(ctx.MustGet("role").(string))
+
+	httpCode, codeMessage := c.Manager.GetBySingle(input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Submit
+// @Summary 送出工時表
+// @description 送出草稿或已退回的工時表給主管審核
+// @Tags timesheet
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "工時表UUID"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "使用者無權限、工時表已送出或沒有工時"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /timesheets/{id}/submit [post]
+func (c *control) Submit(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &timesheetModel.Review{}
+	input.ID = id
+	input.UserID = ctx.MustGet("user_id").(string)
+	input.Role = ctx.MustGet("role").(string)
+
+	httpCode, codeMessage := c.Manager.Submit(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Approve
+// @Summary 核准工時表
+// @description 由擁有者部門的主管核准已送出的工時表，並將核准的工時加總至任務的實際工時及花費時間
+// @Tags timesheet
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "工時表UUID"
+// @param * body timesheets.Review false "審核意見"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "使用者不是主管或工時表未送出"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /timesheets/{id}/approve [post]
+func (c *control) Approve(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &timesheetModel.Review{}
+	// the comment of the approval is optional
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(input); err != nil {
+			log.Error(err)
+			ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+			return
+		}
+	}
+
+	input.ID = id
+	input.UserID = ctx.MustGet("user_id").(string)
+	input.Role = ctx.MustGet("role").(string)
+	httpCode, codeMessage := c.Manager.Approve(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
+
+// Reject
+// @Summary 退回工時表
+// @description 由擁有者部門的主管退回已送出的工時表(須填寫審核意見)，退回後擁有者可修改後再送出
+// @Tags timesheet
+// @version 1.0
+// @Accept json
+// @produce json
+// @param Authorization header string true "JWE Token"
+// @param id path string true "工時表UUID"
+// @param * body timesheets.Review true "審核意見"
+// @success 200 object code.SuccessfulMessage{body=string} "成功後返回的值"
+// @failure 400 object code.ErrorMessage{detailed=string} "使用者不是主管、工時表未送出或未填寫審核意見"
+// @failure 415 object code.ErrorMessage{detailed=string} "必要欄位帶入錯誤"
+// @failure 500 object code.ErrorMessage{detailed=string} "伺服器非預期錯誤"
+// @Router /timesheets/{id}/reject [post]
+func (c *control) Reject(ctx *gin.Context) {
+	trx := ctx.MustGet("db_trx").(*gorm.DB)
+	id := ctx.Param("id")
+	input := &timesheetModel.Review{}
+	if err := ctx.ShouldBindJSON(input); err != nil {
+		log.Error(err)
+		ctx.JSON(http.StatusUnsupportedMediaType, code.GetCodeMessage(code.FormatError, err.Error()))
+		return
+	}
+
+	input.ID = id
+	input.UserID = ctx.MustGet("user_id").(string)
+	input.Role = ctx.MustGet("role").(string)
+	httpCode, codeMessage := c.Manager.Reject(trx, input)
+	ctx.JSON(httpCode, codeMessage)
+}
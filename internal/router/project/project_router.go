@@ -15,7 +15,15 @@ func GetRouter(router *gin.Engine, db *gorm.DB) *gin.Engine {
 		v10.POST("", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Create)
 		v10.POST("list", middleware.Verify(), middleware.CheckPermission(), control.GetByList)
 		v10.GET("no-pagination", middleware.Verify(), middleware.CheckPermission(), control.GetByListNoPagination)
+		v10.GET("milestones", middleware.Verify(), middleware.CheckPermission(), control.GetMilestones)
 		v10.GET(":projectID", middleware.Verify(), middleware.CheckPermission(), control.GetBySingle)
+		v10.GET(":projectID/critical-path", middleware.Verify(), middleware.CheckPermission(), control.GetCriticalPath)
+		v10.GET(":projectID/variance", middleware.Verify(), middleware.CheckPermission(), control.GetVariance)
+		v10.GET(":projectID/earned-value", middleware.Verify(), middleware.CheckPermission(), control.GetEarnedValue)
+		v10.GET(":projectID/forecast", middleware.Verify(), middleware.CheckPermission(), control.GetForecast)
+		v10.POST(":projectID/level-resources", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.LevelResources)
+		v10.POST(":projectID/roll-up", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.RollUpTasks)
+		v10.POST(":projectID/clone", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Clone)
 		v10.DELETE(":projectID", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Delete)
 		v10.PATCH(":projectID", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Update)
 	}
@@ -16,6 +16,7 @@ func GetRouter(router *gin.Engine, db *gorm.DB) *gin.Engine {
 		v10.POST("import", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Import)
 		v10.POST("/list", middleware.Verify(), middleware.CheckPermission(), control.GetByList)
 		v10.GET("no-pagination", middleware.Verify(), middleware.CheckPermission(), control.GetByListNoPagination)
+		v10.GET("load", middleware.Verify(), middleware.CheckPermission(), control.GetByLoad)
 		v10.GET(":resourceUUID", middleware.Verify(), middleware.CheckPermission(), control.GetBySingle)
 		v10.DELETE(":resourceUUID", middleware.Verify(), middleware.CheckPermission(), control.Delete)
 		v10.PATCH(":resourceUUID", middleware.Verify(), middleware.CheckPermission(), control.Update)
@@ -21,6 +21,11 @@ func GetRouter(router *gin.Engine, db *gorm.DB) *gin.Engine {
 		v10.DELETE("", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Delete)
 		v10.PATCH(":taskUUID", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Update)
 		v10.PATCH("update-all", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.UpdateAll)
+		v10.PATCH(":taskUUID/indent", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Indent)
+		v10.PATCH(":taskUUID/outdent", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Outdent)
+		v10.PATCH(":taskUUID/move", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Move)
+		v10.POST(":taskUUID/duplicate", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Duplicate)
+		v10.PATCH(":taskUUID/recurrence", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.UpdateRecurrence)
 	}
 
 	return router
@@ -0,0 +1,23 @@
+package task_checklist
+
+import (
+	present "gantt/internal/presenter/task_checklist"
+	"gantt/internal/router/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func GetRouter(router *gin.Engine, db *gorm.DB) *gin.Engine {
+	control := present.Init(db)
+	v10 := router.Group("gantt").Group("v1.0").Group("tasks")
+	{
+		v10.POST(":taskUUID/checklist", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Create)
+		v10.GET(":taskUUID/checklist", middleware.Verify(), middleware.CheckPermission(), control.GetByList)
+		v10.PATCH(":taskUUID/checklist/reorder", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Reorder)
+		v10.PATCH(":taskUUID/checklist/:id", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Update)
+		v10.DELETE(":taskUUID/checklist/:id", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Delete)
+	}
+
+	return router
+}
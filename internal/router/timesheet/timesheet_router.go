@@ -0,0 +1,27 @@
+package timesheet
+
+import (
+	present "gantt/internal/presenter/timesheet"
+	"gantt/internal/router/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func GetRouter(router *gin.Engine, db *gorm.DB) *gin.Engine {
+	control := present.Init(db)
+	v10 := router.Group("gantt").Group("v1.0").Group("timesheets")
+	{
+		v10.POST("entries", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.CreateEntry)
+		v10.PATCH("entries/:id", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.UpdateEntry)
+		v10.DELETE("entries/:id", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.DeleteEntry)
+		v10.GET("", middleware.Verify(), middleware.CheckPermission(), control.GetByList)
+		v10.GET("approvals", middleware.Verify(), middleware.CheckPermission(), control.GetByApprovalList)
+		v10.GET(":id", middleware.Verify(), middleware.CheckPermission(), control.GetBySingle)
+		v10.POST(":id/submit", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Submit)
+		v10.POST(":id/approve", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Approve)
+		v10.POST(":id/reject", middleware.Verify(), middleware.CheckPermission(), middleware.Transaction(db), control.Reject)
+	}
+
+	return router
+}
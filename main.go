@@ -3,18 +3,25 @@ package main
 import (
 	"fmt"
 	"gantt/internal/interactor/pkg/connect"
+	"gantt/internal/router/calendar"
 	"gantt/internal/router/department"
 	"gantt/internal/router/event_mark"
 	"gantt/internal/router/holiday"
 	"gantt/internal/router/login"
 	"gantt/internal/router/policy"
 	"gantt/internal/router/project"
+	"gantt/internal/router/project_baseline"
 	"gantt/internal/router/project_resource"
+	"gantt/internal/router/project_scenario"
+	"gantt/internal/router/project_template"
 	"gantt/internal/router/project_type"
 	"gantt/internal/router/resource"
+	"gantt/internal/router/resource_exception"
 	"gantt/internal/router/role"
 	"gantt/internal/router/s3_file"
 	"gantt/internal/router/task"
+	"gantt/internal/router/task_checklist"
+	"gantt/internal/router/timesheet"
 	"gantt/internal/router/user"
 	"gantt/internal/router/work_day"
 	"net/http"
@@ -55,7 +62,12 @@ func main() {
 
 	engine := router.Default()
 	resource.GetRouter(engine, db)
+	resource_exception.GetRouter(engine, db)
 	project.GetRouter(engine, db)
+	project_baseline.GetRouter(engine, db)
+	project_scenario.GetRouter(engine, db)
+	project_template.GetRouter(engine, db)
+	calendar.GetRouter(engine, db)
 	holiday.GetRouter(engine, db)
 	event_mark.GetRouter(engine, db)
 	work_day.GetRouter(engine, db)
@@ -66,6 +78,8 @@ func main() {
 	policy.GetRouter(engine, db)
 	role.GetRouter(engine, db)
 	task.GetRouter(engine, db)
+	task_checklist.GetRouter(engine, db)
+	timesheet.GetRouter(engine, db)
 	department.GetRouter(engine, db)
 	s3_file.GetRouter(engine, db)
 
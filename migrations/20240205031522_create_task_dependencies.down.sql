@@ -0,0 +1 @@
+drop table task_dependencies;
\ No newline at end of file
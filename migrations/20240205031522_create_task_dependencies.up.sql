@@ -0,0 +1,42 @@
+create table task_dependencies
+(
+    id               UUID    NOT NULL PRIMARY KEY,
+    project_uuid     UUID    not null references projects (project_uuid),
+    predecessor_uuid UUID    not null references tasks (task_uuid),
+    successor_uuid   UUID    not null references tasks (task_uuid),
+    type             text    not null default 'FS',
+    lag              numeric not null default 0,
+    lag_unit         text    not null default 'd',
+    created_at       TIMESTAMP        default now(),
+    created_by       UUID,
+    updated_at       TIMESTAMP,
+    updated_by       UUID,
+    deleted_at       TIMESTAMP
+);
+
+create index idx_task_dependencies_id
+    on task_dependencies using hash (id);
+
+create index idx_task_dependencies_project_uuid
+    on task_dependencies using hash (project_uuid);
+
+create index idx_task_dependencies_predecessor_uuid
+    on task_dependencies using hash (predecessor_uuid);
+
+create index idx_task_dependencies_successor_uuid
+    on task_dependencies using hash (successor_uuid);
+
+create index idx_task_dependencies_type
+    on task_dependencies (type);
+
+create index idx_task_dependencies_created_at
+    on task_dependencies (created_at desc);
+
+create index idx_task_dependencies_created_by
+    on task_dependencies using hash (created_by);
+
+create index idx_task_dependencies_updated_at
+    on task_dependencies (updated_at desc);
+
+create index idx_task_dependencies_updated_by
+    on task_dependencies using hash (updated_by);
@@ -0,0 +1 @@
+drop table calendars;
\ No newline at end of file
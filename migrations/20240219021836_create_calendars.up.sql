@@ -0,0 +1,34 @@
+create table calendars
+(
+    id           UUID    NOT NULL PRIMARY KEY,
+    name         text    not null,
+    work_week    text,
+    working_time text,
+    is_default   boolean not null default false,
+    created_at   TIMESTAMP        default now(),
+    created_by   UUID,
+    updated_at   TIMESTAMP,
+    updated_by   UUID,
+    deleted_at   TIMESTAMP
+);
+
+create index idx_calendars_id
+    on calendars using hash (id);
+
+create index idx_calendars_name
+    on calendars (name);
+
+create index idx_calendars_is_default
+    on calendars (is_default);
+
+create index idx_calendars_created_at
+    on calendars (created_at desc);
+
+create index idx_calendars_created_by
+    on calendars using hash (created_by);
+
+create index idx_calendars_updated_at
+    on calendars (updated_at desc);
+
+create index idx_calendars_updated_by
+    on calendars using hash (updated_by);
@@ -0,0 +1,5 @@
+alter table holidays
+    add column calendar_uuid uuid references calendars (id);
+
+create index idx_holidays_calendar_uuid
+    on holidays using hash (calendar_uuid);
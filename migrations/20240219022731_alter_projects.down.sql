@@ -0,0 +1,2 @@
+alter table projects
+    drop column calendar_uuid;
\ No newline at end of file
@@ -0,0 +1,5 @@
+alter table projects
+    add column calendar_uuid uuid references calendars (id);
+
+create index idx_projects_calendar_uuid
+    on projects using hash (calendar_uuid);
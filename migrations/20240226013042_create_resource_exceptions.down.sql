@@ -0,0 +1 @@
+drop table resource_exceptions;
\ No newline at end of file
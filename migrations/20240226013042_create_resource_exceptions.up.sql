@@ -0,0 +1,38 @@
+create table resource_exceptions
+(
+    id            UUID NOT NULL PRIMARY KEY,
+    resource_uuid UUID not null references resources (resource_uuid),
+    name          text,
+    start_date    TIMESTAMP,
+    end_date      TIMESTAMP,
+    work_week     text,
+    created_at    TIMESTAMP default now(),
+    created_by    UUID,
+    updated_at    TIMESTAMP,
+    updated_by    UUID,
+    deleted_at    TIMESTAMP
+);
+
+create index idx_resource_exceptions_id
+    on resource_exceptions using hash (id);
+
+create index idx_resource_exceptions_resource_uuid
+    on resource_exceptions using hash (resource_uuid);
+
+create index idx_resource_exceptions_start_date
+    on resource_exceptions (start_date);
+
+create index idx_resource_exceptions_end_date
+    on resource_exceptions (end_date);
+
+create index idx_resource_exceptions_created_at
+    on resource_exceptions (created_at desc);
+
+create index idx_resource_exceptions_created_by
+    on resource_exceptions using hash (created_by);
+
+create index idx_resource_exceptions_updated_at
+    on resource_exceptions (updated_at desc);
+
+create index idx_resource_exceptions_updated_by
+    on resource_exceptions using hash (updated_by);
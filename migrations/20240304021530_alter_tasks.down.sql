@@ -0,0 +1,7 @@
+drop index idx_tasks_constraint_type;
+drop index idx_tasks_deadline;
+
+alter table tasks
+    drop column constraint_type,
+    drop column constraint_date,
+    drop column deadline;
\ No newline at end of file
@@ -0,0 +1,10 @@
+alter table tasks
+    add column constraint_type text default 'ASAP',
+    add column constraint_date timestamp,
+    add column deadline timestamp;
+
+create index idx_tasks_constraint_type
+    on tasks (constraint_type);
+
+create index idx_tasks_deadline
+    on tasks (deadline);
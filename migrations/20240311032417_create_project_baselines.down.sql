@@ -0,0 +1 @@
+drop table project_baselines;
\ No newline at end of file
@@ -0,0 +1,36 @@
+create table project_baselines
+(
+    id           UUID    NOT NULL PRIMARY KEY,
+    project_uuid UUID    not null references projects (project_uuid),
+    name         text,
+    is_selected  boolean not null default false,
+    created_at   TIMESTAMP        default now(),
+    created_by   UUID,
+    updated_at   TIMESTAMP,
+    updated_by   UUID,
+    deleted_at   TIMESTAMP
+);
+
+create index idx_project_baselines_id
+    on project_baselines using hash (id);
+
+create index idx_project_baselines_project_uuid
+    on project_baselines using hash (project_uuid);
+
+create index idx_project_baselines_name
+    on project_baselines (name);
+
+create index idx_project_baselines_is_selected
+    on project_baselines (is_selected);
+
+create index idx_project_baselines_created_at
+    on project_baselines (created_at desc);
+
+create index idx_project_baselines_created_by
+    on project_baselines using hash (created_by);
+
+create index idx_project_baselines_updated_at
+    on project_baselines (updated_at desc);
+
+create index idx_project_baselines_updated_by
+    on project_baselines using hash (updated_by);
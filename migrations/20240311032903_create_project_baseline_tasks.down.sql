@@ -0,0 +1 @@
+drop table project_baseline_tasks;
\ No newline at end of file
@@ -0,0 +1,40 @@
+create table project_baseline_tasks
+(
+    id             UUID NOT NULL PRIMARY KEY,
+    baseline_uuid  UUID not null references project_baselines (id),
+    task_uuid      UUID not null,
+    task_id        text,
+    task_name      text,
+    outline_number text,
+    start_date     TIMESTAMP,
+    end_date       TIMESTAMP,
+    duration       numeric,
+    cost           int,
+    progress       int,
+    created_at     TIMESTAMP default now(),
+    created_by     UUID,
+    updated_at     TIMESTAMP,
+    updated_by     UUID,
+    deleted_at     TIMESTAMP
+);
+
+create index idx_project_baseline_tasks_id
+    on project_baseline_tasks using hash (id);
+
+create index idx_project_baseline_tasks_baseline_uuid
+    on project_baseline_tasks using hash (baseline_uuid);
+
+create index idx_project_baseline_tasks_task_uuid
+    on project_baseline_tasks using hash (task_uuid);
+
+create index idx_project_baseline_tasks_created_at
+    on project_baseline_tasks (created_at desc);
+
+create index idx_project_baseline_tasks_created_by
+    on project_baseline_tasks using hash (created_by);
+
+create index idx_project_baseline_tasks_updated_at
+    on project_baseline_tasks (updated_at desc);
+
+create index idx_project_baseline_tasks_updated_by
+    on project_baseline_tasks using hash (updated_by);
@@ -0,0 +1,2 @@
+alter table resources
+    drop column max_units;
\ No newline at end of file
@@ -0,0 +1,2 @@
+alter table resources
+    add column max_units numeric default 100 not null;
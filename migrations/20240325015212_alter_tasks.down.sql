@@ -0,0 +1,4 @@
+drop index idx_tasks_priority;
+
+alter table tasks
+    drop column priority;
\ No newline at end of file
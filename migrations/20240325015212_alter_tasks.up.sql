@@ -0,0 +1,5 @@
+alter table tasks
+    add column priority integer default 500 not null;
+
+create index idx_tasks_priority
+    on tasks (priority);
\ No newline at end of file
@@ -0,0 +1 @@
+drop table project_templates;
\ No newline at end of file
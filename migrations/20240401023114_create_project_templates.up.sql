@@ -0,0 +1,37 @@
+create table project_templates
+(
+    id          UUID NOT NULL PRIMARY KEY,
+    name        text,
+    type_id     UUID references project_types (id),
+    description text,
+    start_date  timestamp,
+    tasks       text,
+    resources   text,
+    event_marks text,
+    created_at  TIMESTAMP default now(),
+    created_by  UUID,
+    updated_at  TIMESTAMP,
+    updated_by  UUID,
+    deleted_at  TIMESTAMP
+);
+
+create index idx_project_templates_id
+    on project_templates using hash (id);
+
+create index idx_project_templates_name
+    on project_templates (name);
+
+create index idx_project_templates_type_id
+    on project_templates using hash (type_id);
+
+create index idx_project_templates_created_at
+    on project_templates (created_at desc);
+
+create index idx_project_templates_created_by
+    on project_templates using hash (created_by);
+
+create index idx_project_templates_updated_at
+    on project_templates (updated_at desc);
+
+create index idx_project_templates_updated_by
+    on project_templates using hash (updated_by);
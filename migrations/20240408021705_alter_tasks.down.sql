@@ -0,0 +1,2 @@
+alter table tasks
+    drop column recurrence_rule;
\ No newline at end of file
@@ -0,0 +1,2 @@
+alter table tasks
+    add column recurrence_rule text;
\ No newline at end of file
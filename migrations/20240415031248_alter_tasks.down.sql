@@ -0,0 +1,4 @@
+drop index idx_tasks_is_milestone;
+
+alter table tasks
+    drop column is_milestone;
\ No newline at end of file
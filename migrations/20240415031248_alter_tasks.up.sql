@@ -0,0 +1,10 @@
+alter table tasks
+    add column is_milestone boolean default false not null;
+
+update tasks
+set is_milestone = true
+where duration = 0
+  and date(start_date) = date(end_date);
+
+create index idx_tasks_is_milestone
+    on tasks (is_milestone);
@@ -0,0 +1 @@
+drop table project_scenarios;
\ No newline at end of file
@@ -0,0 +1,37 @@
+create table project_scenarios
+(
+    id                    UUID NOT NULL PRIMARY KEY,
+    project_uuid          UUID not null references projects (project_uuid),
+    scenario_project_uuid UUID not null references projects (project_uuid),
+    name                  text,
+    description           text,
+    created_at            TIMESTAMP default now(),
+    created_by            UUID,
+    updated_at            TIMESTAMP,
+    updated_by            UUID,
+    deleted_at            TIMESTAMP
+);
+
+create index idx_project_scenarios_id
+    on project_scenarios using hash (id);
+
+create index idx_project_scenarios_project_uuid
+    on project_scenarios using hash (project_uuid);
+
+create index idx_project_scenarios_scenario_project_uuid
+    on project_scenarios using hash (scenario_project_uuid);
+
+create index idx_project_scenarios_name
+    on project_scenarios (name);
+
+create index idx_project_scenarios_created_at
+    on project_scenarios (created_at desc);
+
+create index idx_project_scenarios_created_by
+    on project_scenarios using hash (created_by);
+
+create index idx_project_scenarios_updated_at
+    on project_scenarios (updated_at desc);
+
+create index idx_project_scenarios_updated_by
+    on project_scenarios using hash (updated_by);
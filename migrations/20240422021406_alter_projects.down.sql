@@ -0,0 +1,2 @@
+alter table projects
+    drop column is_scenario;
\ No newline at end of file
@@ -0,0 +1,5 @@
+alter table projects
+    add column is_scenario boolean default false not null;
+
+create index idx_projects_is_scenario
+    on projects (is_scenario);
@@ -0,0 +1,2 @@
+alter table tasks
+    drop column source_task_uuid;
\ No newline at end of file
@@ -0,0 +1,5 @@
+alter table tasks
+    add column source_task_uuid uuid;
+
+create index idx_tasks_source_task_uuid
+    on tasks using hash (source_task_uuid);
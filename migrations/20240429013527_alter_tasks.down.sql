@@ -0,0 +1,4 @@
+alter table tasks
+    drop column optimistic_duration,
+    drop column most_likely_duration,
+    drop column pessimistic_duration;
\ No newline at end of file
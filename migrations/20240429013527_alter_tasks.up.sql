@@ -0,0 +1,4 @@
+alter table tasks
+    add column optimistic_duration numeric,
+    add column most_likely_duration numeric,
+    add column pessimistic_duration numeric;
\ No newline at end of file
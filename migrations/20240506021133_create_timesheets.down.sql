@@ -0,0 +1 @@
+drop table timesheets;
\ No newline at end of file
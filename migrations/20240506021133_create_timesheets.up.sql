@@ -0,0 +1,45 @@
+create table timesheets
+(
+    id            UUID NOT NULL PRIMARY KEY,
+    user_id       UUID not null references users (id),
+    resource_uuid UUID not null references resources (resource_uuid),
+    week_start    date not null,
+    status        text default 'draft' not null,
+    submitted_at  TIMESTAMP,
+    reviewed_by   UUID,
+    reviewed_at   TIMESTAMP,
+    comment       text,
+    created_at    TIMESTAMP default now(),
+    created_by    UUID,
+    updated_at    TIMESTAMP,
+    updated_by    UUID,
+    deleted_at    TIMESTAMP
+);
+
+create unique index idx_timesheets_user_id_week_start
+    on timesheets (user_id, week_start)
+    where deleted_at is null;
+
+create index idx_timesheets_id
+    on timesheets using hash (id);
+
+create index idx_timesheets_user_id
+    on timesheets using hash (user_id);
+
+create index idx_timesheets_week_start
+    on timesheets (week_start desc);
+
+create index idx_timesheets_status
+    on timesheets (status);
+
+create index idx_timesheets_created_at
+    on timesheets (created_at desc);
+
+create index idx_timesheets_created_by
+    on timesheets using hash (created_by);
+
+create index idx_timesheets_updated_at
+    on timesheets (updated_at desc);
+
+create index idx_timesheets_updated_by
+    on timesheets using hash (updated_by);
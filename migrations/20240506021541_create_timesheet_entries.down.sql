@@ -0,0 +1 @@
+drop table timesheet_entries;
\ No newline at end of file
@@ -0,0 +1,42 @@
+create table timesheet_entries
+(
+    id            UUID    NOT NULL PRIMARY KEY,
+    timesheet_id  UUID    not null references timesheets (id),
+    task_uuid     UUID    not null references tasks (task_uuid),
+    resource_uuid UUID    not null references resources (resource_uuid),
+    date          date    not null,
+    hours         numeric not null,
+    notes         text,
+    created_at    TIMESTAMP default now(),
+    created_by    UUID,
+    updated_at    TIMESTAMP,
+    updated_by    UUID,
+    deleted_at    TIMESTAMP
+);
+
+create index idx_timesheet_entries_id
+    on timesheet_entries using hash (id);
+
+create index idx_timesheet_entries_timesheet_id
+    on timesheet_entries using hash (timesheet_id);
+
+create index idx_timesheet_entries_task_uuid
+    on timesheet_entries using hash (task_uuid);
+
+create index idx_timesheet_entries_resource_uuid
+    on timesheet_entries using hash (resource_uuid);
+
+create index idx_timesheet_entries_date
+    on timesheet_entries (date);
+
+create index idx_timesheet_entries_created_at
+    on timesheet_entries (created_at desc);
+
+create index idx_timesheet_entries_created_by
+    on timesheet_entries using hash (created_by);
+
+create index idx_timesheet_entries_updated_at
+    on timesheet_entries (updated_at desc);
+
+create index idx_timesheet_entries_updated_by
+    on timesheet_entries using hash (updated_by);
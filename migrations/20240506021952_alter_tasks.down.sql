@@ -0,0 +1,2 @@
+alter table tasks
+    drop column actual_work;
\ No newline at end of file
@@ -0,0 +1,2 @@
+alter table tasks
+    add column actual_work numeric default 0 not null;
\ No newline at end of file
@@ -0,0 +1,6 @@
+drop index idx_tasks_status;
+
+alter table tasks
+    drop column status,
+    drop column status_changed_by,
+    drop column status_changed_at;
\ No newline at end of file
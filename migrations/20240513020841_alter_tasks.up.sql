@@ -0,0 +1,14 @@
+alter table tasks
+    add column status            text default 'not_started' not null,
+    add column status_changed_by UUID,
+    add column status_changed_at TIMESTAMP;
+
+update tasks
+set status = case
+                 when progress >= 100 then 'done'
+                 when progress > 0 then 'in_progress'
+                 else 'not_started'
+    end;
+
+create index idx_tasks_status
+    on tasks (status);
@@ -0,0 +1,2 @@
+alter table project_types
+    drop column status_transitions;
\ No newline at end of file
@@ -0,0 +1,2 @@
+alter table project_types
+    add column status_transitions text;
\ No newline at end of file
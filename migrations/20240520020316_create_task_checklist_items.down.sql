@@ -0,0 +1 @@
+drop table task_checklist_items;
\ No newline at end of file
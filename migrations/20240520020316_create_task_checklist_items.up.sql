@@ -0,0 +1,39 @@
+create table task_checklist_items
+(
+    id            UUID    NOT NULL PRIMARY KEY,
+    task_uuid     UUID    not null references tasks (task_uuid),
+    title         text    not null,
+    is_done       boolean default false not null,
+    resource_uuid UUID references resources (resource_uuid),
+    due_date      date,
+    sort_order    integer not null,
+    created_at    TIMESTAMP default now(),
+    created_by    UUID,
+    updated_at    TIMESTAMP,
+    updated_by    UUID,
+    deleted_at    TIMESTAMP
+);
+
+create index idx_task_checklist_items_id
+    on task_checklist_items using hash (id);
+
+create index idx_task_checklist_items_task_uuid
+    on task_checklist_items using hash (task_uuid);
+
+create index idx_task_checklist_items_resource_uuid
+    on task_checklist_items using hash (resource_uuid);
+
+create index idx_task_checklist_items_sort_order
+    on task_checklist_items (sort_order);
+
+create index idx_task_checklist_items_created_at
+    on task_checklist_items (created_at desc);
+
+create index idx_task_checklist_items_created_by
+    on task_checklist_items using hash (created_by);
+
+create index idx_task_checklist_items_updated_at
+    on task_checklist_items (updated_at desc);
+
+create index idx_task_checklist_items_updated_by
+    on task_checklist_items using hash (updated_by);
@@ -0,0 +1,2 @@
+alter table projects
+    drop column progress_from_checklist;
\ No newline at end of file
@@ -0,0 +1,2 @@
+alter table projects
+    add column progress_from_checklist boolean default false not null;
\ No newline at end of file
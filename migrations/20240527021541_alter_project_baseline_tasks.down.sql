@@ -0,0 +1,2 @@
+alter table project_baseline_tasks
+    drop column planned_work;
\ No newline at end of file
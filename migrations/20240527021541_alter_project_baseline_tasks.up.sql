@@ -0,0 +1,2 @@
+alter table project_baseline_tasks
+    add column planned_work numeric;
\ No newline at end of file